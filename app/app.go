@@ -20,12 +20,15 @@ import (
 	"github.com/maticnetwork/heimdall/bor"
 	borTypes "github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/chainmanager"
+	chainmanagerClient "github.com/maticnetwork/heimdall/chainmanager/client"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/clerk"
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/feegrant"
+	feegrantTypes "github.com/maticnetwork/heimdall/feegrant/types"
 	gov "github.com/maticnetwork/heimdall/gov"
 	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	"github.com/maticnetwork/heimdall/helper"
@@ -43,6 +46,9 @@ import (
 	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
 	"github.com/maticnetwork/heimdall/topup"
 	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	"github.com/maticnetwork/heimdall/treasury"
+	treasuryClient "github.com/maticnetwork/heimdall/treasury/client"
+	treasuryTypes "github.com/maticnetwork/heimdall/treasury/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmModule "github.com/maticnetwork/heimdall/types/module"
 	"github.com/maticnetwork/heimdall/version"
@@ -78,13 +84,17 @@ var (
 		clerk.AppModuleBasic{},
 		topup.AppModuleBasic{},
 		slashing.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsClient.ProposalHandler),
+		treasury.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		gov.NewAppModuleBasic(paramsClient.ProposalHandler, chainmanagerClient.ProposalHandler, treasuryClient.ProposalHandler),
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authTypes.FeeCollectorName: nil,
-		govTypes.ModuleName:        {},
+		authTypes.FeeCollectorName:        nil,
+		govTypes.ModuleName:               {},
+		treasuryTypes.ModuleName:          nil,
+		checkpointTypes.FeeRewardPoolName: nil,
 	}
 )
 
@@ -116,6 +126,8 @@ type HeimdallApp struct {
 	ClerkKeeper       clerk.Keeper
 	TopupKeeper       topup.Keeper
 	SlashingKeeper    slashing.Keeper
+	TreasuryKeeper    treasury.Keeper
+	FeeGrantKeeper    feegrant.Keeper
 
 	// param keeper
 	ParamsKeeper params.Keeper
@@ -129,8 +141,14 @@ type HeimdallApp struct {
 	// the module manager
 	mm *module.Manager
 
+	// invariants registered by modules
+	invariants invariantRegistry
+
 	// simulation module manager
 	sm *hmModule.SimulationManager
+
+	// upgrade handlers keyed by upgrade name
+	upgradeHandlers map[string]govTypes.UpgradeHandler
 }
 
 var logger = helper.Logger.With("module", "app")
@@ -149,6 +167,11 @@ func (d ModuleCommunicator) GetACKCount(ctx sdk.Context) uint64 {
 	return d.App.CheckpointKeeper.GetACKCount(ctx)
 }
 
+// IsUpgradeDone returns true if the software upgrade was applied on chain
+func (d ModuleCommunicator) IsUpgradeDone(ctx sdk.Context, name string) bool {
+	return d.App.GovKeeper.IsUpgradeDone(ctx, name)
+}
+
 // IsCurrentValidatorByAddress check if validator is current validator
 func (d ModuleCommunicator) IsCurrentValidatorByAddress(ctx sdk.Context, address []byte) bool {
 	return d.App.StakingKeeper.IsCurrentValidatorByAddress(ctx, address)
@@ -159,6 +182,11 @@ func (d ModuleCommunicator) GetAllDividendAccounts(ctx sdk.Context) []types.Divi
 	return d.App.TopupKeeper.GetAllDividendAccounts(ctx)
 }
 
+// GetSideTxVoters returns validators who voted yes on side-tx under execution
+func (d ModuleCommunicator) GetSideTxVoters(ctx sdk.Context, txHash []byte) []abci.Validator {
+	return d.App.SidechannelKeeper.GetTxVoters(ctx, txHash)
+}
+
 // GetValidatorFromValID get validator from validator id
 func (d ModuleCommunicator) GetValidatorFromValID(ctx sdk.Context, valID types.ValidatorID) (validator types.Validator, ok bool) {
 	return d.App.StakingKeeper.GetValidatorFromValID(ctx, valID)
@@ -179,6 +207,16 @@ func (d ModuleCommunicator) SendCoins(ctx sdk.Context, fromAddr types.HeimdallAd
 	return d.App.BankKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
 }
 
+// GetBorSpanForBlock returns span covering bor block, used by slashing module
+func (d ModuleCommunicator) GetBorSpanForBlock(ctx sdk.Context, blockNumber uint64) (*types.Span, error) {
+	return d.App.BorKeeper.GetSpanForBlock(ctx, blockNumber)
+}
+
+// GetBorLastSpan returns last bor span, used by slashing module
+func (d ModuleCommunicator) GetBorLastSpan(ctx sdk.Context) (*types.Span, error) {
+	return d.App.BorKeeper.GetLastSpan(ctx)
+}
+
 // Create ValidatorSigningInfo used by slashing module
 func (d ModuleCommunicator) CreateValiatorSigningInfo(ctx sdk.Context, valID types.ValidatorID, valSigningInfo types.ValidatorSigningInfo) {
 	d.App.SlashingKeeper.SetValidatorSigningInfo(ctx, valID, valSigningInfo)
@@ -218,6 +256,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		borTypes.StoreKey,
 		clerkTypes.StoreKey,
 		topupTypes.StoreKey,
+		treasuryTypes.StoreKey,
+		feegrantTypes.StoreKey,
 		paramsTypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramsTypes.TStoreKey)
@@ -229,6 +269,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		keys:      keys,
 		tkeys:     tkeys,
 		subspaces: make(map[string]subspace.Subspace),
+
+		upgradeHandlers: make(map[string]govTypes.UpgradeHandler),
 	}
 
 	// init params keeper and subspaces
@@ -245,6 +287,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	app.subspaces[borTypes.ModuleName] = app.ParamsKeeper.Subspace(borTypes.DefaultParamspace)
 	app.subspaces[clerkTypes.ModuleName] = app.ParamsKeeper.Subspace(clerkTypes.DefaultParamspace)
 	app.subspaces[topupTypes.ModuleName] = app.ParamsKeeper.Subspace(topupTypes.DefaultParamspace)
+	app.subspaces[treasuryTypes.ModuleName] = app.ParamsKeeper.Subspace(treasuryTypes.DefaultParamspace)
 	//
 	// Contract caller
 	//
@@ -307,6 +350,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.subspaces[slashingTypes.ModuleName],
 		common.DefaultCodespace,
 		app.ChainKeeper,
+		moduleCommunicator,
 	)
 
 	// bank keeper
@@ -329,11 +373,27 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.BankKeeper,
 	)
 
+	app.TreasuryKeeper = treasury.NewKeeper(
+		app.cdc,
+		keys[treasuryTypes.StoreKey], // target store
+		app.subspaces[treasuryTypes.ModuleName],
+		common.DefaultCodespace,
+		app.SupplyKeeper,
+	)
+
+	app.FeeGrantKeeper = feegrant.NewKeeper(
+		app.cdc,
+		keys[feegrantTypes.StoreKey], // target store
+		common.DefaultCodespace,
+	)
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.
-		AddRoute(govTypes.RouterKey, govTypes.ProposalHandler).
-		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+		AddRoute(govTypes.RouterKey, gov.NewGovProposalHandler(&app.GovKeeper)).
+		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(chainmanagerTypes.RouterKey, chainmanager.NewContractMigrationProposalHandler(app.ChainKeeper)).
+		AddRoute(treasuryTypes.RouterKey, treasury.NewTreasuryProposalHandler(app.TreasuryKeeper))
 
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc,
@@ -352,6 +412,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		common.DefaultCodespace,
 		app.StakingKeeper,
 		app.ChainKeeper,
+		app.SupplyKeeper,
 		moduleCommunicator,
 	)
 
@@ -363,6 +424,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.ChainKeeper,
 		app.StakingKeeper,
 		app.caller,
+		app.GovKeeper,
 	)
 
 	app.ClerkKeeper = clerk.NewKeeper(
@@ -371,6 +433,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.subspaces[clerkTypes.ModuleName],
 		common.DefaultCodespace,
 		app.ChainKeeper,
+		app.GovKeeper,
 	)
 
 	// may be need signer
@@ -382,6 +445,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.ChainKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
+		app.SupplyKeeper,
+		app.GovKeeper,
 	)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -401,6 +466,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		bor.NewAppModule(app.BorKeeper, &app.caller),
 		clerk.NewAppModule(app.ClerkKeeper, &app.caller),
 		topup.NewAppModule(app.TopupKeeper, &app.caller),
+		treasury.NewAppModule(app.TreasuryKeeper),
+		feegrant.NewAppModule(app.FeeGrantKeeper),
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -418,11 +485,16 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		borTypes.ModuleName,
 		clerkTypes.ModuleName,
 		topupTypes.ModuleName,
+		treasuryTypes.ModuleName,
+		feegrantTypes.ModuleName,
 	)
 
 	// register message routes and query routes
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
+	// register module invariants
+	app.mm.RegisterInvariants(&app.invariants)
+
 	// side router
 	app.sideRouter = types.NewSideRouter()
 	for _, m := range app.mm.Modules {
@@ -453,9 +525,14 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		checkpoint.NewAppModule(app.CheckpointKeeper, app.StakingKeeper, app.TopupKeeper, &app.caller),
 		bank.NewAppModule(app.BankKeeper, &app.caller),
 		bor.NewAppModule(app.BorKeeper, &app.caller),
+		clerk.NewAppModule(app.ClerkKeeper, &app.caller),
+		sidechannel.NewAppModule(app.SidechannelKeeper),
 	)
 	app.sm.RegisterStoreDecoders()
 
+	// register store migrations shipped with this binary
+	app.registerUpgradeHandlers()
+
 	// mount the multistore and load the latest state
 	app.MountKVStores(keys)
 	app.MountTransientStores(tkeys)
@@ -469,6 +546,9 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 			app.AccountKeeper,
 			app.ChainKeeper,
 			app.SupplyKeeper,
+			&app.StakingKeeper,
+			app.FeeGrantKeeper,
+			app.GovKeeper,
 			&app.caller,
 			auth.DefaultSigVerificationGasConsumer,
 		),
@@ -521,25 +601,25 @@ func (app *HeimdallApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain)
 		panic(fmt.Sprintf("%s module account has not been set", authTypes.FeeCollectorName))
 	}
 
+	// genesis state is in the layout of this binary, upgrades it ships are done
+	app.setUpgradesDone(ctx, genesisState)
+
 	// init genesis
 	app.mm.InitGenesis(ctx, genesisState)
 
 	stakingState := stakingTypes.GetGenesisStateFromAppState(genesisState)
 	checkpointState := checkpointTypes.GetGenesisStateFromAppState(genesisState)
 
-	// check if validator is current validator
-	// add to val updates else skip
+	// add the top MaxValidators current validators by power to val updates
 	var valUpdates []abci.ValidatorUpdate
-	for _, validator := range stakingState.Validators {
-		if validator.IsCurrentValidator(checkpointState.AckCount) {
-			// convert to Validator Update
-			updateVal := abci.ValidatorUpdate{
-				Power:  int64(validator.VotingPower),
-				PubKey: validator.PubKey.ABCIPubKey(),
-			}
-			// Add validator to validator updated to be processed below
-			valUpdates = append(valUpdates, updateVal)
+	for _, validator := range helper.GetActiveValidators(stakingState.Validators, checkpointState.AckCount, app.StakingKeeper.GetMaxValidators(ctx)) {
+		// convert to Validator Update
+		updateVal := abci.ValidatorUpdate{
+			Power:  int64(validator.VotingPower),
+			PubKey: validator.PubKey.ABCIPubKey(),
 		}
+		// Add validator to validator updated to be processed below
+		valUpdates = append(valUpdates, updateVal)
 	}
 
 	// TODO make sure old validtors dont go in validator updates ie deactivated validators have to be removed
@@ -556,13 +636,26 @@ func (app *HeimdallApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock
 		ctx,
 		types.BytesToHeimdallAddress(req.Header.GetProposerAddress()),
 	)
+
+	// apply scheduled upgrade or halt
+	app.applyUpgrade(ctx)
+
 	return app.mm.BeginBlock(ctx, req)
 }
 
 // EndBlocker executes on each end block
 func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	// transfer fees to current proposer
-	if proposer, ok := app.AccountKeeper.GetBlockProposer(ctx); ok {
+	// move treasury share of collected fees
+	if app.GovKeeper.IsUpgradeDone(ctx, types.UpgradeV03) {
+		app.TreasuryKeeper.FundFromFeeCollector(ctx)
+	}
+
+	proposer, hasProposer := app.AccountKeeper.GetBlockProposer(ctx)
+	if app.GovKeeper.IsUpgradeDone(ctx, types.UpgradeV03) {
+		// move remaining fees to fee reward pool, distributed to signers on next checkpoint ack
+		app.CheckpointKeeper.CollectFees(ctx)
+	} else if hasProposer {
+		// transfer fees to current proposer
 		moduleAccount := app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
 		amount := moduleAccount.GetCoins().AmountOf(authTypes.FeeToken)
 		if !amount.IsZero() {
@@ -571,12 +664,15 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 				logger.Error("EndBlocker | SendCoinsFromModuleToAccount", "Error", err)
 			}
 		}
+	}
 
-		// remove block proposer
+	// remove block proposer
+	if hasProposer {
 		app.AccountKeeper.RemoveBlockProposer(ctx)
 	}
 
 	var tmValUpdates []abci.ValidatorUpdate
+	var valStatusEvents sdk.Events
 
 	// --- Start update to new validators
 	currentValidatorSet := app.StakingKeeper.GetValidatorSet(ctx)
@@ -585,12 +681,16 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 
 	// get validator updates
 	setUpdates := helper.GetUpdatedValidators(
-		&currentValidatorSet, // pointer to current validator set -- UpdateValidators will modify it
-		allValidators,        // All validators
-		ackCount,             // ack count
+		&currentValidatorSet,                    // pointer to current validator set -- UpdateValidators will modify it
+		allValidators,                           // All validators
+		ackCount,                                // ack count
+		app.StakingKeeper.GetMaxValidators(ctx), // max validators in active set
 	)
 
 	if len(setUpdates) > 0 {
+		// active/standby status changes for validator updates
+		valStatusEvents = staking.ValidatorStatusEvents(&currentValidatorSet, setUpdates, allValidators, ackCount)
+
 		// create new validator set
 		if err := currentValidatorSet.UpdateWithChangeSet(setUpdates); err != nil {
 			// return with nothing
@@ -621,11 +721,12 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 	}
 
 	// end block
-	app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
 
 	// send validator updates to peppermint
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: tmValUpdates,
+		Events:           append(valStatusEvents.ToABCIEvents(), res.Events...),
 	}
 }
 
@@ -13,6 +13,8 @@ import (
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/simulation"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	simTypes "github.com/maticnetwork/heimdall/types/simulation"
 )
 
@@ -129,6 +131,46 @@ func TestValidateGenesis(t *testing.T) {
 	})
 }
 
+func TestInitChainerMaxValidators(t *testing.T) {
+	happ := NewHeimdallApp(log.NewNopLogger(), db.NewMemDB())
+	genesisState := NewDefaultGenesisState()
+
+	accounts := simTypes.RandomAccounts(rand.New(rand.NewSource(42)), 3)
+	validators := make([]*hmTypes.Validator, len(accounts))
+	for i, acc := range accounts {
+		validators[i] = hmTypes.NewValidator(
+			hmTypes.NewValidatorID(uint64(i+1)),
+			0,
+			0,
+			uint64(i),
+			int64(10*(i+1)), // power
+			hmTypes.NewPubKey(acc.PubKey.Bytes()),
+			acc.Address,
+		)
+	}
+
+	params := stakingTypes.DefaultParams()
+	params.MaxValidators = 2
+	genesisState[stakingTypes.ModuleName] = happ.Codec().MustMarshalJSON(
+		stakingTypes.NewGenesisState(params, validators, hmTypes.ValidatorSet{}, nil),
+	)
+
+	res := happ.InitChain(abci.RequestInitChain{AppStateBytes: happ.Codec().MustMarshalJSON(genesisState)})
+
+	// only the top validators by power are sent to tendermint
+	require.Len(t, res.Validators, 2)
+	require.Equal(t, validators[2].PubKey.ABCIPubKey(), res.Validators[0].PubKey)
+	require.Equal(t, validators[1].PubKey.ABCIPubKey(), res.Validators[1].PubKey)
+
+	// validator set matches, the remaining validator is stored on standby
+	happ.Commit()
+	ctx := happ.BaseApp.NewContext(true, abci.Header{})
+	validatorSet := happ.StakingKeeper.GetValidatorSet(ctx)
+	require.Len(t, validatorSet.Validators, 2)
+	require.False(t, validatorSet.HasAddress(validators[0].Signer.Bytes()))
+	require.Len(t, happ.StakingKeeper.GetAllValidators(ctx), 3)
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/gov"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestTextProposal(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{})
+
+	require.True(t, govTypes.IsValidProposalType(govTypes.ProposalTypeText))
+
+	content := govTypes.ContentFromProposalType("Checkpoint interval", "Approve checkpoint interval change next quarter", govTypes.ProposalTypeText)
+	require.Equal(t, govTypes.NewTextProposal("Checkpoint interval", "Approve checkpoint interval change next quarter"), content)
+	require.NoError(t, content.ValidateBasic())
+	require.Error(t, govTypes.NewTextProposal("", "description").ValidateBasic())
+
+	// submit proposal msg round trips through app codec
+	msg := govTypes.NewMsgSubmitProposal(content, sdk.NewCoins(), hmTypes.BytesToHeimdallAddress([]byte("proposer")), hmTypes.ValidatorID(1))
+	bz := happ.Codec().MustMarshalBinaryBare(msg)
+	var decoded govTypes.MsgSubmitProposal
+	happ.Codec().MustUnmarshalBinaryBare(bz, &decoded)
+	require.Equal(t, content, decoded.Content)
+
+	// passed text proposal does not change state
+	handler := gov.NewGovProposalHandler(&happ.GovKeeper)
+	require.NoError(t, handler(ctx, content))
+	_, found := happ.GovKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
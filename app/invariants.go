@@ -0,0 +1,70 @@
+package app
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// invariantRoute is an invariant registered by a module under a route
+type invariantRoute struct {
+	ModuleName string
+	Route      string
+	Invariant  sdk.Invariant
+}
+
+// upgradeInvariants are the invariants which hold once the upgrade introducing the tracked
+// state is applied, keyed by module and route
+var upgradeInvariants = map[string]string{
+	supplyTypes.ModuleName + "/total-supply":     types.UpgradeV03,
+	topupTypes.ModuleName + "/dividend-accounts": types.UpgradeV03,
+}
+
+// invariantRegistry collects module invariants to be checked on demand
+type invariantRegistry struct {
+	routes []invariantRoute
+}
+
+// RegisterRoute adds an invariant of a module to the registry
+func (ir *invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	ir.routes = append(ir.routes, invariantRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invariant:  invar,
+	})
+
+	// modules register in map order, keep routes deterministic
+	sort.SliceStable(ir.routes, func(i, j int) bool {
+		if ir.routes[i].ModuleName != ir.routes[j].ModuleName {
+			return ir.routes[i].ModuleName < ir.routes[j].ModuleName
+		}
+		return ir.routes[i].Route < ir.routes[j].Route
+	})
+}
+
+// CheckInvariants runs all registered module invariants against the given context
+// and returns the messages of the broken ones
+func (app *HeimdallApp) CheckInvariants(ctx sdk.Context) (broken []string) {
+	for _, ir := range app.invariants.routes {
+		if upgrade, ok := upgradeInvariants[ir.ModuleName+"/"+ir.Route]; ok && !app.GovKeeper.IsUpgradeDone(ctx, upgrade) {
+			continue
+		}
+
+		if msg, stop := ir.Invariant(ctx); stop {
+			broken = append(broken, msg)
+		}
+	}
+	return broken
+}
+
+// CheckInvariantsAtLatestHeight runs all registered module invariants against
+// the last committed state
+func (app *HeimdallApp) CheckInvariantsAtLatestHeight() []string {
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	return app.CheckInvariants(ctx)
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestCheckInvariants(t *testing.T) {
+	db := db.NewMemDB()
+	happ := NewHeimdallApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db)
+	require.NotEmpty(t, happ.invariants.routes)
+
+	stateBytes, err := codec.MarshalJSONIndent(happ.Codec(), NewDefaultGenesisState())
+	require.NoError(t, err)
+
+	happ.InitChain(
+		abci.RequestInitChain{
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: stateBytes,
+		},
+	)
+	happ.Commit()
+
+	// invariants hold on committed genesis state
+	newHapp := NewHeimdallApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db)
+	require.Empty(t, newHapp.CheckInvariantsAtLatestHeight())
+
+	// checkpoint stored without ACK
+	ctx := newHapp.NewContext(true, abci.Header{Height: newHapp.LastBlockHeight()})
+	checkpoint := hmTypes.CreateBlock(0, 256, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 0)
+	require.NoError(t, newHapp.CheckpointKeeper.AddCheckpoint(ctx, 10000, checkpoint))
+
+	broken := newHapp.CheckInvariants(ctx)
+	require.Len(t, broken, 1)
+	require.Contains(t, broken[0], "ack count")
+}
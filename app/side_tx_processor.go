@@ -8,8 +8,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 	"github.com/maticnetwork/heimdall/types"
 )
 
@@ -57,12 +60,34 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 		return
 	}
 
+	// vote records, side-tx voters and side-tx liveness are kept from v0.3
+	upgradeDone := app.GovKeeper.IsUpgradeDone(ctx, types.UpgradeV03)
+
 	// calculate power
 	var totalPower int64
 	for _, v := range validators {
 		totalPower = totalPower + v.Power
 	}
 
+	// validator ids for vote records
+	validatorIDs := make([]types.ValidatorID, len(validators))
+	for i, v := range validators {
+		if validator, err := app.StakingKeeper.GetValidatorInfo(ctx, v.Address); err == nil {
+			validatorIDs[i] = validator.ID
+		}
+	}
+
+	// side-tx liveness is tracked only when slashing is enabled
+	var slashingParams slashingTypes.Params
+	trackSideTxVotes := false
+	if upgradeDone {
+		slashingParams = app.SlashingKeeper.GetParams(ctx)
+		trackSideTxVotes = slashingParams.EnableSlashing
+	}
+
+	// missed votes of each validator on decided side-txs, in order
+	missedVotes := make([][]bool, len(validators))
+
 	// get empty events
 	events := sdk.EmptyEvents()
 
@@ -75,6 +100,10 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 			app.SidechannelKeeper.RemoveTx(ctx, targetHeight, txHash)
 
 			usedValidator := make(map[int]bool)
+			var yesVoters []abci.Validator
+
+			// vote record for audit
+			voteRecord := sidechannelTypes.NewSideTxVoteRecord(txHash, targetHeight, validatorIDs)
 
 			// signed power
 			signedPower := make(map[abci.SideTxResultType]int64)
@@ -89,6 +118,11 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 					if _, ok := usedValidator[i]; !ok {
 						signedPower[sigObj.Result] = signedPower[sigObj.Result] + validators[i].Power
 						usedValidator[i] = true
+						voteRecord.SetVote(i, sigObj.Result)
+
+						if sigObj.Result == abci.SideTxResultType_Yes {
+							yesVoters = append(yesVoters, validators[i])
+						}
 					}
 				}
 			}
@@ -100,20 +134,51 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 				// approved
 				logger.Debug("[sidechannel] Approved side-tx", "txHash", hex.EncodeToString(tx.Hash()))
 
+				// keep yes voters available to post handlers (used for fee rewards)
+				if upgradeDone {
+					if err := app.SidechannelKeeper.SetTxVoters(ctx, txHash, yesVoters); err != nil {
+						logger.Error("[sidechannel] Unable to set side-tx voters", "error", err)
+					}
+				}
+
 				// execute tx with `yes`
+				voteRecord.Result = abci.SideTxResultType_Yes
 				result = app.runTx(ctx, tx, abci.SideTxResultType_Yes)
+
+				if upgradeDone {
+					app.SidechannelKeeper.RemoveTxVoters(ctx, txHash)
+				}
+				app.removeStagedSideTx(ctx, tx)
 			} else if signedPower[abci.SideTxResultType_No] >= (totalPower*2/3 + 1) {
 				// rejected
 				logger.Debug("[sidechannel] Rejected side-tx", "txHash", hex.EncodeToString(tx.Hash()))
 
 				// execute tx with `no`
+				voteRecord.Result = abci.SideTxResultType_No
 				result = app.runTx(ctx, tx, abci.SideTxResultType_No)
+
+				app.removeStagedSideTx(ctx, tx)
 			} else {
 				// skipped
 				logger.Debug("[sidechannel] Skipped side-tx", "txHash", hex.EncodeToString(tx.Hash()))
 
-				// execute tx with `skip`
-				result = app.runTx(ctx, tx, abci.SideTxResultType_Skip)
+				// requeue or execute tx with `skip`
+				result.Events = app.skipSideTx(ctx, tx)
+			}
+
+			// save vote record
+			if upgradeDone {
+				if err := app.SidechannelKeeper.SetVoteRecord(ctx, voteRecord); err != nil {
+					logger.Error("[sidechannel] Unable to set side-tx vote record", "error", err)
+				}
+			}
+
+			// track missed votes on decided side-tx (absent or skip while majority decided yes/no)
+			if trackSideTxVotes && voteRecord.Result != abci.SideTxResultType_Skip {
+				for i := range validators {
+					vote, voted := voteRecord.GetVote(i)
+					missedVotes[i] = append(missedVotes[i], !voted || vote == abci.SideTxResultType_Skip)
+				}
 			}
 
 			// add events
@@ -121,6 +186,15 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 		}
 	}
 
+	// handle votes of each validator on decided side-txs
+	if trackSideTxVotes {
+		for i, v := range validators {
+			if err := app.SlashingKeeper.HandleValidatorSideTxVotes(ctx, slashingParams, v.Address, missedVotes[i]); err != nil {
+				logger.Error("[sidechannel] Unable to handle side-tx votes for validator", "error", err)
+			}
+		}
+	}
+
 	// remove all pending txs before exiting
 	txs := app.SidechannelKeeper.GetTxs(ctx, targetHeight)
 	for _, tx := range txs {
@@ -129,11 +203,20 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 		// skipped
 		logger.Debug("[sidechannel] Skipped side-tx", "txHash", hex.EncodeToString(tx.Hash()))
 
-		// execute tx with `skip`
-		result := app.runTx(ctx, tx, abci.SideTxResultType_Skip)
+		// save vote record without votes
+		if upgradeDone {
+			if err := app.SidechannelKeeper.SetVoteRecord(ctx, sidechannelTypes.NewSideTxVoteRecord(tx.Hash(), targetHeight, validatorIDs)); err != nil {
+				logger.Error("[sidechannel] Unable to set side-tx vote record", "error", err)
+			}
+		}
+
+		// requeue or execute tx with `skip`
+		events = events.AppendEvents(app.skipSideTx(ctx, tx))
+	}
 
-		// add events
-		events = events.AppendEvents(result.Events)
+	// remove vote records out of retention window
+	if upgradeDone {
+		app.SidechannelKeeper.PruneVoteRecords(ctx, height)
 	}
 
 	// set event to response
@@ -150,7 +233,7 @@ func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abc
 	result := abci.SideTxResultType_Skip
 	data := make([]byte, 0)
 
-	for _, msg := range tx.GetMsgs() {
+	for _, msg := range app.expandRetryMsgs(ctx, tx.GetMsgs()) {
 		sideMsg, isSideTxMsg := msg.(types.SideTxMsg)
 
 		// match message route
@@ -219,7 +302,7 @@ func (app *HeimdallApp) runTx(ctx sdk.Context, txBytes []byte, sideTxResult abci
 	// Create a new context based off of the existing context with a cache wrapped
 	// multi-store in case message processing fails.
 	runMsgCtx, msCache := app.cacheTxContext(ctx, txBytes)
-	result = app.runMsgs(runMsgCtx, tx.GetMsgs(), sideTxResult)
+	result = app.runMsgs(runMsgCtx, app.expandRetryMsgs(ctx, tx.GetMsgs()), sideTxResult)
 	// only update state if all messages pass
 	if result.IsOK() {
 		msCache.Write()
@@ -273,6 +356,70 @@ func (app *HeimdallApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, sideTxResult ab
 	}
 }
 
+// skipSideTx requeues skipped side-tx for another vote round while retries are left,
+// otherwise executes it with `skip`. Skipped retry gets its staged side-tx requeued.
+// Side-txs are not requeued before v0.3.
+func (app *HeimdallApp) skipSideTx(ctx sdk.Context, tx tmTypes.Tx) sdk.Events {
+	if !app.GovKeeper.IsUpgradeDone(ctx, types.UpgradeV03) {
+		return app.runTx(ctx, tx, abci.SideTxResultType_Skip).Events
+	}
+
+	if retry, ok := app.getStagedSideTx(ctx, tx); ok {
+		tx = retry.Tx
+	}
+
+	events, requeued := app.SidechannelKeeper.RequeueSideTx(ctx, tx)
+	if requeued {
+		return events
+	}
+
+	// execute tx with `skip`
+	result := app.runTx(ctx, tx, abci.SideTxResultType_Skip)
+	return events.AppendEvents(result.Events)
+}
+
+// removeStagedSideTx removes staged side-tx once its retry is decided
+func (app *HeimdallApp) removeStagedSideTx(ctx sdk.Context, tx tmTypes.Tx) {
+	if retry, ok := app.getStagedSideTx(ctx, tx); ok {
+		app.SidechannelKeeper.RemoveSideTxRetry(ctx, retry.TxHash)
+	}
+}
+
+// getStagedSideTx returns staged side-tx if tx is a side-tx retry
+func (app *HeimdallApp) getStagedSideTx(ctx sdk.Context, txBytes []byte) (retry sidechannelTypes.SideTxRetry, found bool) {
+	tx, err := authTypes.DefaultTxDecoder(app.cdc)(txBytes)
+	if err != nil {
+		return retry, false
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if retryMsg, ok := msg.(sidechannelTypes.MsgRetrySideTx); ok {
+			return app.SidechannelKeeper.GetSideTxRetry(ctx, retryMsg.TxHash)
+		}
+	}
+
+	return retry, false
+}
+
+// expandRetryMsgs replaces side-tx retry messages with messages of staged side-tx
+func (app *HeimdallApp) expandRetryMsgs(ctx sdk.Context, msgs []sdk.Msg) []sdk.Msg {
+	result := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		if retryMsg, ok := msg.(sidechannelTypes.MsgRetrySideTx); ok {
+			if retry, found := app.SidechannelKeeper.GetSideTxRetry(ctx, retryMsg.TxHash); found {
+				if stagedTx, err := authTypes.DefaultTxDecoder(app.cdc)(retry.Tx); err == nil {
+					result = append(result, stagedTx.GetMsgs()...)
+					continue
+				}
+			}
+		}
+
+		result = append(result, msg)
+	}
+
+	return result
+}
+
 // cacheTxContext returns a new context based off of the provided context with
 // a cache wrapped multi-store.
 func (app *HeimdallApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context, sdk.CacheMultiStore) {
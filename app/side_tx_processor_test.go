@@ -13,6 +13,11 @@ import (
 
 	app "github.com/maticnetwork/heimdall/app"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingSim "github.com/maticnetwork/heimdall/staking/simulation"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -332,6 +337,17 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 				})
 				require.Equal(t, 0, len(res.Events), "It should have no event")
 				require.Nil(t, happ.SidechannelKeeper.GetTx(ctx, height-2, txHash), "Tx should not be present in store after begin block")
+
+				// votes should be recorded
+				record, found := happ.SidechannelKeeper.GetVoteRecord(ctx, txHash)
+				require.True(t, found, "Vote record should be present after begin block")
+				require.Equal(t, abci.SideTxResultType(value), record.Result)
+				require.Equal(t, height-2, record.Height)
+				for i := range record.Validators {
+					vote, voted := record.GetVote(i)
+					require.True(t, voted)
+					require.Equal(t, abci.SideTxResultType(value), vote)
+				}
 			})
 		}
 
@@ -341,6 +357,41 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 			res = happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{})
 			require.Equal(t, 0, len(res.Events), "It should have no event with validators")
 			require.Nil(t, happ.SidechannelKeeper.GetTx(ctx, height-2, txHash), "Tx should not be present in store after begin block")
+
+			// skipped tx should be recorded without votes
+			record, found := happ.SidechannelKeeper.GetVoteRecord(ctx, txHash)
+			require.True(t, found, "Vote record should be present after begin block")
+			require.Equal(t, abci.SideTxResultType_Skip, record.Result)
+			_, voted := record.GetVote(0)
+			require.False(t, voted)
+		})
+
+		t.Run("NoVoteRecordBeforeUpgrade", func(t *testing.T) {
+			ctx.KVStore(happ.GetKey(govTypes.StoreKey)).Delete(govTypes.DoneUpgradeKey(hmTypes.UpgradeV03))
+			defer happ.GovKeeper.SetUpgradeDone(ctx, hmTypes.UpgradeV03)
+
+			happ.SidechannelKeeper.RemoveVoteRecord(ctx, txHash)
+			happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+			happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{})
+			require.Nil(t, happ.SidechannelKeeper.GetTx(ctx, height-2, txHash), "Tx should not be present in store after begin block")
+
+			_, found := happ.SidechannelKeeper.GetVoteRecord(ctx, txHash)
+			require.False(t, found, "Vote record should not be kept before the upgrade")
+		})
+
+		t.Run("NoSideTxTrackingBeforeUpgrade", func(t *testing.T) {
+			ctx.KVStore(happ.GetKey(govTypes.StoreKey)).Delete(govTypes.DoneUpgradeKey(hmTypes.UpgradeV03))
+			defer happ.GovKeeper.SetUpgradeDone(ctx, hmTypes.UpgradeV03)
+
+			// side-tx window is missing on a chain started before the upgrade
+			slashingParams := happ.SlashingKeeper.GetParams(ctx)
+			defer happ.SlashingKeeper.SetParams(ctx, slashingParams)
+			paramKey := append([]byte(slashingTypes.DefaultParamspace+"/"), slashingTypes.KeySideTxWindow...)
+			ctx.KVStore(happ.GetKey(paramsTypes.StoreKey)).Delete(paramKey)
+
+			happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+			require.NotPanics(t, func() { happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{}) })
+			require.Nil(t, happ.SidechannelKeeper.GetTx(ctx, height-2, txHash), "Tx should not be present in store after begin block")
 		})
 	})
 
@@ -501,6 +552,182 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 			require.Equal(t, 0, len(happ.SidechannelKeeper.GetTxs(ctx, 900)), "It shouldn't save state after failed post-tx execution")
 		}
 	})
+
+	t.Run("SideTxLiveness", func(t *testing.T) {
+		var height int64 = 30
+		ctx = ctx.WithBlockHeight(height)
+
+		// enable slashing
+		params := happ.SlashingKeeper.GetParams(ctx)
+		params.EnableSlashing = true
+		happ.SlashingKeeper.SetParams(ctx, params)
+
+		// staking validators with signing info
+		validators := stakingSim.GenRandomVal(4, 0, 10, 10, false, 1)
+		abciValidators := make([]abci.Validator, len(validators))
+		for i, validator := range validators {
+			require.NoError(t, happ.StakingKeeper.AddValidator(ctx, validator))
+			happ.SlashingKeeper.SetValidatorSigningInfo(ctx, validator.ID, hmTypes.NewValidatorSigningInfo(validator.ID, 0, 0, 0))
+			abciValidators[i] = abci.Validator{Address: validator.Signer.Bytes(), Power: 10}
+		}
+		happ.SidechannelKeeper.SetValidators(ctx, height, abciValidators)
+
+		// setup router and handler
+		router := hmTypes.NewSideRouter()
+		handler := &hmTypes.SideHandlers{
+			SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+				return abci.ResponseDeliverSideTx{}
+			},
+			PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
+				return sdk.Result{}
+			},
+		}
+		router.AddRoute(routeMsgSideCounter, handler)
+		happ.SetSideRouter(router)
+
+		// last validator votes skip
+		happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+		happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{
+			SideTxResults: []abci.SideTxResult{
+				{
+					TxHash: txHash,
+					Sigs: []abci.SideTxSig{
+						{Result: abci.SideTxResultType_Yes, Address: abciValidators[0].Address},
+						{Result: abci.SideTxResultType_Yes, Address: abciValidators[1].Address},
+						{Result: abci.SideTxResultType_Yes, Address: abciValidators[2].Address},
+						{Result: abci.SideTxResultType_Skip, Address: abciValidators[3].Address},
+					},
+				},
+			},
+		})
+
+		// majority decided yes, only skip vote is missed
+		for i, validator := range validators {
+			signInfo, found := happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+			require.True(t, found)
+			require.Equal(t, int64(1), signInfo.SideTxIndexOffset)
+			if i == 3 {
+				require.Equal(t, int64(1), signInfo.MissedSideTxsCounter)
+			} else {
+				require.Equal(t, int64(0), signInfo.MissedSideTxsCounter)
+			}
+		}
+
+		// undecided side-tx is not tracked
+		happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+		happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{})
+		for _, validator := range validators {
+			signInfo, _ := happ.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+			require.Equal(t, int64(1), signInfo.SideTxIndexOffset)
+		}
+	})
+
+	t.Run("SideTxRetry", func(t *testing.T) {
+		var height int64 = 40
+		ctx = ctx.WithBlockHeight(height)
+
+		addr1 := []byte("hello-1")
+		addr2 := []byte("hello-2")
+		validators := []abci.Validator{
+			{Address: addr1, Power: 10},
+			{Address: addr2, Power: 20},
+		}
+		happ.SidechannelKeeper.SetValidators(ctx, height, validators)
+
+		// allow one retry
+		happ.SidechannelKeeper.SetParams(ctx, sidechannelTypes.NewParams(1, 10, sidechannelTypes.DefaultVoteRecordRetention))
+
+		// record results passed to post-tx handler
+		var postResults []abci.SideTxResultType
+		router := hmTypes.NewSideRouter()
+		router.AddRoute(routeMsgSideCounter, &hmTypes.SideHandlers{
+			SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+				return abci.ResponseDeliverSideTx{Result: abci.SideTxResultType_Yes}
+			},
+			PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
+				postResults = append(postResults, sideTxResult)
+				return sdk.Result{}
+			},
+		})
+		happ.SetSideRouter(router)
+
+		// retry tx carrying the staged side-tx
+		retryTx := hmTypes.BaseTx{
+			Msg: sidechannelTypes.NewMsgRetrySideTx(hmTypes.BytesToHeimdallAddress(addr1), txHash),
+		}
+		retryTxBytes, err := encoder(retryTx)
+		require.Nil(t, err)
+		retryTxHash := tmTypes.Tx(retryTxBytes).Hash()
+
+		requeue := func(t *testing.T) {
+			happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+			res := happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{})
+			require.Equal(t, 1, len(res.Events))
+			require.Equal(t, sidechannelTypes.EventTypeSideTxRequeue, res.Events[0].Type)
+			require.Empty(t, postResults, "Requeued side-tx should not be executed")
+
+			retry, found := happ.SidechannelKeeper.GetSideTxRetry(ctx, txHash)
+			require.True(t, found)
+			require.Equal(t, uint64(1), retry.Retries)
+			require.Equal(t, height+10, retry.NextHeight)
+		}
+
+		t.Run("SkipBeforeUpgrade", func(t *testing.T) {
+			ctx.KVStore(happ.GetKey(govTypes.StoreKey)).Delete(govTypes.DoneUpgradeKey(hmTypes.UpgradeV03))
+			defer happ.GovKeeper.SetUpgradeDone(ctx, hmTypes.UpgradeV03)
+			defer func() { postResults = nil }()
+
+			happ.SidechannelKeeper.SetTx(ctx, height-2, txBytes)
+			res := happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{})
+			for _, event := range res.Events {
+				require.NotEqual(t, sidechannelTypes.EventTypeSideTxRequeue, event.Type)
+			}
+			require.Equal(t, []abci.SideTxResultType{abci.SideTxResultType_Skip}, postResults, "Side-tx should be executed with skip before the upgrade")
+
+			_, found := happ.SidechannelKeeper.GetSideTxRetry(ctx, txHash)
+			require.False(t, found, "Side-tx should not be staged before the upgrade")
+		})
+
+		t.Run("Requeue", requeue)
+
+		t.Run("DeliverSideTx", func(t *testing.T) {
+			res := happ.DeliverSideTxHandler(ctx, retryTx, abci.RequestDeliverSideTx{Tx: retryTxBytes})
+			require.Equal(t, abci.SideTxResultType_Yes, res.GetResult(), "Retry should be voted with staged side-tx")
+		})
+
+		t.Run("Approved", func(t *testing.T) {
+			happ.SidechannelKeeper.SetTx(ctx, height-2, retryTxBytes)
+			happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{
+				SideTxResults: []abci.SideTxResult{
+					{
+						TxHash: retryTxHash,
+						Sigs: []abci.SideTxSig{
+							{Result: abci.SideTxResultType_Yes, Address: addr1},
+							{Result: abci.SideTxResultType_Yes, Address: addr2},
+						},
+					},
+				},
+			})
+			require.Equal(t, []abci.SideTxResultType{abci.SideTxResultType_Yes}, postResults)
+
+			_, found := happ.SidechannelKeeper.GetSideTxRetry(ctx, txHash)
+			require.False(t, found, "Staged side-tx should be removed after decision")
+		})
+
+		postResults = nil
+		t.Run("RequeueAgain", requeue)
+
+		t.Run("GiveUp", func(t *testing.T) {
+			happ.SidechannelKeeper.SetTx(ctx, height-2, retryTxBytes)
+			res := happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{})
+			require.Equal(t, 1, len(res.Events))
+			require.Equal(t, sidechannelTypes.EventTypeSideTxGiveUp, res.Events[0].Type)
+			require.Equal(t, []abci.SideTxResultType{abci.SideTxResultType_Skip}, postResults, "Staged side-tx should be executed with skip")
+
+			_, found := happ.SidechannelKeeper.GetSideTxRetry(ctx, txHash)
+			require.False(t, found)
+		})
+	})
 }
 
 //
@@ -511,6 +738,9 @@ func registerTestCodec(cdc *codec.Codec) {
 	// register Tx, Msg
 	sdk.RegisterCodec(cdc)
 
+	// register side-tx retry
+	sidechannelTypes.RegisterCodec(cdc)
+
 	// register test types
 	cdc.RegisterConcrete(&msgCounter{}, "cosmos-sdk/baseapp/msgCounter", nil)
 	cdc.RegisterConcrete(&msgSideCounter{}, "cosmos-sdk/baseapp/msgSideCounter", nil)
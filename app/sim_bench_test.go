@@ -26,9 +26,10 @@ func BenchmarkFullAppSimulation(b *testing.B) {
 	app := NewHeimdallApp(logger, db)
 
 	// run randomized simulation
+	sideChannel := SimulationSideChannel(app)
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		b, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.SimulationManager()),
-		SimulationOperations(app, app.Codec(), config),
+		SimulationOperations(app, app.Codec(), config, sideChannel), sideChannel,
 		app.ModuleAccountAddrs(), config,
 	)
 
@@ -65,9 +66,10 @@ func BenchmarkInvariants(b *testing.B) {
 	app := NewHeimdallApp(logger, db)
 
 	// run randomized simulation
+	sideChannel := SimulationSideChannel(app)
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		b, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.SimulationManager()),
-		SimulationOperations(app, app.Codec(), config),
+		SimulationOperations(app, app.Codec(), config, sideChannel), sideChannel,
 		app.ModuleAccountAddrs(), config,
 	)
 
@@ -17,11 +17,14 @@ import (
 
 	"github.com/maticnetwork/heimdall/app/helpers"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	paramTypes "github.com/maticnetwork/heimdall/params/types"
 	"github.com/maticnetwork/heimdall/simulation"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 )
 
 // Get flags every time the simulator is run
@@ -58,9 +61,10 @@ func TestFullAppSimulation(t *testing.T) {
 	require.Equal(t, AppName, app.Name())
 
 	// run randomized simulation
+	sideChannel := SimulationSideChannel(app)
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.SimulationManager()),
-		SimulationOperations(app, app.Codec(), config),
+		SimulationOperations(app, app.Codec(), config, sideChannel), sideChannel,
 		app.ModuleAccountAddrs(), config,
 	)
 
@@ -90,9 +94,10 @@ func TestAppImportExport(t *testing.T) {
 	require.Equal(t, AppName, app.Name())
 
 	// Run randomized simulation
+	sideChannel := SimulationSideChannel(app)
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.SimulationManager()),
-		SimulationOperations(app, app.Codec(), config),
+		SimulationOperations(app, app.Codec(), config, sideChannel), sideChannel,
 		app.ModuleAccountAddrs(), config,
 	)
 
@@ -140,6 +145,9 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[supplyTypes.StoreKey], newApp.keys[supplyTypes.StoreKey], [][]byte{}},
 		{app.keys[paramTypes.StoreKey], newApp.keys[paramTypes.StoreKey], [][]byte{}},
 		{app.keys[govTypes.StoreKey], newApp.keys[govTypes.StoreKey], [][]byte{}},
+		{app.keys[checkpointTypes.StoreKey], newApp.keys[checkpointTypes.StoreKey], [][]byte{}},
+		{app.keys[clerkTypes.StoreKey], newApp.keys[clerkTypes.StoreKey], [][]byte{}},
+		{app.keys[topupTypes.StoreKey], newApp.keys[topupTypes.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -167,9 +175,10 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	require.Equal(t, AppName, app.Name())
 
 	// Run randomized simulation
+	sideChannel := SimulationSideChannel(app)
 	stopEarly, simParams, simErr := simulation.SimulateFromSeed(
 		t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.SimulationManager()),
-		SimulationOperations(app, app.Codec(), config),
+		SimulationOperations(app, app.Codec(), config, sideChannel), sideChannel,
 		app.ModuleAccountAddrs(), config,
 	)
 
@@ -208,9 +217,11 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	newApp.InitChain(abci.RequestInitChain{
 		AppStateBytes: appState,
 	})
+
+	sideChannel = SimulationSideChannel(newApp)
 	stopEarly, _, err = simulation.SimulateFromSeed(
 		t, os.Stdout, newApp.BaseApp, AppStateFn(app.Codec(), app.SimulationManager()),
-		SimulationOperations(newApp, newApp.Codec(), config),
+		SimulationOperations(newApp, newApp.Codec(), config, sideChannel), sideChannel,
 		newApp.ModuleAccountAddrs(), config,
 	)
 	require.False(t, stopEarly)
@@ -261,9 +272,10 @@ func TestAppStateDeterminism(t *testing.T) {
 				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
 			)
 
+			sideChannel := SimulationSideChannel(app)
 			_, _, err = simulation.SimulateFromSeed(
 				t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.SimulationManager()),
-				SimulationOperations(app, app.Codec(), config),
+				SimulationOperations(app, app.Codec(), config, sideChannel), sideChannel,
 				app.ModuleAccountAddrs(), config,
 			)
 			require.NoError(t, err)
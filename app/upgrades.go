@@ -0,0 +1,161 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	borLegacy "github.com/maticnetwork/heimdall/bor/legacy/v0_3"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	clerkLegacy "github.com/maticnetwork/heimdall/clerk/legacy/v0_3"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/gov"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	sidechannelLegacy "github.com/maticnetwork/heimdall/sidechannel/legacy/v0_3"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	slashingLegacy "github.com/maticnetwork/heimdall/slashing/legacy/v0_3"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingLegacy "github.com/maticnetwork/heimdall/staking/legacy/v0_3"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+	treasuryTypes "github.com/maticnetwork/heimdall/treasury/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// registerUpgradeHandlers registers store migrations for the upgrades known to this binary.
+// New upgrades are added here as `app.RegisterUpgradeHandler("<name>", handler)`.
+func (app *HeimdallApp) registerUpgradeHandlers() {
+	app.RegisterUpgradeHandler(hmTypes.UpgradeV03, app.upgradeV03)
+}
+
+// upgradeV03 migrates the store of a v0.2 chain to v0.3.
+// Fee granter txs are accepted once it is applied.
+func (app *HeimdallApp) upgradeV03(ctx sdk.Context, plan govTypes.Plan) {
+	// topups were not added to total supply before, it is reset to the coins held by accounts
+	totalSupply := sdk.NewCoins()
+	app.AccountKeeper.IterateAccounts(ctx, func(acc authTypes.Account) (stop bool) {
+		totalSupply = totalSupply.Add(acc.GetCoins())
+		return false
+	})
+	app.SupplyKeeper.SetSupply(ctx, supplyTypes.NewSupply(totalSupply))
+
+	// topups were not recorded before, total topups is backed by dividend accounts and supply
+	totalTopups, err := app.TopupKeeper.EstimateTotalTopups(ctx)
+	if err != nil {
+		panic(err)
+	}
+	app.TopupKeeper.SetTotalTopups(ctx, totalTopups)
+
+	// validator limit was added to staking params, it is raised to the size of the current
+	// validator set so no validator moves to standby on upgrade
+	stakingSubspace := app.subspaces[stakingTypes.ModuleName]
+	if !stakingSubspace.Has(ctx, stakingTypes.KeyMaxValidators) {
+		maxValidators := stakingLegacy.DefaultParams.MaxValidators
+		if size := uint64(len(app.StakingKeeper.GetValidatorSet(ctx).Validators)); size > maxValidators {
+			maxValidators = size
+		}
+		stakingSubspace.Set(ctx, stakingTypes.KeyMaxValidators, maxValidators)
+	}
+
+	// state-sync records were not indexed by receiver contract before
+	for _, record := range app.ClerkKeeper.GetAllEventRecords(ctx) {
+		if err := app.ClerkKeeper.SetEventRecordWithContract(ctx, *record); err != nil {
+			panic(err)
+		}
+	}
+
+	// params added in v0.3 are set to the defaults of a migrated v0.2 genesis
+	for _, param := range upgradeV03Params() {
+		subspace := app.subspaces[param.module]
+		if !subspace.Has(ctx, param.key) {
+			subspace.Set(ctx, param.key, param.value)
+		}
+	}
+}
+
+// upgradeParam is a param added by an upgrade along with its value on upgraded chain
+type upgradeParam struct {
+	module string
+	key    []byte
+	value  interface{}
+}
+
+// upgradeV03Params returns the params added in v0.3, except staking validator limit which
+// depends on the validator set
+func upgradeV03Params() []upgradeParam {
+	return []upgradeParam{
+		{authTypes.ModuleName, authTypes.KeyMsgFees, []authTypes.MsgFee{}},
+		{borTypes.ModuleName, borTypes.KeyProducerSelectionAlgorithm, borLegacy.ProducerSelectionAlgorithm},
+		{borTypes.ModuleName, borTypes.KeyMaxProducerSlots, borLegacy.MaxProducerSlots},
+		{borTypes.ModuleName, borTypes.KeyMinSeedReveals, borLegacy.MinSeedReveals},
+		{clerkTypes.ModuleName, clerkTypes.KeyPruneDeliveredRecords, clerkLegacy.DefaultParams.PruneDeliveredRecords},
+		{clerkTypes.ModuleName, clerkTypes.KeyRecordRetentionPeriod, clerkLegacy.DefaultParams.RecordRetentionPeriod},
+		{treasuryTypes.ModuleName, treasuryTypes.KeyFeeFraction, sdk.ZeroDec()},
+		{slashingTypes.ModuleName, slashingTypes.KeySideTxWindow, slashingLegacy.SideTxWindow},
+		{slashingTypes.ModuleName, slashingTypes.KeyMinSideTxParticipation, slashingLegacy.MinSideTxParticipation},
+		{slashingTypes.ModuleName, slashingTypes.KeySlashFractionSideTxDowntime, slashingLegacy.SlashFractionSideTxDowntime},
+		{slashingTypes.ModuleName, slashingTypes.KeyMaxBorEvidenceSpans, slashingLegacy.MaxBorEvidenceSpans},
+		{sidechannelTypes.ModuleName, sidechannelTypes.KeyMaxSideTxRetries, sidechannelLegacy.DefaultParams.MaxSideTxRetries},
+		{sidechannelTypes.ModuleName, sidechannelTypes.KeySideTxRetryBackoff, sidechannelLegacy.DefaultParams.SideTxRetryBackoff},
+		{sidechannelTypes.ModuleName, sidechannelTypes.KeyVoteRecordRetention, sidechannelLegacy.DefaultParams.VoteRecordRetention},
+	}
+}
+
+// RegisterUpgradeHandler registers an upgrade handler for the named upgrade.
+// The handler runs at the height of the passed SoftwareUpgradeProposal plan with the same name.
+func (app *HeimdallApp) RegisterUpgradeHandler(name string, handler govTypes.UpgradeHandler) {
+	if _, ok := app.upgradeHandlers[name]; ok {
+		panic(fmt.Sprintf("upgrade handler for %s is already registered", name))
+	}
+
+	app.upgradeHandlers[name] = handler
+}
+
+// setUpgradesDone marks the registered upgrades as done at genesis, except the one a genesis
+// exported before its height still has scheduled. It runs before module genesis, so modules
+// init their state in the layout of the done upgrades.
+func (app *HeimdallApp) setUpgradesDone(ctx sdk.Context, genesisState GenesisState) {
+	var govState gov.GenesisState
+	if bz, ok := genesisState[govTypes.ModuleName]; ok {
+		app.cdc.MustUnmarshalJSON(bz, &govState)
+	}
+
+	for name := range app.upgradeHandlers {
+		if govState.UpgradePlan != nil && govState.UpgradePlan.Name == name {
+			continue
+		}
+
+		app.GovKeeper.SetUpgradeDone(ctx, name)
+	}
+}
+
+// applyUpgrade runs upgrade handler once scheduled upgrade height is reached.
+// Node is halted if upgrade is due but no handler is registered, or if new binary is started too early.
+func (app *HeimdallApp) applyUpgrade(ctx sdk.Context) {
+	plan, found := app.GovKeeper.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	handler, ok := app.upgradeHandlers[plan.Name]
+	if plan.ShouldExecute(ctx) {
+		if !ok {
+			// we don't have an upgrade handler for this upgrade name, meaning this software is out of date
+			msg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
+			logger.Error(msg)
+			panic(msg)
+		}
+
+		logger.Info(fmt.Sprintf("applying upgrade \"%s\" at %s", plan.Name, plan.DueAt()))
+		app.GovKeeper.ApplyUpgrade(ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()), plan, handler)
+		return
+	}
+
+	// if we have a pending upgrade, but it is not yet time, make sure we did not set the handler already
+	if ok {
+		msg := fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE \"%s\" - in binary but not executed on chain", plan.Name)
+		logger.Error(msg)
+		panic(msg)
+	}
+}
@@ -0,0 +1,356 @@
+package app
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	borLegacy "github.com/maticnetwork/heimdall/bor/legacy/v0_3"
+	clerkLegacy "github.com/maticnetwork/heimdall/clerk/legacy/v0_3"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/gov"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	sidechannelLegacy "github.com/maticnetwork/heimdall/sidechannel/legacy/v0_3"
+	slashingLegacy "github.com/maticnetwork/heimdall/slashing/legacy/v0_3"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingLegacy "github.com/maticnetwork/heimdall/staking/legacy/v0_3"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/topup"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	treasuryTypes "github.com/maticnetwork/heimdall/treasury/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+func TestSoftwareUpgradeProposal(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	handler := gov.NewGovProposalHandler(&happ.GovKeeper)
+
+	// invalid plans are rejected
+	err := handler(ctx, govTypes.NewSoftwareUpgradeProposal("title", "description", govTypes.NewPlan("", 20, "")))
+	require.Error(t, err)
+
+	err = handler(ctx, govTypes.NewSoftwareUpgradeProposal("title", "description", govTypes.NewPlan("v2", 5, "")))
+	require.Error(t, err, "upgrade in the past should be rejected")
+
+	err = handler(ctx, govTypes.NewSoftwareUpgradeProposal("title", "description", govTypes.NewPlan("v2", 20, "commit")))
+	require.NoError(t, err)
+
+	plan, found := happ.GovKeeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, govTypes.NewPlan("v2", 20, "commit"), plan)
+
+	// nothing happens before upgrade height
+	require.NotPanics(t, func() { happ.applyUpgrade(ctx) })
+
+	// node halts at upgrade height without upgrade handler
+	upgradeCtx := ctx.WithBlockHeight(20)
+	require.PanicsWithValue(t, `UPGRADE "v2" NEEDED at height: 20: commit`, func() { happ.applyUpgrade(upgradeCtx) })
+
+	// new binary started before upgrade height
+	var applied bool
+	happ.RegisterUpgradeHandler("v2", func(ctx sdk.Context, plan govTypes.Plan) {
+		applied = true
+	})
+	require.Panics(t, func() { happ.applyUpgrade(ctx) })
+	require.False(t, applied)
+
+	// new binary applies the upgrade at upgrade height
+	happ.applyUpgrade(upgradeCtx)
+	require.True(t, applied)
+
+	_, found = happ.GovKeeper.GetUpgradePlan(upgradeCtx)
+	require.False(t, found, "plan should be cleared once applied")
+	require.Equal(t, int64(20), happ.GovKeeper.GetDoneHeight(upgradeCtx, "v2"))
+
+	// upgrade name cannot be reused
+	err = handler(upgradeCtx, govTypes.NewSoftwareUpgradeProposal("title", "description", govTypes.NewPlan("v2", 30, "")))
+	require.Error(t, err)
+}
+
+func TestExportImportUpgrades(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	// apply one upgrade and schedule another
+	require.NoError(t, happ.GovKeeper.ScheduleUpgrade(ctx, govTypes.NewPlan("v1", 15, "")))
+	happ.GovKeeper.ApplyUpgrade(ctx.WithBlockHeight(15), govTypes.NewPlan("v1", 15, ""), func(sdk.Context, govTypes.Plan) {})
+	require.NoError(t, happ.GovKeeper.ScheduleUpgrade(ctx, govTypes.NewPlan("v2", 20, "commit")))
+
+	genState := gov.ExportGenesis(ctx, happ.GovKeeper)
+	require.Equal(t, &govTypes.Plan{Name: "v2", Height: 20, Info: "commit"}, genState.UpgradePlan)
+	require.Equal(t, []govTypes.DoneUpgrade{{Name: hmTypes.UpgradeV03, Height: 0}, {Name: "v1", Height: 15}}, genState.DoneUpgrades)
+	require.NoError(t, gov.ValidateGenesis(genState))
+
+	// pending upgrade and done upgrades survive import
+	happ2 := Setup(false)
+	ctx2 := happ2.BaseApp.NewContext(false, abci.Header{})
+	gov.InitGenesis(ctx2, happ2.GovKeeper, happ2.SupplyKeeper, genState)
+
+	plan, ok := happ2.GovKeeper.GetUpgradePlan(ctx2)
+	require.True(t, ok)
+	require.Equal(t, govTypes.NewPlan("v2", 20, "commit"), plan)
+	require.True(t, happ2.GovKeeper.IsUpgradeDone(ctx2, "v1"))
+	require.Equal(t, int64(15), happ2.GovKeeper.GetDoneHeight(ctx2, "v1"))
+	require.False(t, happ2.GovKeeper.IsUpgradeDone(ctx2, "v2"))
+
+	// done upgrade cannot be scheduled again
+	genState.UpgradePlan = &govTypes.Plan{Name: "v1", Height: 30}
+	require.Error(t, gov.ValidateGenesis(genState))
+}
+
+func TestUpgradesDoneAtGenesis(t *testing.T) {
+	// upgrades shipped with the binary are done on a new chain
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{})
+	require.True(t, happ.GovKeeper.IsUpgradeDone(ctx, hmTypes.UpgradeV03))
+	require.Equal(t, int64(0), happ.GovKeeper.GetDoneHeight(ctx, hmTypes.UpgradeV03))
+
+	// upgrade still scheduled by the genesis is left to its handler
+	genesisState := NewDefaultGenesisState()
+	govState := gov.DefaultGenesisState()
+	govState.UpgradePlan = &govTypes.Plan{Name: hmTypes.UpgradeV03, Height: 100}
+	genesisState[govTypes.ModuleName] = happ.Codec().MustMarshalJSON(govState)
+
+	happ2 := NewHeimdallApp(log.NewNopLogger(), dbm.NewMemDB())
+	happ2.InitChain(abci.RequestInitChain{AppStateBytes: happ2.Codec().MustMarshalJSON(genesisState)})
+
+	ctx2 := happ2.BaseApp.NewContext(false, abci.Header{})
+	require.False(t, happ2.GovKeeper.IsUpgradeDone(ctx2, hmTypes.UpgradeV03))
+	plan, ok := happ2.GovKeeper.GetUpgradePlan(ctx2)
+	require.True(t, ok)
+	require.Equal(t, hmTypes.UpgradeV03, plan.Name)
+}
+
+// unsetUpgradeDone reverts the upgrade to not applied, as on a chain started before it
+func unsetUpgradeDone(happ *HeimdallApp, ctx sdk.Context, name string) {
+	ctx.KVStore(happ.keys[govTypes.StoreKey]).Delete(govTypes.DoneUpgradeKey(name))
+}
+
+func TestUpgradeV03Supply(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+	unsetUpgradeDone(happ, ctx, hmTypes.UpgradeV03)
+
+	// topped up coins held by accounts are missing from total supply before the upgrade
+	user := hmTypes.BytesToHeimdallAddress([]byte("user"))
+	coins := sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(1000)))
+	_, err := happ.BankKeeper.AddCoins(ctx, user, coins)
+	require.NoError(t, err)
+	require.Empty(t, happ.CheckInvariants(ctx))
+
+	// withdrawals leave total supply untouched
+	handler := topup.NewHandler(happ.TopupKeeper, nil)
+	result := handler(ctx, topupTypes.NewMsgWithdrawFee(user, sdk.NewInt(400)))
+	require.True(t, result.IsOK(), result.Log)
+	require.True(t, happ.SupplyKeeper.GetSupply(ctx).Total.IsZero())
+	require.True(t, happ.TopupKeeper.GetTotalTopups(ctx).IsZero())
+
+	// upgrade resets total supply to account coins, total topups covers dividend accounts and supply
+	require.NoError(t, happ.GovKeeper.ScheduleUpgrade(ctx, govTypes.NewPlan(hmTypes.UpgradeV03, 20, "")))
+	happ.applyUpgrade(ctx.WithBlockHeight(20))
+	require.True(t, happ.GovKeeper.IsUpgradeDone(ctx, hmTypes.UpgradeV03))
+	require.Equal(t, sdk.NewInt(600), happ.SupplyKeeper.GetSupply(ctx).Total.AmountOf(authTypes.FeeToken))
+	require.Equal(t, sdk.NewInt(1000), happ.TopupKeeper.GetTotalTopups(ctx))
+	require.Empty(t, happ.CheckInvariants(ctx))
+
+	// withdrawals leave total supply after the upgrade
+	result = handler(ctx, topupTypes.NewMsgWithdrawFee(user, sdk.NewInt(100)))
+	require.True(t, result.IsOK(), result.Log)
+	require.Equal(t, sdk.NewInt(500), happ.SupplyKeeper.GetSupply(ctx).Total.AmountOf(authTypes.FeeToken))
+	require.Empty(t, happ.CheckInvariants(ctx))
+}
+
+func TestUpgradeV03MissedBitArrays(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+	unsetUpgradeDone(happ, ctx, hmTypes.UpgradeV03)
+
+	// before the upgrade bits are stored as is, a false bit marshals to empty bytes
+	valID := hmTypes.NewValidatorID(1)
+	happ.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, valID, 0, true)
+	happ.SlashingKeeper.SetValidatorMissedSideTxBitArray(ctx, valID, 0, true)
+	require.Panics(t, func() { happ.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, valID, 0, false) })
+	require.Panics(t, func() { happ.SlashingKeeper.SetValidatorMissedSideTxBitArray(ctx, valID, 0, false) })
+
+	// after the upgrade unset bits are deleted
+	require.NoError(t, happ.GovKeeper.ScheduleUpgrade(ctx, govTypes.NewPlan(hmTypes.UpgradeV03, 20, "")))
+	happ.applyUpgrade(ctx.WithBlockHeight(20))
+
+	happ.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, valID, 0, false)
+	happ.SlashingKeeper.SetValidatorMissedSideTxBitArray(ctx, valID, 0, false)
+	require.False(t, happ.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, valID, 0))
+	require.False(t, happ.SlashingKeeper.GetValidatorMissedSideTxBitArray(ctx, valID, 0))
+
+	store := ctx.KVStore(happ.keys[slashingTypes.StoreKey])
+	require.False(t, store.Has(slashingTypes.GetValidatorMissedBlockBitArrayKey(valID.Bytes(), 0)))
+	require.False(t, store.Has(slashingTypes.GetValidatorMissedSideTxBitArrayKey(valID.Bytes(), 0)))
+}
+
+func TestUpgradeV03FeeRewards(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+	unsetUpgradeDone(happ, ctx, hmTypes.UpgradeV03)
+
+	user := hmTypes.BytesToHeimdallAddress([]byte("user"))
+	proposer := hmTypes.BytesToHeimdallAddress([]byte("proposer"))
+	fees := sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(1000)))
+	collectFees := func() {
+		_, err := happ.BankKeeper.AddCoins(ctx, user, fees)
+		require.NoError(t, err)
+		require.NoError(t, happ.SupplyKeeper.SendCoinsFromAccountToModule(ctx, user, authTypes.FeeCollectorName, fees))
+		happ.AccountKeeper.SetBlockProposer(ctx, proposer)
+	}
+
+	// block proposer gets collected fees before the upgrade, treasury is not funded
+	happ.TreasuryKeeper.SetParams(ctx, treasuryTypes.NewParams(sdk.NewDecWithPrec(1, 1)))
+	collectFees()
+	happ.EndBlocker(ctx, abci.RequestEndBlock{Height: 10})
+	require.Equal(t, fees, happ.BankKeeper.GetCoins(ctx, proposer))
+	require.True(t, happ.CheckpointKeeper.GetUndistributedFees(ctx).IsZero())
+	require.True(t, happ.TreasuryKeeper.GetPool(ctx).IsZero())
+	_, found := happ.AccountKeeper.GetBlockProposer(ctx)
+	require.False(t, found)
+	happ.TreasuryKeeper.SetParams(ctx, treasuryTypes.DefaultParams())
+
+	// collected fees go to fee reward pool after the upgrade
+	require.NoError(t, happ.GovKeeper.ScheduleUpgrade(ctx, govTypes.NewPlan(hmTypes.UpgradeV03, 20, "")))
+	happ.applyUpgrade(ctx.WithBlockHeight(20))
+
+	collectFees()
+	happ.EndBlocker(ctx, abci.RequestEndBlock{Height: 20})
+	require.Equal(t, fees, happ.BankKeeper.GetCoins(ctx, proposer))
+	require.Equal(t, fees, happ.CheckpointKeeper.GetUndistributedFees(ctx))
+	require.True(t, happ.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName).GetCoins().IsZero())
+	_, found = happ.AccountKeeper.GetBlockProposer(ctx)
+	require.False(t, found)
+}
+
+func TestUpgradeV03Params(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+	unsetUpgradeDone(happ, ctx, hmTypes.UpgradeV03)
+
+	// params are missing on a chain started before the upgrade
+	params := upgradeV03Params()
+	for _, param := range params {
+		paramKey := append([]byte(happ.subspaces[param.module].Name()+"/"), param.key...)
+		ctx.KVStore(happ.keys[paramsTypes.StoreKey]).Delete(paramKey)
+	}
+	require.Panics(t, func() { happ.SlashingKeeper.GetParams(ctx) })
+
+	// upgrade sets the defaults of a migrated genesis
+	require.NoError(t, happ.GovKeeper.ScheduleUpgrade(ctx, govTypes.NewPlan(hmTypes.UpgradeV03, 20, "")))
+	happ.applyUpgrade(ctx.WithBlockHeight(20))
+
+	authParams := happ.AccountKeeper.GetParams(ctx)
+	require.Empty(t, authParams.MsgFees)
+
+	borParams := happ.BorKeeper.GetParams(ctx)
+	require.Equal(t, borLegacy.ProducerSelectionAlgorithm, borParams.ProducerSelectionAlgorithm)
+	require.Equal(t, borLegacy.MaxProducerSlots, borParams.MaxProducerSlots)
+	require.Equal(t, borLegacy.MinSeedReveals, borParams.MinSeedReveals)
+
+	clerkParams := happ.ClerkKeeper.GetParams(ctx)
+	require.Equal(t, clerkLegacy.DefaultParams.PruneDeliveredRecords, clerkParams.PruneDeliveredRecords)
+	require.Equal(t, clerkLegacy.DefaultParams.RecordRetentionPeriod, clerkParams.RecordRetentionPeriod)
+
+	require.True(t, happ.TreasuryKeeper.GetParams(ctx).FeeFraction.IsZero())
+
+	slashingParams := happ.SlashingKeeper.GetParams(ctx)
+	require.Equal(t, slashingLegacy.SideTxWindow, slashingParams.SideTxWindow)
+	require.Equal(t, slashingLegacy.MinSideTxParticipation, slashingParams.MinSideTxParticipation)
+	require.Equal(t, slashingLegacy.SlashFractionSideTxDowntime, slashingParams.SlashFractionSideTxDowntime)
+	require.Equal(t, slashingLegacy.MaxBorEvidenceSpans, slashingParams.MaxBorEvidenceSpans)
+
+	sidechannelParams := happ.SidechannelKeeper.GetParams(ctx)
+	require.Equal(t, sidechannelLegacy.DefaultParams.MaxSideTxRetries, sidechannelParams.MaxSideTxRetries)
+	require.Equal(t, sidechannelLegacy.DefaultParams.SideTxRetryBackoff, sidechannelParams.SideTxRetryBackoff)
+	require.Equal(t, sidechannelLegacy.DefaultParams.VoteRecordRetention, sidechannelParams.VoteRecordRetention)
+
+	for _, param := range params {
+		require.True(t, happ.subspaces[param.module].Has(ctx, param.key), string(param.key))
+	}
+}
+
+func TestUpgradeV03MaxValidators(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+	unsetUpgradeDone(happ, ctx, hmTypes.UpgradeV03)
+
+	// param is missing on a chain started before the upgrade
+	paramKey := append([]byte(stakingTypes.DefaultParamspace+"/"), stakingTypes.KeyMaxValidators...)
+	ctx.KVStore(happ.keys[paramsTypes.StoreKey]).Delete(paramKey)
+
+	// more current validators than the default limit
+	numValidators := int(stakingLegacy.DefaultParams.MaxValidators) + 1
+	accounts := simTypes.RandomAccounts(rand.New(rand.NewSource(42)), numValidators)
+	for i, acc := range accounts {
+		validator := hmTypes.NewValidator(
+			hmTypes.NewValidatorID(uint64(i+1)),
+			0,
+			0,
+			1,
+			int64(i+1), // power
+			hmTypes.NewPubKey(acc.PubKey.Bytes()),
+			acc.Address,
+		)
+		require.NoError(t, happ.StakingKeeper.AddValidator(ctx, *validator))
+	}
+
+	// validator set is not capped before the upgrade
+	res := happ.EndBlocker(ctx, abci.RequestEndBlock{Height: 10})
+	require.Len(t, res.ValidatorUpdates, numValidators)
+	require.Len(t, happ.StakingKeeper.GetValidatorSet(ctx).Validators, numValidators)
+	require.Len(t, happ.StakingKeeper.GetSpanEligibleValidators(ctx), numValidators)
+	require.Empty(t, happ.StakingKeeper.GetStandbyValidators(ctx))
+
+	// upgrade raises the limit to the size of the validator set
+	require.NoError(t, happ.GovKeeper.ScheduleUpgrade(ctx, govTypes.NewPlan(hmTypes.UpgradeV03, 20, "")))
+	happ.applyUpgrade(ctx.WithBlockHeight(20))
+	require.Equal(t, uint64(numValidators), happ.StakingKeeper.GetParams(ctx).MaxValidators)
+
+	// no validator moves to standby
+	res = happ.EndBlocker(ctx, abci.RequestEndBlock{Height: 20})
+	require.Empty(t, res.ValidatorUpdates)
+	require.Len(t, happ.StakingKeeper.GetValidatorSet(ctx).Validators, numValidators)
+	require.Empty(t, happ.StakingKeeper.GetStandbyValidators(ctx))
+}
+
+func TestUpgradeV03RecordContractIndex(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 10})
+	unsetUpgradeDone(happ, ctx, hmTypes.UpgradeV03)
+
+	// records are not indexed by receiver contract before the upgrade
+	contract := hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000001001")
+	for id := uint64(1); id <= 3; id++ {
+		record := clerkTypes.NewEventRecord(hmTypes.HexToHeimdallHash("0x01"), id, id, contract, hmTypes.HexBytes{}, "1", time.Unix(int64(id), 0))
+		require.NoError(t, happ.ClerkKeeper.SetEventRecord(ctx, record))
+	}
+	records, err := happ.ClerkKeeper.GetEventRecordListWithContract(ctx, contract, 1, 10)
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	// delivered records are not pruned before the upgrade
+	happ.ClerkKeeper.SetParams(ctx, clerkTypes.NewParams(true, 0))
+	happ.ClerkKeeper.SetLastSyncedStateID(ctx, 3)
+	require.Zero(t, happ.ClerkKeeper.PruneEventRecords(ctx))
+
+	// upgrade indexes existing records
+	require.NoError(t, happ.GovKeeper.ScheduleUpgrade(ctx, govTypes.NewPlan(hmTypes.UpgradeV03, 20, "")))
+	happ.applyUpgrade(ctx.WithBlockHeight(20))
+	records, err = happ.ClerkKeeper.GetEventRecordListWithContract(ctx, contract, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+}
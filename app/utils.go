@@ -11,6 +11,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/maticnetwork/heimdall/app/helpers"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/types/module"
 	simTypes "github.com/maticnetwork/heimdall/types/simulation"
 )
@@ -48,10 +49,11 @@ func SetupSimulation(dirPrefix, dbName string) (simTypes.Config, dbm.DB, string,
 
 // SimulationOperations retrieves the simulation params from the provided file path
 // and returns all the modules weighted operations
-func SimulationOperations(app App, cdc *codec.Codec, config simTypes.Config) []simTypes.WeightedOperation {
+func SimulationOperations(app App, cdc *codec.Codec, config simTypes.Config, sideChannel *simTypes.SideChannel) []simTypes.WeightedOperation {
 	simState := module.SimulationState{
-		AppParams: make(simTypes.AppParams),
-		Cdc:       cdc,
+		AppParams:   make(simTypes.AppParams),
+		Cdc:         cdc,
+		SideChannel: sideChannel,
 	}
 
 	if config.ParamsFile != "" {
@@ -68,6 +70,12 @@ func SimulationOperations(app App, cdc *codec.Codec, config simTypes.Config) []s
 	return app.SimulationManager().WeightedOperations(simState)
 }
 
+// SimulationSideChannel returns the side channel handing side-txs of simulation
+// operations to the side-tx processor of the app
+func SimulationSideChannel(app *HeimdallApp) *simTypes.SideChannel {
+	return simTypes.NewSideChannel(app, authTypes.DefaultTxEncoder(app.Codec()))
+}
+
 // CheckExportSimulation exports the app state and simulation parameters to JSON
 // if the export paths are defined.
 func CheckExportSimulation(
@@ -52,6 +52,24 @@ type FeeCollector interface {
 	) sdk.Error
 }
 
+//
+// Validator checker interface
+//
+
+// ValidatorChecker checks if signer is a current validator, used for fee exempt msgs
+type ValidatorChecker interface {
+	IsCurrentValidatorByAddress(ctx sdk.Context, address []byte) bool
+}
+
+//
+// Fee grant interface
+//
+
+// FeeGrantKeeper deducts fees from allowance granted by fee granter to tx signer
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter types.HeimdallAddress, grantee types.HeimdallAddress, fee sdk.Coins) sdk.Error
+}
+
 //
 // MainTxMsg tx hash
 //
@@ -67,6 +85,9 @@ func NewAnteHandler(
 	ak AccountKeeper,
 	chainKeeper chainmanager.Keeper,
 	feeCollector FeeCollector,
+	validatorChecker ValidatorChecker,
+	feeGrantKeeper FeeGrantKeeper,
+	upgradeKeeper types.UpgradeKeeper,
 	contractCaller helper.IContractCaller,
 	sigGasConsumer SignatureVerificationGasConsumer,
 ) sdk.AnteHandler {
@@ -85,17 +106,31 @@ func NewAnteHandler(
 			return newCtx, sdk.ErrInternal("tx must be StdTx").Result(), true
 		}
 
+		// fee granter txs are accepted once the upgrade adding them is applied
+		if !stdTx.FeeGranter.Empty() && !upgradeKeeper.IsUpgradeDone(ctx, types.UpgradeV03) {
+			newCtx = SetGasMeter(simulate, ctx, 0)
+			return newCtx, sdk.ErrUnauthorized(fmt.Sprintf("fee granter is not supported before upgrade %s", types.UpgradeV03)).Result(), true
+		}
+
+		// msgs added by a software upgrade are accepted once it is applied
+		if upgradeMsg, ok := stdTx.Msg.(types.UpgradeMsg); ok && !upgradeKeeper.IsUpgradeDone(ctx, upgradeMsg.RequiredUpgrade()) {
+			newCtx = SetGasMeter(simulate, ctx, 0)
+			return newCtx, sdk.ErrUnknownRequest(fmt.Sprintf("%s msg is not supported before upgrade %s", stdTx.Msg.Type(), upgradeMsg.RequiredUpgrade())).Result(), true
+		}
+
 		// get account params
 		params := ak.GetParams(ctx)
 
+		// fee schedule for tx msg
+		msgFee := params.GetMsgFee(stdTx.Msg.Route(), stdTx.Msg.Type())
+
 		// gas for tx
-		gasForTx := params.MaxTxGas // stdTx.Fee.Gas
+		gasForTx := msgFee.MaxTxGas // stdTx.Fee.Gas
 
-		amount, ok := sdk.NewIntFromString(params.TxFees)
+		feeForTx, ok := msgFee.GetFees() // stdTx.Fee.Amount
 		if !ok {
 			return newCtx, sdk.ErrInternal("Invalid param tx fees").Result(), true
 		}
-		feeForTx := sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: amount}} // stdTx.Fee.Amount
 
 		// new gas meter
 		newCtx = SetGasMeter(simulate, ctx, gasForTx)
@@ -152,8 +187,27 @@ func NewAnteHandler(
 			return newCtx, res, true
 		}
 
-		// deduct the fees
-		if !feeForTx.IsZero() {
+		// validator duty msgs are free for current validators
+		if msgFee.ValidatorExempt && validatorChecker.IsCurrentValidatorByAddress(newCtx, signerAcc.GetAddress().Bytes()) {
+			feeForTx = sdk.Coins{}
+		}
+
+		// deduct the fees, from granter's account if tx uses fee allowance
+		if !feeForTx.IsZero() && !stdTx.FeeGranter.Empty() {
+			if err := feeGrantKeeper.UseGrantedFees(newCtx, stdTx.FeeGranter, signerAcc.GetAddress(), feeForTx); err != nil {
+				return newCtx, err.Result(), true
+			}
+
+			granterAcc, res := GetSignerAcc(newCtx, ak, stdTx.FeeGranter)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+
+			res = DeductFees(feeCollector, newCtx, granterAcc, feeForTx)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+		} else if !feeForTx.IsZero() {
 			res = DeductFees(feeCollector, newCtx, signerAcc, feeForTx)
 			if !res.IsOK() {
 				return newCtx, res, true
@@ -226,17 +280,26 @@ func processSig(
 	}
 
 	if !simulate {
-		var pk secp256k1.PubKeySecp256k1
-		p, err := authTypes.RecoverPubkey(signBytes, sig.Bytes())
-		copy(pk[:], p[:])
-
-		if err != nil || !bytes.Equal(acc.GetAddress().Bytes(), pk.Address().Bytes()) {
-			return nil, sdk.ErrUnauthorized("signature verification failed; verify correct account sequence and chain-id").Result()
+		var pk crypto.PubKey
+		if authTypes.IsMultisigPubKey(acc.GetPubKey()) || (acc.GetPubKey() == nil && authTypes.IsMultiSignature(sig)) {
+			multisigPk, res := processMultiSig(acc, sig, signBytes)
+			if !res.IsOK() {
+				return nil, res
+			}
+			pk = multisigPk
+		} else {
+			var secpPk secp256k1.PubKeySecp256k1
+			p, err := authTypes.RecoverPubkey(signBytes, sig.Bytes())
+			copy(secpPk[:], p[:])
+
+			if err != nil || !bytes.Equal(acc.GetAddress().Bytes(), secpPk.Address().Bytes()) {
+				return nil, sdk.ErrUnauthorized("signature verification failed; verify correct account sequence and chain-id").Result()
+			}
+			pk = secpPk
 		}
 
 		if acc.GetPubKey() == nil {
-			var cryptoPk crypto.PubKey = pk
-			if err := acc.SetPubKey(cryptoPk); err != nil {
+			if err := acc.SetPubKey(pk); err != nil {
 				return nil, sdk.ErrUnauthorized("error while updating account pubkey").Result()
 			}
 		}
@@ -249,16 +312,66 @@ func processSig(
 	return acc, res
 }
 
+// processMultiSig verifies combined signature of threshold multisig account
+// and returns multisig pubkey
+func processMultiSig(
+	acc authTypes.Account,
+	sig authTypes.StdSignature,
+	signBytes []byte,
+) (crypto.PubKey, sdk.Result) {
+	multiSig, err := authTypes.DecodeMultiSignature(sig)
+	if err != nil {
+		return nil, sdk.ErrUnauthorized("invalid multisig signature").Result()
+	}
+
+	if acc.GetPubKey() != nil && !acc.GetPubKey().Equals(multiSig.PubKey) {
+		return nil, sdk.ErrUnauthorized("multisig pubkey does not match account pubkey").Result()
+	}
+
+	if !bytes.Equal(acc.GetAddress().Bytes(), multiSig.PubKey.Address().Bytes()) {
+		return nil, sdk.ErrUnauthorized("multisig pubkey does not match account address").Result()
+	}
+
+	if err := multiSig.Verify(signBytes); err != nil {
+		return nil, sdk.ErrUnauthorized(fmt.Sprintf("multisig verification failed: %v; verify correct account sequence and chain-id", err)).Result()
+	}
+
+	return multiSig.PubKey, sdk.Result{}
+}
+
 // DefaultSigVerificationGasConsumer is the default implementation of SignatureVerificationGasConsumer. It consumes gas
 // for signature verification based upon the public key type. The cost is fetched from the given params and is matched
-// by the concrete type.
+// by the concrete type. A multisig signature is charged once for each sub-signature it carries.
 func DefaultSigVerificationGasConsumer(
 	meter sdk.GasMeter, sig authTypes.StdSignature, params authTypes.Params,
 ) sdk.Result {
+	if authTypes.IsMultiSignature(sig) {
+		if multiSig, err := authTypes.DecodeMultiSignature(sig); err == nil {
+			consumeMultisignatureVerificationGas(meter, multiSig, params)
+			return sdk.Result{}
+		}
+	}
+
 	meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 	return sdk.Result{}
 }
 
+// consumeMultisignatureVerificationGas charges secp256k1 verification for every
+// multisig key marked as signed in the bit array
+func consumeMultisignatureVerificationGas(meter sdk.GasMeter, multiSig authTypes.MultiSignature, params authTypes.Params) {
+	bitArray := multiSig.Signature.BitArray
+	if bitArray == nil {
+		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
+		return
+	}
+
+	for i := 0; i < bitArray.Size(); i++ {
+		if bitArray.GetIndex(i) {
+			meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
+		}
+	}
+}
+
 // DeductFees deducts fees from the given account.
 //
 // NOTE: We could use the CoinKeeper (in addition to the AccountKeeper, because
@@ -314,5 +427,5 @@ func GetSignBytes(chainID string, stdTx authTypes.StdTx, acc authTypes.Account,
 		accNum = acc.GetAccountNumber()
 	}
 
-	return authTypes.StdSignBytes(chainID, accNum, acc.GetSequence(), stdTx.Msg, stdTx.Memo)
+	return authTypes.StdSignBytesWithFeeGranter(chainID, accNum, acc.GetSequence(), stdTx.Msg, stdTx.Memo, stdTx.FeeGranter)
 }
@@ -3,6 +3,7 @@ package auth_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -14,6 +15,8 @@ import (
 	"github.com/maticnetwork/heimdall/auth"
 	"github.com/maticnetwork/heimdall/auth/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/common"
+	feegrantTypes "github.com/maticnetwork/heimdall/feegrant/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/simulation"
@@ -42,6 +45,9 @@ func (suite *AnteTestSuite) SetupTest() {
 		suite.app.AccountKeeper,
 		suite.app.ChainKeeper,
 		suite.app.SupplyKeeper,
+		&suite.app.StakingKeeper,
+		suite.app.FeeGrantKeeper,
+		suite.app.GovKeeper,
 		&caller,
 		auth.DefaultSigVerificationGasConsumer,
 	)
@@ -149,6 +155,248 @@ func (suite *AnteTestSuite) TestCheckpointGasLimit() {
 	// require.Equal(t, uint64(10000000), uint64(result.GasWanted))
 }
 
+func (suite *AnteTestSuite) TestMsgFees() {
+	t, happ, ctx, anteHandler := suite.T(), suite.app, suite.ctx, suite.anteHandler
+	ctx = ctx.WithBlockHeight(1)
+
+	// keys and addresses
+	priv1, _, addr1 := sdkAuth.KeyTestPubAddr()
+	signer := hmTypes.AccAddressToHeimdallAddress(addr1)
+
+	// set the account with balance for one scheduled fee
+	acc1 := happ.AccountKeeper.NewAccountWithAddress(ctx, signer)
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 100)))
+	happ.AccountKeeper.SetAccount(ctx, acc1)
+	acc1 = happ.AccountKeeper.GetAccount(ctx, signer)
+
+	msg := sdkAuth.NewTestMsg(addr1)
+
+	// set fee schedule for test msg
+	params := happ.AccountKeeper.GetParams(ctx)
+	params.MsgFees = []authTypes.MsgFee{
+		authTypes.NewMsgFee(msg.Route(), msg.Type(), "100", 500000, true),
+	}
+	happ.AccountKeeper.SetParams(ctx, params)
+
+	// scheduled fee and gas is charged from non validator
+	tx := types.NewTestTx(ctx, msg, priv1, acc1.GetAccountNumber(), uint64(0))
+	_, result, _ := checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, uint64(500000), result.GasWanted)
+	require.True(sdk.IntEq(t, happ.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName).GetCoins().AmountOf(authTypes.FeeToken), sdk.NewInt(100)))
+	require.True(t, happ.AccountKeeper.GetAccount(ctx, signer).GetCoins().IsZero())
+
+	// exempt msg is free for current validator
+	validator := hmTypes.NewValidator(hmTypes.NewValidatorID(1), 0, 0, 1, 10, hmTypes.NewPubKey(priv1.PubKey().Bytes()), signer)
+	require.NoError(t, happ.StakingKeeper.AddValidator(ctx, *validator))
+
+	tx = types.NewTestTx(ctx, msg, priv1, acc1.GetAccountNumber(), uint64(1))
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.True(sdk.IntEq(t, happ.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName).GetCoins().AmountOf(authTypes.FeeToken), sdk.NewInt(100)))
+}
+
+func (suite *AnteTestSuite) TestMultisig() {
+	t, happ, ctx, anteHandler := suite.T(), suite.app, suite.ctx, suite.anteHandler
+	ctx = ctx.WithBlockHeight(1)
+
+	// keys and 2 of 3 multisig
+	priv1, _, _ := sdkAuth.KeyTestPubAddr()
+	priv2, _, _ := sdkAuth.KeyTestPubAddr()
+	priv3, _, _ := sdkAuth.KeyTestPubAddr()
+	multisigPk, err := types.NewMultisigPubKey(2, []crypto.PubKey{priv1.PubKey(), priv2.PubKey(), priv3.PubKey()})
+	require.NoError(t, err)
+
+	// multisig account
+	multisigAddr := hmTypes.BytesToHeimdallAddress(multisigPk.Address().Bytes())
+	acc := happ.AccountKeeper.NewAccountWithAddress(ctx, multisigAddr)
+	acc.SetCoins(sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, sdk.NewIntFromBigInt(auth.DefaultFeeInMatic).MulRaw(4))))
+	happ.AccountKeeper.SetAccount(ctx, acc)
+	acc = happ.AccountKeeper.GetAccount(ctx, multisigAddr)
+
+	msg := sdkAuth.NewTestMsg(multisigAddr.Bytes())
+	newMultisigTx := func(seq uint64, privs ...crypto.PrivKey) sdk.Tx {
+		signBytes := types.StdSignBytes(ctx.ChainID(), acc.GetAccountNumber(), seq, msg, "")
+		multiSig := types.NewMultiSignature(multisigPk)
+		for _, priv := range privs {
+			sig, err := priv.Sign(signBytes)
+			require.NoError(t, err)
+			require.NoError(t, multiSig.AddSignature(sig, signBytes))
+		}
+		return types.NewStdTx(msg, multiSig.Bytes(), "")
+	}
+
+	// below threshold
+	checkInvalidTx(t, anteHandler, ctx, newMultisigTx(0, priv1), false, sdk.CodeUnauthorized)
+
+	// signature from key outside of multisig can't be added
+	priv4, _, _ := sdkAuth.KeyTestPubAddr()
+	multiSig := types.NewMultiSignature(multisigPk)
+	signBytes := types.StdSignBytes(ctx.ChainID(), acc.GetAccountNumber(), 0, msg, "")
+	sig, err := priv4.Sign(signBytes)
+	require.NoError(t, err)
+	require.Error(t, multiSig.AddSignature(sig, signBytes))
+
+	// threshold signatures, sets multisig pubkey on account
+	checkValidTx(t, anteHandler, ctx, newMultisigTx(0, priv1, priv3), false)
+	acc = happ.AccountKeeper.GetAccount(ctx, multisigAddr)
+	require.Equal(t, uint64(1), acc.GetSequence())
+	require.True(t, acc.GetPubKey().Equals(multisigPk))
+
+	// all signatures
+	checkValidTx(t, anteHandler, ctx, newMultisigTx(1, priv3, priv2, priv1), false)
+
+	// single key signature is rejected for multisig account
+	tx := types.NewTestTx(ctx, msg, priv1, acc.GetAccountNumber(), uint64(2))
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+}
+
+func (suite *AnteTestSuite) TestSigVerificationGas() {
+	t, happ, ctx := suite.T(), suite.app, suite.ctx
+	params := happ.AccountKeeper.GetParams(ctx)
+
+	priv1, _, _ := sdkAuth.KeyTestPubAddr()
+	priv2, _, _ := sdkAuth.KeyTestPubAddr()
+	priv3, _, _ := sdkAuth.KeyTestPubAddr()
+	multisigPk, err := types.NewMultisigPubKey(2, []crypto.PubKey{priv1.PubKey(), priv2.PubKey(), priv3.PubKey()})
+	require.NoError(t, err)
+
+	signBytes := []byte("sign bytes")
+	multisigWith := func(privs ...crypto.PrivKey) types.StdSignature {
+		multiSig := types.NewMultiSignature(multisigPk)
+		for _, priv := range privs {
+			sig, err := priv.Sign(signBytes)
+			require.NoError(t, err)
+			require.NoError(t, multiSig.AddSignature(sig, signBytes))
+		}
+		return multiSig.Bytes()
+	}
+
+	single, err := priv1.Sign(signBytes)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		sig  types.StdSignature
+		gas  uint64
+	}{
+		{"single signature", single, params.SigVerifyCostSecp256k1},
+		{"2 of 3 multisig", multisigWith(priv1, priv3), 2 * params.SigVerifyCostSecp256k1},
+		{"3 of 3 multisig", multisigWith(priv1, priv2, priv3), 3 * params.SigVerifyCostSecp256k1},
+	}
+
+	for _, tc := range tests {
+		meter := sdk.NewInfiniteGasMeter()
+		res := auth.DefaultSigVerificationGasConsumer(meter, tc.sig, params)
+		require.True(t, res.IsOK(), tc.name)
+		require.Equal(t, tc.gas, meter.GasConsumed(), tc.name)
+	}
+}
+
+func (suite *AnteTestSuite) TestFeeGrant() {
+	t, happ, ctx, anteHandler := suite.T(), suite.app, suite.ctx, suite.anteHandler
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0))
+
+	// keys and addresses
+	priv1, _, addr1 := sdkAuth.KeyTestPubAddr()
+	_, _, addr2 := sdkAuth.KeyTestPubAddr()
+	grantee := hmTypes.AccAddressToHeimdallAddress(addr1)
+	granter := hmTypes.AccAddressToHeimdallAddress(addr2)
+	fee := sdk.NewIntFromBigInt(auth.DefaultFeeInMatic)
+
+	// grantee without coins, granter funds three txs
+	acc1 := happ.AccountKeeper.NewAccountWithAddress(ctx, grantee)
+	happ.AccountKeeper.SetAccount(ctx, acc1)
+	acc2 := happ.AccountKeeper.NewAccountWithAddress(ctx, granter)
+	acc2.SetCoins(sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, fee.MulRaw(3))))
+	happ.AccountKeeper.SetAccount(ctx, acc2)
+	acc1 = happ.AccountKeeper.GetAccount(ctx, grantee)
+
+	msg := sdkAuth.NewTestMsg(addr1)
+	newFeeGrantTx := func(seq uint64) sdk.Tx {
+		signBytes := types.StdSignBytesWithFeeGranter(ctx.ChainID(), acc1.GetAccountNumber(), seq, msg, "", granter)
+		sig, err := priv1.Sign(signBytes)
+		require.NoError(t, err)
+		return types.NewStdTx(msg, sig, "").WithFeeGranter(granter)
+	}
+
+	// fee granter txs are rejected before the upgrade adding them
+	caller, err := helper.NewContractCaller()
+	require.NoError(t, err)
+	preUpgradeHandler := auth.NewAnteHandler(
+		happ.AccountKeeper,
+		happ.ChainKeeper,
+		happ.SupplyKeeper,
+		&happ.StakingKeeper,
+		happ.FeeGrantKeeper,
+		upgradeKeeper(false),
+		&caller,
+		auth.DefaultSigVerificationGasConsumer,
+	)
+	checkInvalidTx(t, preUpgradeHandler, ctx, newFeeGrantTx(0), false, sdk.CodeUnauthorized)
+
+	// no allowance
+	_, result, abort := anteHandler(ctx, newFeeGrantTx(0), false)
+	require.True(t, abort)
+	require.Equal(t, common.CodeFeeAllowanceNotFound, result.Code)
+	require.True(t, happ.AccountKeeper.GetAccount(ctx, granter).GetCoins().AmountOf(authTypes.FeeToken).Equal(fee.MulRaw(3)))
+
+	// allowance for one tx
+	happ.FeeGrantKeeper.GrantAllowance(ctx, feegrantTypes.NewFeeAllowance(granter, grantee, sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, fee)), time.Unix(2000, 0)))
+	checkValidTx(t, anteHandler, ctx, newFeeGrantTx(0), false)
+	require.True(t, happ.AccountKeeper.GetAccount(ctx, granter).GetCoins().AmountOf(authTypes.FeeToken).Equal(fee.MulRaw(2)))
+	require.True(t, happ.AccountKeeper.GetAccount(ctx, grantee).GetCoins().IsZero())
+
+	// allowance is used up
+	_, found := happ.FeeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	require.False(t, found)
+
+	// granter can't be changed after signing
+	happ.FeeGrantKeeper.GrantAllowance(ctx, feegrantTypes.NewFeeAllowance(granter, grantee, sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, fee.MulRaw(2))), time.Unix(2000, 0)))
+	tx := types.NewTestTx(ctx, msg, priv1, acc1.GetAccountNumber(), uint64(1)).(types.StdTx).WithFeeGranter(granter)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// expired allowance
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	_, result, abort = anteHandler(ctx, newFeeGrantTx(1), false)
+	require.True(t, abort)
+	require.Equal(t, common.CodeFeeAllowanceExceeded, result.Code)
+}
+
+func (suite *AnteTestSuite) TestUpgradeMsg() {
+	t, happ, ctx, anteHandler := suite.T(), suite.app, suite.ctx, suite.anteHandler
+	ctx = ctx.WithBlockHeight(1)
+
+	// keys and addresses
+	priv1, _, addr1 := sdkAuth.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := happ.AccountKeeper.NewAccountWithAddress(ctx, hmTypes.AccAddressToHeimdallAddress(addr1))
+	amt1, _ := sdk.NewIntFromString(authTypes.DefaultTxFees)
+	acc1.SetCoins(sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, amt1)))
+	happ.AccountKeeper.SetAccount(ctx, acc1)
+	acc1 = happ.AccountKeeper.GetAccount(ctx, acc1.GetAddress()) // get stored account
+
+	msg := TestUpgradeMsg{*sdkAuth.NewTestMsg(addr1)}
+	tx := types.NewTestTx(ctx, sdk.Msg(&msg), priv1, acc1.GetAccountNumber(), uint64(0))
+
+	// msg is rejected before the upgrade adding it
+	caller, err := helper.NewContractCaller()
+	require.NoError(t, err)
+	preUpgradeHandler := auth.NewAnteHandler(
+		happ.AccountKeeper,
+		happ.ChainKeeper,
+		happ.SupplyKeeper,
+		&happ.StakingKeeper,
+		happ.FeeGrantKeeper,
+		upgradeKeeper(false),
+		&caller,
+		auth.DefaultSigVerificationGasConsumer,
+	)
+	checkInvalidTx(t, preUpgradeHandler, ctx, tx, false, sdk.CodeUnknownRequest)
+
+	// msg is accepted once upgrade is applied
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
+
 func (suite *AnteTestSuite) TestStdTx() {
 	t, happ, ctx, anteHandler := suite.T(), suite.app, suite.ctx, suite.anteHandler
 
@@ -426,6 +674,13 @@ func checkInvalidTx(t *testing.T, anteHandler sdk.AnteHandler, ctx sdk.Context,
 
 var _ sdk.Msg = (*TestCheckpointMsg)(nil)
 
+// upgradeKeeper reports every upgrade as done or as pending
+type upgradeKeeper bool
+
+func (u upgradeKeeper) IsUpgradeDone(_ sdk.Context, _ string) bool {
+	return bool(u)
+}
+
 // msg type for testing
 type TestCheckpointMsg struct {
 	sdk.TestMsg
@@ -433,3 +688,10 @@ type TestCheckpointMsg struct {
 
 func (msg *TestCheckpointMsg) Route() string { return "checkpoint" }
 func (msg *TestCheckpointMsg) Type() string  { return "checkpoint" }
+
+// msg type added by an upgrade for testing
+type TestUpgradeMsg struct {
+	sdk.TestMsg
+}
+
+func (msg *TestUpgradeMsg) RequiredUpgrade() string { return hmTypes.UpgradeV03 }
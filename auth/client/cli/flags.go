@@ -1,8 +1,11 @@
 package cli
 
 const (
-	flagAppend  = "append"
-	flagOffline = "offline"
-	flagSigOnly = "signature-only"
-	flagOutfile = "output-document"
+	flagAppend    = "append"
+	flagOffline   = "offline"
+	flagSigOnly   = "signature-only"
+	flagOutfile   = "output-document"
+	flagMultisig  = "multisig"
+	flagPubkeys   = "pubkeys"
+	flagThreshold = "threshold"
 )
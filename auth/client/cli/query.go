@@ -28,6 +28,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetAccountCmd(cdc),
 			GetQueryParams(cdc),
+			GetMsgFeeCmd(cdc),
 		)...,
 	)
 	return txCmd
@@ -96,3 +97,41 @@ $ %s query auth params
 		},
 	}
 }
+
+// GetMsgFeeCmd implements the effective msg fee query command.
+func GetMsgFeeCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "msg-fee [route] [type]",
+		Args:  cobra.ExactArgs(2),
+		Short: "show the effective fee and gas limit for a msg type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fee and gas limit charged for txs with msg of given route and type.
+
+Example:
+$ %s query auth msg-fee clerk event-record
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryMsgFeeParams(args[0], args[1]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMsgFee)
+			bz, _, err := cliCtx.QueryWithData(route, queryParams)
+			if err != nil {
+				return err
+			}
+
+			var msgFee types.MsgFee
+			if err := json.Unmarshal(bz, &msgFee); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(msgFee)
+		},
+	}
+}
@@ -19,6 +19,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	txCmd.AddCommand(
 		GetSignCommand(cdc),
+		GetMultisigAddressCommand(cdc),
+		GetMultiSignCommand(cdc),
 	)
 	return txCmd
 }
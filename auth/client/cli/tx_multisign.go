@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/maticnetwork/bor/common"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetMultisigAddressCommand returns the multisig address command.
+func GetMultisigAddressCommand(codec *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig-address",
+		Short: "Show address of threshold multisig account",
+		Long: `Show address and pubkey of k of n threshold multisig account created from
+--pubkeys (comma separated 65 bytes hex pubkeys) and --threshold.
+Order of pubkeys defines the address and must be same for all commands.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			multisigPubKey, err := multisigPubKeyFromFlags()
+			if err != nil {
+				return err
+			}
+
+			result := struct {
+				Address hmTypes.HeimdallAddress `json:"address"`
+				PubKey  crypto.PubKey           `json:"pub_key"`
+			}{
+				Address: hmTypes.BytesToHeimdallAddress(multisigPubKey.Address().Bytes()),
+				PubKey:  multisigPubKey,
+			}
+
+			json, err := codec.MarshalJSONIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("%s\n", json)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagPubkeys, "", "Comma separated hex pubkeys of multisig account")
+	cmd.Flags().Int(flagThreshold, 1, "Minimum number of signatures required")
+
+	return cmd
+}
+
+// GetMultiSignCommand returns the multi-sign command.
+func GetMultiSignCommand(codec *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign [file] [signature-files...]",
+		Short: "Combine member signatures of multisig account",
+		Long: `Combine signatures of multisig account members into signed transaction.
+It will read a transaction from [file] and member signatures from [signature-files]
+created with "sign --multisig=<address> --signature-only", and print the JSON encoding
+of the transaction signed by multisig account created from --pubkeys and --threshold.
+
+The --offline flag makes sure that the client will not reach out to full node.
+As a result, the account and sequence number queries will not be performed and
+it is required to set such parameters manually.
+`,
+		PreRun: preSignCmd,
+		RunE:   makeMultiSignCmd(codec),
+		Args:   cobra.MinimumNArgs(2),
+	}
+
+	cmd.Flags().String(flagPubkeys, "", "Comma separated hex pubkeys of multisig account")
+	cmd.Flags().Int(flagThreshold, 1, "Minimum number of signatures required")
+	cmd.Flags().Bool(flagOffline, false, "Offline mode; Do not query a full node")
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+func makeMultiSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) (err error) {
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+		stdTx, err := helper.ReadStdTxFromFile(cliCtx.Codec, args[0])
+		if err != nil {
+			return err
+		}
+
+		multisigPubKey, err := multisigPubKeyFromFlags()
+		if err != nil {
+			return err
+		}
+
+		sigs := make([]types.StdSignature, 0, len(args)-1)
+		for _, filename := range args[1:] {
+			bz, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return err
+			}
+
+			var sig types.StdSignature
+			if err := cdc.UnmarshalJSON(bz, &sig); err != nil {
+				return err
+			}
+			sigs = append(sigs, sig)
+		}
+
+		newTx, err := helper.MultiSignStdTx(cliCtx, stdTx, multisigPubKey, sigs, viper.GetBool(flagOffline))
+		if err != nil {
+			return err
+		}
+
+		var json []byte
+		switch cliCtx.Indent {
+		case true:
+			json, err = cdc.MarshalJSONIndent(newTx, "", "  ")
+
+		default:
+			json, err = cdc.MarshalJSON(newTx)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if viper.GetString(flagOutfile) == "" {
+			fmt.Printf("%s\n", json)
+			return
+		}
+
+		fp, err := os.OpenFile(
+			viper.GetString(flagOutfile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644,
+		)
+		if err != nil {
+			return err
+		}
+
+		defer fp.Close()
+		fmt.Fprintf(fp, "%s\n", json)
+
+		return
+	}
+}
+
+// multisigPubKeyFromFlags creates multisig pubkey from --pubkeys and --threshold
+func multisigPubKeyFromFlags() (multisig.PubKeyMultisigThreshold, error) {
+	pubkeyStrs := strings.Split(viper.GetString(flagPubkeys), ",")
+
+	pubkeys := make([]crypto.PubKey, 0, len(pubkeyStrs))
+	for _, pubkeyStr := range pubkeyStrs {
+		pubkeyBytes := common.FromHex(strings.TrimSpace(pubkeyStr))
+		if len(pubkeyBytes) != 65 {
+			return multisig.PubKeyMultisigThreshold{}, fmt.Errorf("Invalid public key length")
+		}
+		pubkeys = append(pubkeys, hmTypes.NewPubKey(pubkeyBytes).CryptoPubKey())
+	}
+
+	return types.NewMultisigPubKey(viper.GetInt(flagThreshold), pubkeys)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/maticnetwork/bor/common"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	amino "github.com/tendermint/go-amino"
@@ -33,6 +34,10 @@ order, and if the signature is valid over the given transaction. If the --offlin
 flag is also set, signature validation over the transaction will be not be
 performed as that will require RPC communication with a full node.
 
+If the flag --multisig is set, the transaction is signed on behalf of the given
+multisig account and only the member signature is printed. Member signatures are
+combined with the multisign command.
+
 The --offline flag makes sure that the client will not reach out to full node.
 As a result, the account and sequence number queries will not be performed and
 it is required to set such parameters manually. Note, invalid values will cause
@@ -46,6 +51,7 @@ the transaction to fail.
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signature, then exit")
 	cmd.Flags().Bool(flagOffline, false, "Offline mode; Do not query a full node")
 	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+	cmd.Flags().String(flagMultisig, "", "Address of multisig account on behalf of which to sign")
 
 	cmd = client.PostCommands(cmd)[0]
 	// cmd.MarkFlagRequired(client.FlagFrom)
@@ -81,7 +87,14 @@ func makeSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string) error
 		generateSignatureOnly := viper.GetBool(flagSigOnly)
 
 		appendSig := viper.GetBool(flagAppend) && !generateSignatureOnly
-		newTx, err = helper.SignStdTx(cliCtx, stdTx, appendSig, offline)
+
+		// multisig member signs on behalf of multisig account
+		if multisigAddr := viper.GetString(flagMultisig); multisigAddr != "" {
+			generateSignatureOnly = true
+			newTx.Signature, err = helper.SignStdTxForMultisig(cliCtx, stdTx, common.FromHex(multisigAddr), offline)
+		} else {
+			newTx, err = helper.SignStdTx(cliCtx, stdTx, appendSig, offline)
+		}
 
 		if err != nil {
 			return err
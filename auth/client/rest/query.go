@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -47,10 +48,38 @@ func QueryAccountRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		cliCtx = cliCtx.WithHeight(height)
+
+		// vesting accounts show vested and vesting amounts at queried height
+		if vestingAccount, ok := account.(authTypes.VestingAccount); ok {
+			blockTime, err := getBlockTime(cliCtx, height)
+			if err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			hmRest.PostProcessResponse(w, cliCtx, authTypes.NewVestingAccountOutput(vestingAccount, blockTime))
+			return
+		}
+
 		hmRest.PostProcessResponse(w, cliCtx, account)
 	}
 }
 
+// getBlockTime returns time of block at height
+func getBlockTime(cliCtx context.CLIContext, height int64) (time.Time, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	block, err := node.Block(&height)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return block.Block.Time, nil
+}
+
 // QueryAccountSequenceRequestHandlerFn query account sequence REST Handler
 func QueryAccountSequenceRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -114,3 +143,31 @@ func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the effective fee of a msg type
+func msgFeeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		queryParams, err := cliCtx.Codec.MarshalJSON(authTypes.NewQueryMsgFeeParams(vars["route"], vars["type"]))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", authTypes.QuerierRoute, authTypes.QueryMsgFee)
+		res, height, err := cliCtx.QueryWithData(route, queryParams)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -38,3 +38,17 @@ type Account interface {
 	// Ensure that account implements stringer
 	String() string
 }
+
+// VestingAccount defines an account type that vests coins via a vesting schedule.
+type VestingAccount interface {
+	Account
+
+	// Calculates the vested and vesting coins given the current time.
+	GetVestedCoins(blockTime time.Time) sdk.Coins
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+
+	GetStartTime() int64
+	GetEndTime() int64
+
+	GetOriginalVesting() sdk.Coins
+}
@@ -14,12 +14,11 @@ func InitGenesis(ctx sdk.Context, ak AccountKeeper, processors []authTypes.Accou
 	for _, gacc := range data.Accounts {
 		acc := gacc.ToAccount()
 
-		// convert to base account
-		d := acc.(*authTypes.BaseAccount)
-
-		// execute account processors
-		for _, p := range processors {
-			acc = p(&gacc, d)
+		// execute account processors on base accounts, vesting accounts are already converted
+		if d, ok := acc.(*authTypes.BaseAccount); ok {
+			for _, p := range processors {
+				acc = p(&gacc, d)
+			}
 		}
 
 		acc = ak.NewAccount(ctx, acc)
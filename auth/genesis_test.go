@@ -3,6 +3,7 @@ package auth_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,7 @@ import (
 	"github.com/maticnetwork/heimdall/auth"
 	"github.com/maticnetwork/heimdall/auth/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/simulation"
 )
 
@@ -66,3 +68,30 @@ func (suite *GenesisTestSuite) TestExportGenesis() {
 	genesisState := auth.ExportGenesis(ctx, happ.AccountKeeper)
 	require.LessOrEqual(t, 10, len(genesisState.Accounts))
 }
+
+func (suite *GenesisTestSuite) TestVestingGenesis() {
+	t := suite.T()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 1000))
+	gacc := authTypes.GenesisAccount{
+		Address:         hmTypes.HexToHeimdallAddress("0x1"),
+		Coins:           coins,
+		OriginalVesting: coins,
+		EndTime:         time.Now().Add(time.Hour).Unix(),
+	}
+
+	happ := app.SetupWithGenesisAccounts(authTypes.GenesisAccounts{gacc})
+	ctx := happ.BaseApp.NewContext(true, abci.Header{})
+
+	acc := happ.AccountKeeper.GetAccount(ctx, gacc.Address)
+	require.IsType(t, &authTypes.DelayedVestingAccount{}, acc)
+
+	genesisState := auth.ExportGenesis(ctx, happ.AccountKeeper)
+	require.True(t, genesisState.Accounts.Contains(gacc.Address))
+	for _, exported := range genesisState.Accounts {
+		if exported.Address.Equals(gacc.Address) {
+			require.Equal(t, coins, exported.OriginalVesting)
+			require.Equal(t, gacc.EndTime, exported.EndTime)
+		}
+	}
+}
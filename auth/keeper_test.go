@@ -206,10 +206,8 @@ func (suite *KeeperTestSuite) TestIterateAccounts() {
 	app.AccountKeeper.IterateAccounts(ctx, func(acc types.Account) bool {
 		filteredAccounts = append(filteredAccounts, acc)
 
-		if acc.GetAccountNumber() > 5 {
-			return true
-		}
-		return false
+		// stop iteration after 5 accounts, module accounts from genesis take account numbers too
+		return len(filteredAccounts) == 5
 	})
 	require.Equal(t, 5, len(filteredAccounts))
 }
@@ -0,0 +1,64 @@
+package v0_3
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/maticnetwork/heimdall/migrate"
+)
+
+// Migrate adds the per-msg fee schedule and the vesting fields of genesis
+// accounts. Accounts keep vesting nothing.
+func Migrate(oldGenState json.RawMessage) (json.RawMessage, error) {
+	genState, err := migrate.DecodeObject(oldGenState)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := genState.Object("params")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := params.SetDefault("msg_fees", migrate.EmptyList); err != nil {
+		return nil, err
+	}
+
+	if err := genState.Set("params", params); err != nil {
+		return nil, err
+	}
+
+	accounts, err := genState.List("accounts")
+	if err != nil {
+		return nil, err
+	}
+
+	for i, bz := range accounts {
+		account, err := migrate.DecodeObject(bz)
+		if err != nil {
+			return nil, fmt.Errorf("account %d: %v", i, err)
+		}
+
+		if err := account.SetDefault("original_vesting", migrate.EmptyList); err != nil {
+			return nil, err
+		}
+		if err := account.SetDefault("start_time", int64(0)); err != nil {
+			return nil, err
+		}
+		if err := account.SetDefault("end_time", int64(0)); err != nil {
+			return nil, err
+		}
+
+		if accounts[i], err = account.Marshal(); err != nil {
+			return nil, err
+		}
+	}
+
+	if accounts != nil {
+		if err := genState.Set("accounts", accounts); err != nil {
+			return nil, err
+		}
+	}
+
+	return genState.Marshal()
+}
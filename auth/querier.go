@@ -19,6 +19,8 @@ func NewQuerier(keeper AccountKeeper) sdk.Querier {
 			return queryParams(ctx, req, keeper)
 		case types.QueryAccount:
 			return queryAccount(ctx, req, keeper)
+		case types.QueryMsgFee:
+			return queryMsgFee(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -51,3 +53,16 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, keeper AccountKeeper)
 
 	return bz, nil
 }
+
+func queryMsgFee(ctx sdk.Context, req abci.RequestQuery, keeper AccountKeeper) ([]byte, sdk.Error) {
+	var params types.QueryMsgFeeParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := json.Marshal(keeper.GetParams(ctx).GetMsgFee(params.Route, params.Type))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
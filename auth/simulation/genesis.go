@@ -119,6 +119,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 		maxTxGas,
 		txFees,
+		nil,
 	)
 	genesisAccs := RandomGenesisAccounts(simState)
 
@@ -16,6 +16,11 @@ type GenesisAccount struct {
 	Sequence      uint64                  `json:"sequence_number" yaml:"sequence_number"`
 	AccountNumber uint64                  `json:"account_number" yaml:"account_number"`
 
+	// vesting account fields
+	OriginalVesting sdk.Coins `json:"original_vesting" yaml:"original_vesting"` // total vesting coins upon initialization
+	StartTime       int64     `json:"start_time" yaml:"start_time"`             // vesting start time (UNIX Epoch time), zero for delayed vesting
+	EndTime         int64     `json:"end_time" yaml:"end_time"`                 // vesting end time (UNIX Epoch time)
+
 	// module account fields
 	ModuleName        string   `json:"module_name" yaml:"module_name"`               // name of the module account
 	ModulePermissions []string `json:"module_permissions" yaml:"module_permissions"` // permissions of module account
@@ -23,6 +28,24 @@ type GenesisAccount struct {
 
 // Validate checks for errors on the vesting and module account parameters
 func (ga GenesisAccount) Validate() error {
+	if !ga.OriginalVesting.IsZero() {
+		if ga.ModuleName != "" {
+			return errors.New("module account cannot be a vesting account")
+		}
+
+		if !ga.OriginalVesting.IsAllLTE(ga.Coins) {
+			return errors.New("vesting amount cannot be greater than total amount")
+		}
+
+		if ga.EndTime <= 0 {
+			return errors.New("vesting end-time must be set")
+		}
+
+		if ga.StartTime >= ga.EndTime {
+			return errors.New("vesting start-time cannot be after end-time")
+		}
+	}
+
 	// don't allow blank (i.e just whitespaces) on the module name
 	if ga.ModuleName != "" && strings.TrimSpace(ga.ModuleName) == "" {
 		return errors.New("module account name cannot be blank")
@@ -73,6 +96,10 @@ func NewGenesisAccountI(acc Account) (GenesisAccount, error) {
 	}
 
 	switch acc := acc.(type) {
+	case VestingAccount:
+		gacc.OriginalVesting = acc.GetOriginalVesting()
+		gacc.StartTime = acc.GetStartTime()
+		gacc.EndTime = acc.GetEndTime()
 	case supplyExported.ModuleAccountI:
 		gacc.ModuleName = acc.GetName()
 		gacc.ModulePermissions = acc.GetPermissions()
@@ -84,6 +111,16 @@ func NewGenesisAccountI(acc Account) (GenesisAccount, error) {
 // ToAccount converts a GenesisAccount to an Account interface
 func (ga *GenesisAccount) ToAccount() Account {
 	bacc := NewBaseAccount(ga.Address, ga.Coins.Sort(), nil, ga.AccountNumber, ga.Sequence)
+
+	if !ga.OriginalVesting.IsZero() {
+		baseVestingAcc := NewBaseVestingAccount(bacc, ga.OriginalVesting.Sort(), ga.EndTime)
+
+		if ga.StartTime != 0 {
+			return NewContinuousVestingAccountRaw(baseVestingAcc, ga.StartTime)
+		}
+		return NewDelayedVestingAccountRaw(baseVestingAcc)
+	}
+
 	return bacc
 }
 
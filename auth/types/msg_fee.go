@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgFee defines fee and gas limit charged for txs with msg of given route and type.
+// Empty type matches all msgs of the route. Validator exempt msgs are free when
+// signed by a current validator.
+type MsgFee struct {
+	Route           string `json:"route" yaml:"route"`
+	Type            string `json:"type" yaml:"type"`
+	TxFees          string `json:"tx_fees" yaml:"tx_fees"`
+	MaxTxGas        uint64 `json:"max_tx_gas" yaml:"max_tx_gas"`
+	ValidatorExempt bool   `json:"validator_exempt" yaml:"validator_exempt"`
+}
+
+// NewMsgFee creates a new MsgFee object
+func NewMsgFee(route string, msgType string, txFees string, maxTxGas uint64, validatorExempt bool) MsgFee {
+	return MsgFee{
+		Route:           route,
+		Type:            msgType,
+		TxFees:          txFees,
+		MaxTxGas:        maxTxGas,
+		ValidatorExempt: validatorExempt,
+	}
+}
+
+// Matches returns true if msg fee applies to msg with given route and type
+func (f MsgFee) Matches(route string, msgType string) bool {
+	return f.Route == route && (f.Type == "" || f.Type == msgType)
+}
+
+// GetFees returns tx fees as coins
+func (f MsgFee) GetFees() (sdk.Coins, bool) {
+	amount, ok := sdk.NewIntFromString(f.TxFees)
+	if !ok {
+		return nil, false
+	}
+
+	return sdk.Coins{sdk.Coin{Denom: FeeToken, Amount: amount}}, true
+}
+
+// String implements the stringer interface.
+func (f MsgFee) String() string {
+	return fmt.Sprintf(`MsgFee:
+  Route:           %s
+  Type:            %s
+  TxFees:          %s
+  MaxTxGas:        %d
+  ValidatorExempt: %t
+`, f.Route, f.Type, f.TxFees, f.MaxTxGas, f.ValidatorExempt)
+}
+
+func validateMsgFees(i interface{}) error {
+	v, ok := i.([]MsgFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, f := range v {
+		if strings.TrimSpace(f.Route) == "" {
+			return fmt.Errorf("invalid msg fee route: %s", f.Route)
+		}
+
+		key := f.Route + "/" + f.Type
+		if seen[key] {
+			return fmt.Errorf("duplicate msg fee for %s", key)
+		}
+		seen[key] = true
+
+		if err := validateTxFees(f.TxFees); err != nil {
+			return err
+		}
+
+		if err := validateMaxTxGas(f.MaxTxGas); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
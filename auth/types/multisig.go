@@ -0,0 +1,125 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ethCrypto "github.com/maticnetwork/bor/crypto"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// MultiSignature is the combined signature of a threshold multisig account.
+// It carries the multisig pubkey so that account pubkey can be set on first tx.
+type MultiSignature struct {
+	PubKey    multisig.PubKeyMultisigThreshold `json:"pub_key" yaml:"pub_key"`
+	Signature multisig.Multisignature          `json:"signature" yaml:"signature"`
+}
+
+// NewMultisigPubKey creates k of n threshold multisig pubkey. Order of pubkeys
+// matters as it defines multisig address.
+func NewMultisigPubKey(threshold int, pubkeys []crypto.PubKey) (multisig.PubKeyMultisigThreshold, error) {
+	if threshold <= 0 || len(pubkeys) < threshold {
+		return multisig.PubKeyMultisigThreshold{}, fmt.Errorf("invalid multisig threshold %d for %d pubkeys", threshold, len(pubkeys))
+	}
+
+	seen := make(map[string]bool)
+	for _, pubkey := range pubkeys {
+		if _, ok := pubkey.(secp256k1.PubKeySecp256k1); !ok {
+			return multisig.PubKeyMultisigThreshold{}, errors.New("multisig pubkeys must be secp256k1 keys")
+		}
+
+		if seen[pubkey.Address().String()] {
+			return multisig.PubKeyMultisigThreshold{}, fmt.Errorf("duplicate multisig pubkey %s", pubkey.Address())
+		}
+		seen[pubkey.Address().String()] = true
+	}
+
+	return multisig.NewPubKeyMultisigThreshold(threshold, pubkeys).(multisig.PubKeyMultisigThreshold), nil
+}
+
+// NewMultiSignature creates empty combined signature for multisig pubkey
+func NewMultiSignature(pubkey multisig.PubKeyMultisigThreshold) MultiSignature {
+	return MultiSignature{
+		PubKey:    pubkey,
+		Signature: *multisig.NewMultisig(len(pubkey.PubKeys)),
+	}
+}
+
+// AddSignature adds signature of one of multisig keys over sign bytes
+func (ms *MultiSignature) AddSignature(sig StdSignature, signBytes []byte) error {
+	signer, err := recoverSigner(sig, signBytes)
+	if err != nil {
+		return err
+	}
+
+	return ms.Signature.AddSignatureFromPubKey(sig, signer, ms.PubKey.PubKeys)
+}
+
+// Verify checks that at least threshold multisig keys signed sign bytes
+func (ms MultiSignature) Verify(signBytes []byte) error {
+	bitArray := ms.Signature.BitArray
+	if bitArray == nil || bitArray.Size() != len(ms.PubKey.PubKeys) {
+		return errors.New("invalid multisig bit array")
+	}
+
+	size := bitArray.Size()
+	if bitArray.NumTrueBitsBefore(size) < int(ms.PubKey.K) || bitArray.NumTrueBitsBefore(size) != len(ms.Signature.Sigs) {
+		return errors.New("not enough multisig signatures")
+	}
+
+	sigIndex := 0
+	for i := 0; i < size; i++ {
+		if !bitArray.GetIndex(i) {
+			continue
+		}
+
+		signer, err := recoverSigner(ms.Signature.Sigs[sigIndex], signBytes)
+		if err != nil || !bytes.Equal(signer.Address().Bytes(), ms.PubKey.PubKeys[i].Address().Bytes()) {
+			return fmt.Errorf("invalid multisig signature at index %d", i)
+		}
+		sigIndex++
+	}
+
+	return nil
+}
+
+// Bytes returns combined signature as StdSignature
+func (ms MultiSignature) Bytes() StdSignature {
+	return ModuleCdc.MustMarshalBinaryBare(ms)
+}
+
+// IsMultiSignature returns true if signature is not a single secp256k1 signature
+func IsMultiSignature(sig StdSignature) bool {
+	return len(sig) != ethCrypto.SignatureLength
+}
+
+// DecodeMultiSignature decodes combined signature of multisig account
+func DecodeMultiSignature(sig StdSignature) (ms MultiSignature, err error) {
+	err = ModuleCdc.UnmarshalBinaryBare(sig, &ms)
+	return ms, err
+}
+
+// IsMultisigPubKey returns true if pubkey is threshold multisig pubkey
+func IsMultisigPubKey(pubkey crypto.PubKey) bool {
+	_, ok := pubkey.(multisig.PubKeyMultisigThreshold)
+	return ok
+}
+
+// recoverSigner recovers secp256k1 pubkey from signature over sign bytes
+func recoverSigner(sig []byte, signBytes []byte) (crypto.PubKey, error) {
+	if len(sig) != ethCrypto.SignatureLength {
+		return nil, errors.New("invalid signature length")
+	}
+
+	p, err := RecoverPubkey(signBytes, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	var pk secp256k1.PubKeySecp256k1
+	copy(pk[:], p[:])
+	return pk, nil
+}
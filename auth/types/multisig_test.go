@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestMultiSignature(t *testing.T) {
+	priv1, priv2, priv3 := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	pubkeys := []crypto.PubKey{priv1.PubKey(), priv2.PubKey(), priv3.PubKey()}
+
+	// invalid threshold and duplicate pubkeys
+	_, err := NewMultisigPubKey(0, pubkeys)
+	require.Error(t, err)
+	_, err = NewMultisigPubKey(4, pubkeys)
+	require.Error(t, err)
+	_, err = NewMultisigPubKey(2, []crypto.PubKey{priv1.PubKey(), priv1.PubKey()})
+	require.Error(t, err)
+
+	multisigPk, err := NewMultisigPubKey(2, pubkeys)
+	require.NoError(t, err)
+
+	signBytes := StdSignBytes("test-chain", 1, 0, sdk.NewTestMsg(sdk.AccAddress(multisigPk.Address())), "")
+	multiSig := NewMultiSignature(multisigPk)
+
+	// below threshold
+	sig, err := priv2.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, multiSig.AddSignature(sig, signBytes))
+	require.Error(t, multiSig.Verify(signBytes))
+
+	sig, err = priv1.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, multiSig.AddSignature(sig, signBytes))
+	require.NoError(t, multiSig.Verify(signBytes))
+	require.True(t, IsMultiSignature(multiSig.Bytes()))
+
+	// encoding round trip
+	decoded, err := DecodeMultiSignature(multiSig.Bytes())
+	require.NoError(t, err)
+	require.True(t, decoded.PubKey.Equals(multisigPk))
+	require.NoError(t, decoded.Verify(signBytes))
+
+	// signatures are bound to sign bytes
+	require.Error(t, decoded.Verify(StdSignBytes("test-chain", 1, 1, sdk.NewTestMsg(sdk.AccAddress(multisigPk.Address())), "")))
+}
@@ -31,6 +31,7 @@ var (
 
 	KeyMaxTxGas = []byte("MaxTxGas")
 	KeyTxFees   = []byte("TxFees")
+	KeyMsgFees  = []byte("MsgFees")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -45,6 +46,8 @@ type Params struct {
 
 	MaxTxGas uint64 `json:"max_tx_gas" yaml:"max_tx_gas"`
 	TxFees   string `json:"tx_fees" yaml:"tx_fees"`
+
+	MsgFees []MsgFee `json:"msg_fees" yaml:"msg_fees"`
 }
 
 // NewParams creates a new Params object
@@ -57,6 +60,7 @@ func NewParams(
 
 	maxTxGas uint64,
 	txFees string,
+	msgFees []MsgFee,
 ) Params {
 
 	return Params{
@@ -68,6 +72,8 @@ func NewParams(
 
 		MaxTxGas: maxTxGas,
 		TxFees:   txFees,
+
+		MsgFees: msgFees,
 	}
 }
 
@@ -89,6 +95,7 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 
 		{KeyMaxTxGas, &p.MaxTxGas},
 		{KeyTxFees, &p.TxFees},
+		{KeyMsgFees, &p.MsgFees},
 	}
 }
 
@@ -124,6 +131,9 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("SigVerifyCostSecp256k1: %d\n", p.SigVerifyCostSecp256k1))
 	sb.WriteString(fmt.Sprintf("MaxTxGas: %d\n", p.MaxTxGas))
 	sb.WriteString(fmt.Sprintf("TxFees: %s\n", p.TxFees))
+	for _, f := range p.MsgFees {
+		sb.WriteString(fmt.Sprintf("MsgFee: %s/%s TxFees: %s MaxTxGas: %d ValidatorExempt: %t\n", f.Route, f.Type, f.TxFees, f.MaxTxGas, f.ValidatorExempt))
+	}
 	return sb.String()
 }
 
@@ -227,6 +237,29 @@ func (p Params) Validate() error {
 	if err := validateTxFees(p.TxFees); err != nil {
 		return err
 	}
+	if err := validateMsgFees(p.MsgFees); err != nil {
+		return err
+	}
 
 	return nil
 }
+
+// GetMsgFee returns effective fee schedule for msg with given route and type.
+// Exact type match takes precedence over route-wide entry, default tx fees and gas
+// apply when no entry matches.
+func (p Params) GetMsgFee(route string, msgType string) MsgFee {
+	fee := NewMsgFee(route, msgType, p.TxFees, p.MaxTxGas, false)
+	for _, f := range p.MsgFees {
+		if !f.Matches(route, msgType) {
+			continue
+		}
+
+		if f.Type == msgType {
+			return f
+		}
+
+		fee = f
+	}
+
+	return fee
+}
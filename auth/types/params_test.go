@@ -14,3 +14,31 @@ func TestParamsEqual(t *testing.T) {
 	p1.TxSigLimit += 10
 	require.NotEqual(t, p1, p2)
 }
+
+func TestGetMsgFee(t *testing.T) {
+	params := DefaultParams()
+
+	// default fee schedule
+	fee := params.GetMsgFee("bank", "send")
+	require.Equal(t, params.TxFees, fee.TxFees)
+	require.Equal(t, params.MaxTxGas, fee.MaxTxGas)
+	require.False(t, fee.ValidatorExempt)
+
+	params.MsgFees = []MsgFee{
+		NewMsgFee("clerk", "", "0", 2000000, false),
+		NewMsgFee("clerk", "event-record", "10", 3000000, true),
+	}
+	require.NoError(t, params.Validate())
+
+	// exact type match takes precedence over route-wide entry
+	require.Equal(t, params.MsgFees[1], params.GetMsgFee("clerk", "event-record"))
+	require.Equal(t, params.MsgFees[0], params.GetMsgFee("clerk", "confirm-state-sync"))
+	require.Equal(t, params.TxFees, params.GetMsgFee("bank", "send").TxFees)
+
+	// duplicate and invalid entries
+	params.MsgFees = append(params.MsgFees, NewMsgFee("clerk", "", "1", 1, false))
+	require.Error(t, params.Validate())
+
+	params.MsgFees = []MsgFee{NewMsgFee("bank", "send", "1", 0, false)}
+	require.Error(t, params.Validate())
+}
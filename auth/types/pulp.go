@@ -58,10 +58,30 @@ func (p *Pulp) GetMsgTxInstance(hash []byte) interface{} {
 	return reflect.New(rtype).Elem().Interface().(sdk.Msg)
 }
 
-// EncodeToBytes encodes msg to bytes
+// EncodeToBytes encodes msg to bytes. Txs with fee granter use the
+// StdTxWithFeeGranterRaw layout, others the StdTxRaw layout.
 func (p *Pulp) EncodeToBytes(tx StdTx) ([]byte, error) {
 	msg := tx.GetMsgs()[0]
-	txBytes, err := rlp.EncodeToBytes(tx)
+	msgBytes, err := rlp.EncodeToBytes(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var txRaw interface{} = StdTxRaw{
+		Msg:       msgBytes,
+		Signature: tx.Signature,
+		Memo:      tx.Memo,
+	}
+	if !tx.FeeGranter.Empty() {
+		txRaw = StdTxWithFeeGranterRaw{
+			Msg:        msgBytes,
+			Signature:  tx.Signature,
+			Memo:       tx.Memo,
+			FeeGranter: tx.FeeGranter,
+		}
+	}
+
+	txBytes, err := rlp.EncodeToBytes(txRaw)
 	if err != nil {
 		return nil, err
 	}
@@ -69,19 +89,55 @@ func (p *Pulp) EncodeToBytes(tx StdTx) ([]byte, error) {
 	return append(GetPulpHash(msg), txBytes[:]...), nil
 }
 
-// DecodeBytes decodes bytes to msg
+// DecodeBytes decodes bytes to msg. Both StdTxRaw and StdTxWithFeeGranterRaw
+// layouts are accepted, told apart by the number of list elements.
 func (p *Pulp) DecodeBytes(data []byte) (interface{}, error) {
-	var txRaw StdTxRaw
+	var txRaw StdTxWithFeeGranterRaw
 
 	if len(data) <= PulpHashLength {
 		return nil, errors.New("Invalid data length, should be greater than PulpPrefix")
 	}
 
-	if err := rlp.DecodeBytes(data[PulpHashLength:], &txRaw); err != nil {
+	content, _, err := rlp.SplitList(data[PulpHashLength:])
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := rlp.CountValues(content)
+	if err != nil {
 		return nil, err
 	}
 
-	rtype := p.typeInfos[hex.EncodeToString(data[:PulpHashLength])]
+	switch count {
+	case 3:
+		var legacyRaw StdTxRaw
+		if err := rlp.DecodeBytes(data[PulpHashLength:], &legacyRaw); err != nil {
+			return nil, err
+		}
+
+		txRaw = StdTxWithFeeGranterRaw{
+			Msg:       legacyRaw.Msg,
+			Signature: legacyRaw.Signature,
+			Memo:      legacyRaw.Memo,
+		}
+	case 4:
+		if err := rlp.DecodeBytes(data[PulpHashLength:], &txRaw); err != nil {
+			return nil, err
+		}
+
+		// only one encoding per tx, txs without fee granter use StdTxRaw
+		if txRaw.FeeGranter.Empty() {
+			return nil, errors.New("Invalid fee granter, txs without fee granter must use StdTxRaw")
+		}
+	default:
+		return nil, fmt.Errorf("Invalid tx, unknown layout with %d fields", count)
+	}
+
+	rtype, ok := p.typeInfos[hex.EncodeToString(data[:PulpHashLength])]
+	if !ok {
+		return nil, errors.New("Invalid tx, unknown msg type")
+	}
+
 	newMsg := reflect.New(rtype).Interface()
 	if err := rlp.DecodeBytes(txRaw.Msg[:], newMsg); err != nil {
 		return nil, err
@@ -93,9 +149,10 @@ func (p *Pulp) DecodeBytes(data []byte) (interface{}, error) {
 	// return vptr.Interface(), nil
 
 	result := StdTx{
-		Msg:       vptr.Interface().(sdk.Msg),
-		Signature: txRaw.Signature,
-		Memo:      txRaw.Memo,
+		Msg:        vptr.Interface().(sdk.Msg),
+		Signature:  txRaw.Signature,
+		Memo:       txRaw.Memo,
+		FeeGranter: txRaw.FeeGranter,
 	}
 	return result, nil
 }
@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/rlp"
 	assert "github.com/stretchr/testify/require"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 func TestGetPulpHash(t *testing.T) {
@@ -18,3 +21,58 @@ func TestGetPulpHash(t *testing.T) {
 	out := GetPulpHash(tc.in)
 	assert.Equal(t, string(tc.out), string(out))
 }
+
+func TestPulpTxLayouts(t *testing.T) {
+	p := NewPulp()
+	msg := pulpTestMsg{From: hmTypes.AccAddressToHeimdallAddress(addr), Value: 10}
+	p.RegisterConcrete(msg)
+
+	msgBytes, err := rlp.EncodeToBytes(msg)
+	assert.NoError(t, err)
+
+	// tx encoded before fee grants were added
+	legacyBytes, err := rlp.EncodeToBytes(StdTxRaw{Msg: msgBytes, Signature: StdSignature{1, 2, 3}, Memo: "memo"})
+	assert.NoError(t, err)
+	legacyBytes = append(GetPulpHash(msg), legacyBytes...)
+
+	decoded, err := p.DecodeBytes(legacyBytes)
+	assert.NoError(t, err)
+	tx := decoded.(StdTx)
+	assert.Equal(t, msg, tx.Msg)
+	assert.Equal(t, StdSignature{1, 2, 3}, tx.Signature)
+	assert.Equal(t, "memo", tx.Memo)
+	assert.True(t, tx.FeeGranter.Empty())
+
+	// tx without fee granter keeps the legacy layout
+	bz, err := p.EncodeToBytes(tx)
+	assert.NoError(t, err)
+	assert.Equal(t, legacyBytes, bz)
+
+	// tx with fee granter
+	granter := hmTypes.BytesToHeimdallAddress([]byte("granter"))
+	bz, err = p.EncodeToBytes(tx.WithFeeGranter(granter))
+	assert.NoError(t, err)
+	assert.NotEqual(t, legacyBytes, bz)
+
+	decoded, err = p.DecodeBytes(bz)
+	assert.NoError(t, err)
+	assert.Equal(t, tx.WithFeeGranter(granter), decoded)
+
+	// empty fee granter in the fee granter layout is rejected
+	bz, err = rlp.EncodeToBytes(StdTxWithFeeGranterRaw{Msg: msgBytes, Signature: tx.Signature, Memo: tx.Memo})
+	assert.NoError(t, err)
+	_, err = p.DecodeBytes(append(GetPulpHash(msg), bz...))
+	assert.Error(t, err)
+}
+
+// pulpTestMsg is a msg with RLP encodable fields
+type pulpTestMsg struct {
+	From  hmTypes.HeimdallAddress
+	Value uint64
+}
+
+func (msg pulpTestMsg) Route() string                { return "test" }
+func (msg pulpTestMsg) Type() string                 { return "pulp" }
+func (msg pulpTestMsg) ValidateBasic() sdk.Error     { return nil }
+func (msg pulpTestMsg) GetSignBytes() []byte         { return nil }
+func (msg pulpTestMsg) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.From.Bytes()} }
@@ -8,6 +8,7 @@ import (
 const (
 	QueryParams  = "params"
 	QueryAccount = "account"
+	QueryMsgFee  = "msg-fee"
 )
 
 // QueryAccountParams defines the params for querying accounts.
@@ -19,3 +20,14 @@ type QueryAccountParams struct {
 func NewQueryAccountParams(addr types.HeimdallAddress) QueryAccountParams {
 	return QueryAccountParams{Address: addr}
 }
+
+// QueryMsgFeeParams defines the params for querying effective fee of msg type.
+type QueryMsgFeeParams struct {
+	Route string
+	Type  string
+}
+
+// NewQueryMsgFeeParams creates a new instance of QueryMsgFeeParams.
+func NewQueryMsgFeeParams(route string, msgType string) QueryMsgFeeParams {
+	return QueryMsgFeeParams{Route: route, Type: msgType}
+}
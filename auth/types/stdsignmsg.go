@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 //__________________________________________________________
@@ -19,10 +21,22 @@ type StdSignDoc struct {
 	Sequence      uint64          `json:"sequence" yaml:"sequence"`
 	Msg           json.RawMessage `json:"msg" yaml:"msg"`
 	Memo          string          `json:"memo" yaml:"memo"`
+	FeeGranter    string          `json:"fee_granter,omitempty" yaml:"fee_granter"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum uint64, sequence uint64, msg sdk.Msg, memo string) []byte {
+	return StdSignBytesWithFeeGranter(chainID, accnum, sequence, msg, memo, hmTypes.ZeroHeimdallAddress)
+}
+
+// StdSignBytesWithFeeGranter returns the bytes to sign for a transaction which fees
+// are paid by granter. Sign bytes of transactions without granter are unchanged.
+func StdSignBytesWithFeeGranter(chainID string, accnum uint64, sequence uint64, msg sdk.Msg, memo string, feeGranter hmTypes.HeimdallAddress) []byte {
+	var granter string
+	if !feeGranter.Empty() {
+		granter = feeGranter.String()
+	}
+
 	msgsBytes := json.RawMessage(msg.GetSignBytes())
 	bz, err := ModuleCdc.MarshalJSON(StdSignDoc{
 		AccountNumber: accnum,
@@ -30,6 +44,7 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, msg sdk.Msg, m
 		Memo:          memo,
 		Msg:           msgsBytes,
 		Sequence:      sequence,
+		FeeGranter:    granter,
 	})
 	if err != nil {
 		panic(err)
@@ -41,14 +56,15 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, msg sdk.Msg, m
 // a Msg with the other requirements for a StdSignDoc before
 // it is signed. For use in the CLI.
 type StdSignMsg struct {
-	ChainID       string  `json:"chain_id" yaml:"chain_id"`
-	AccountNumber uint64  `json:"account_number" yaml:"account_number"`
-	Sequence      uint64  `json:"sequence" yaml:"sequence"`
-	Msg           sdk.Msg `json:"msg" yaml:"msg"`
-	Memo          string  `json:"memo" yaml:"memo"`
+	ChainID       string                  `json:"chain_id" yaml:"chain_id"`
+	AccountNumber uint64                  `json:"account_number" yaml:"account_number"`
+	Sequence      uint64                  `json:"sequence" yaml:"sequence"`
+	Msg           sdk.Msg                 `json:"msg" yaml:"msg"`
+	Memo          string                  `json:"memo" yaml:"memo"`
+	FeeGranter    hmTypes.HeimdallAddress `json:"fee_granter" yaml:"fee_granter"`
 }
 
 // Bytes returns message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Msg, msg.Memo)
+	return StdSignBytesWithFeeGranter(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Msg, msg.Memo, msg.FeeGranter)
 }
@@ -2,11 +2,14 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/rlp"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 var (
@@ -15,9 +18,10 @@ var (
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 type StdTx struct {
-	Msg       sdk.Msg      `json:"msg" yaml:"msg"`
-	Signature StdSignature `json:"signature" yaml:"signature"`
-	Memo      string       `json:"memo" yaml:"memo"`
+	Msg        sdk.Msg                 `json:"msg" yaml:"msg"`
+	Signature  StdSignature            `json:"signature" yaml:"signature"`
+	Memo       string                  `json:"memo" yaml:"memo"`
+	FeeGranter hmTypes.HeimdallAddress `json:"fee_granter" yaml:"fee_granter"`
 }
 
 // StdTxRaw is a standard way to wrap a RLP Msg with Fee and Signatures.
@@ -27,6 +31,24 @@ type StdTxRaw struct {
 	Memo      string
 }
 
+// StdTxWithFeeGranterRaw is the RLP layout of a tx which fees are paid by a fee
+// granter. Txs without fee granter keep the StdTxRaw layout.
+type StdTxWithFeeGranterRaw struct {
+	Msg        rlp.RawValue
+	Signature  StdSignature
+	Memo       string
+	FeeGranter hmTypes.HeimdallAddress
+}
+
+// stdTxAmino is the amino layout of StdTx. Fee granter is left out when empty,
+// so txs without fee granter encode the same as before fee grants were added.
+type stdTxAmino struct {
+	Msg        sdk.Msg                  `json:"msg" yaml:"msg"`
+	Signature  StdSignature             `json:"signature" yaml:"signature"`
+	Memo       string                   `json:"memo" yaml:"memo"`
+	FeeGranter *hmTypes.HeimdallAddress `json:"fee_granter,omitempty" yaml:"fee_granter,omitempty"`
+}
+
 // NewStdTx is function to get new std tx object
 func NewStdTx(msg sdk.Msg, sig StdSignature, memo string) StdTx {
 	return StdTx{
@@ -36,6 +58,46 @@ func NewStdTx(msg sdk.Msg, sig StdSignature, memo string) StdTx {
 	}
 }
 
+// WithFeeGranter returns copy of tx with fees paid by granter's allowance
+func (tx StdTx) WithFeeGranter(granter hmTypes.HeimdallAddress) StdTx {
+	tx.FeeGranter = granter
+	return tx
+}
+
+// MarshalAmino encodes tx in its amino layout
+func (tx StdTx) MarshalAmino() (stdTxAmino, error) {
+	raw := stdTxAmino{
+		Msg:       tx.Msg,
+		Signature: tx.Signature,
+		Memo:      tx.Memo,
+	}
+
+	if !tx.FeeGranter.Empty() {
+		feeGranter := tx.FeeGranter
+		raw.FeeGranter = &feeGranter
+	}
+
+	return raw, nil
+}
+
+// UnmarshalAmino decodes tx from its amino layout
+func (tx *StdTx) UnmarshalAmino(raw stdTxAmino) error {
+	tx.Msg = raw.Msg
+	tx.Signature = raw.Signature
+	tx.Memo = raw.Memo
+	tx.FeeGranter = hmTypes.ZeroHeimdallAddress
+
+	if raw.FeeGranter != nil {
+		// only one encoding per tx, empty fee granter is left out
+		if raw.FeeGranter.Empty() {
+			return errors.New("fee granter must be left out when empty")
+		}
+		tx.FeeGranter = *raw.FeeGranter
+	}
+
+	return nil
+}
+
 // GetMsgs returns the all the transaction's messages.
 func (tx StdTx) GetMsgs() []sdk.Msg {
 	return []sdk.Msg{tx.Msg}
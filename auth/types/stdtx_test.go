@@ -13,6 +13,8 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
 	tmTypes "github.com/tendermint/tendermint/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 var (
@@ -86,3 +88,64 @@ func TestTxHash(t *testing.T) {
 	tx = txBz
 	require.Equal(t, txHashStr, hex.EncodeToString(tx.Hash()))
 }
+
+// legacyStdTx is the amino layout of StdTx before fee grants were added
+type legacyStdTx struct {
+	Msg       sdk.Msg      `json:"msg"`
+	Signature StdSignature `json:"signature"`
+	Memo      string       `json:"memo"`
+}
+
+// zeroGranterStdTx carries an explicitly set fee granter
+type zeroGranterStdTx struct {
+	Msg        sdk.Msg
+	Signature  StdSignature
+	Memo       string
+	FeeGranter *hmTypes.HeimdallAddress
+}
+
+func TestTxAminoLayouts(t *testing.T) {
+	newCodec := func(tx interface{}) *codec.Codec {
+		cdc := codec.New()
+		sdk.RegisterCodec(cdc)
+		cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+		cdc.RegisterConcrete(tx, "auth/StdTx", nil)
+		return cdc
+	}
+	cdc := newCodec(StdTx{})
+	legacyCdc := newCodec(legacyStdTx{})
+
+	msg := sdk.NewTestMsg(addr)
+	sig := StdSignature{1, 2, 3}
+
+	// tx without fee granter keeps the legacy layout
+	legacyBytes := legacyCdc.MustMarshalBinaryLengthPrefixed(legacyStdTx{Msg: msg, Signature: sig, Memo: "memo"})
+	txBytes, err := DefaultTxEncoder(cdc)(NewStdTx(msg, sig, "memo"))
+	require.NoError(t, err)
+	require.Equal(t, legacyBytes, txBytes)
+
+	// legacy tx decodes without fee granter
+	tx, err := DefaultTxDecoder(cdc)(legacyBytes)
+	require.NoError(t, err)
+	require.Equal(t, sig, tx.(StdTx).Signature)
+	require.Equal(t, "memo", tx.(StdTx).Memo)
+	require.True(t, tx.(StdTx).FeeGranter.Empty())
+
+	legacyJSON := legacyCdc.MustMarshalJSON(legacyStdTx{Msg: msg, Signature: sig, Memo: "memo"})
+	require.Equal(t, string(legacyJSON), string(cdc.MustMarshalJSON(NewStdTx(msg, sig, "memo"))))
+
+	// tx with fee granter
+	granter := hmTypes.BytesToHeimdallAddress([]byte("granter"))
+	txBytes, err = DefaultTxEncoder(cdc)(NewStdTx(msg, sig, "memo").WithFeeGranter(granter))
+	require.NoError(t, err)
+	tx, err = DefaultTxDecoder(cdc)(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, sig, tx.(StdTx).Signature)
+	require.Equal(t, granter, tx.(StdTx).FeeGranter)
+
+	// explicitly set empty fee granter is rejected
+	zeroGranterCdc := newCodec(zeroGranterStdTx{})
+	txBytes = zeroGranterCdc.MustMarshalBinaryLengthPrefixed(zeroGranterStdTx{Msg: msg, Signature: sig, Memo: "memo", FeeGranter: &hmTypes.ZeroHeimdallAddress})
+	_, err = DefaultTxDecoder(cdc)(txBytes)
+	require.Error(t, err)
+}
@@ -12,8 +12,13 @@ import (
 	ethCrypto "github.com/maticnetwork/bor/crypto/secp256k1"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+// FlagFeeGranter is the flag for account paying tx fees from its fee allowance
+const FlagFeeGranter = "fee-granter"
+
 // TxBuilder implements a transaction context created in SDK modules.
 type TxBuilder struct {
 	txEncoder          sdk.TxEncoder
@@ -27,6 +32,7 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         hmTypes.HeimdallAddress
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -74,6 +80,7 @@ func NewTxBuilderFromCLI() TxBuilder {
 		simulateAndExecute: client.GasFlagVar.Simulate,
 		chainID:            viper.GetString(client.FlagChainID),
 		memo:               viper.GetString(client.FlagMemo),
+		feeGranter:         hmTypes.HexToHeimdallAddress(viper.GetString(FlagFeeGranter)),
 	}
 
 	return txbldr
@@ -104,6 +111,9 @@ func (bldr TxBuilder) SimulateAndExecute() bool { return bldr.simulateAndExecute
 // ChainID returns the chain id
 func (bldr TxBuilder) ChainID() string { return bldr.chainID }
 
+// FeeGranter returns the account paying fees from its allowance
+func (bldr TxBuilder) FeeGranter() hmTypes.HeimdallAddress { return bldr.feeGranter }
+
 // Memo returns the memo message
 func (bldr TxBuilder) Memo() string { return bldr.memo }
 
@@ -177,6 +187,12 @@ func (bldr TxBuilder) WithAccountNumber(accnum uint64) TxBuilder {
 	return bldr
 }
 
+// WithFeeGranter returns a copy of the context with an updated fee granter.
+func (bldr TxBuilder) WithFeeGranter(feeGranter hmTypes.HeimdallAddress) TxBuilder {
+	bldr.feeGranter = feeGranter
+	return bldr
+}
+
 // BuildSignMsg builds a single message to be signed from a TxBuilder given a
 // set of messages. It returns an error if a fee is supplied but cannot be
 // parsed.
@@ -191,6 +207,7 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msg:           msgs[0], // allow only one message
+		FeeGranter:    bldr.feeGranter,
 	}, nil
 }
 
@@ -201,7 +218,7 @@ func (bldr TxBuilder) Sign(privKey secp256k1.PrivKeySecp256k1, msg StdSignMsg) (
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo))
+	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo).WithFeeGranter(msg.FeeGranter))
 }
 
 // SignWithPassphrase signs a transaction given a name, passphrase, and a single message to
@@ -212,7 +229,7 @@ func (bldr TxBuilder) SignWithPassphrase(name, passphrase string, msg StdSignMsg
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo))
+	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo).WithFeeGranter(msg.FeeGranter))
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -247,7 +264,7 @@ func (bldr TxBuilder) BuildTxForSim(msgs []sdk.Msg) ([]byte, error) {
 
 	// the ante handler will populate with a sentinel pubkey
 	sig := StdSignature{}
-	return bldr.txEncoder(NewStdTx(signMsg.Msg, sig, signMsg.Memo))
+	return bldr.txEncoder(NewStdTx(signMsg.Msg, sig, signMsg.Memo).WithFeeGranter(signMsg.FeeGranter))
 }
 
 // SignStdTxWithPassphrase appends a signature to a StdTx and returns a copy of it. If append
@@ -263,12 +280,13 @@ func (bldr TxBuilder) SignStdTxWithPassphrase(name, passphrase string, stdTx Std
 		Sequence:      bldr.sequence,
 		Msg:           stdTx.GetMsgs()[0],
 		Memo:          stdTx.GetMemo(),
+		FeeGranter:    stdTx.FeeGranter,
 	})
 	if err != nil {
 		return
 	}
 
-	signedStdTx = NewStdTx(stdTx.GetMsgs()[0], stdSignature, stdTx.GetMemo())
+	signedStdTx = NewStdTx(stdTx.GetMsgs()[0], stdSignature, stdTx.GetMemo()).WithFeeGranter(stdTx.FeeGranter)
 	return
 }
 
@@ -285,6 +303,7 @@ func (bldr TxBuilder) SignStdTx(privKey secp256k1.PrivKeySecp256k1, stdTx StdTx,
 		Sequence:      bldr.sequence,
 		Memo:          stdTx.Memo,
 		Msg:           stdTx.Msg, // allow only one message
+		FeeGranter:    stdTx.FeeGranter,
 	}
 
 	sig, err := MakeSignature(privKey, signMsg)
@@ -292,7 +311,7 @@ func (bldr TxBuilder) SignStdTx(privKey secp256k1.PrivKeySecp256k1, stdTx StdTx,
 		return
 	}
 
-	signedStdTx = NewStdTx(signMsg.Msg, sig, signMsg.Memo)
+	signedStdTx = NewStdTx(signMsg.Msg, sig, signMsg.Memo).WithFeeGranter(signMsg.FeeGranter)
 	return
 }
 
@@ -0,0 +1,272 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/maticnetwork/heimdall/auth/exported"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+type (
+	// VestingAccount is an account with vesting schedule
+	VestingAccount = exported.VestingAccount
+)
+
+//-----------------------------------------------------------------------------
+// Base Vesting Account
+
+// BaseVestingAccount implements the VestingAccount interface. It contains all
+// the necessary fields needed for any vesting account implementation.
+type BaseVestingAccount struct {
+	*BaseAccount
+
+	OriginalVesting sdk.Coins `json:"original_vesting" yaml:"original_vesting"` // coins in account upon initialization
+	EndTime         int64     `json:"end_time" yaml:"end_time"`                 // when the coins become unlocked
+}
+
+// NewBaseVestingAccount creates a new base vesting account
+func NewBaseVestingAccount(baseAccount *BaseAccount, originalVesting sdk.Coins, endTime int64) *BaseVestingAccount {
+	return &BaseVestingAccount{
+		BaseAccount:     baseAccount,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+}
+
+// spendableCoins returns all the spendable coins for a vesting account given a
+// set of vesting coins. Coins received after account creation are always spendable.
+func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
+	var spendableCoins sdk.Coins
+
+	for _, coin := range bva.Coins {
+		spendable := coin.Amount.Sub(vestingCoins.AmountOf(coin.Denom))
+		if spendable.IsPositive() {
+			spendableCoins = spendableCoins.Add(sdk.Coins{sdk.NewCoin(coin.Denom, spendable)})
+		}
+	}
+
+	return spendableCoins
+}
+
+// GetOriginalVesting returns a vesting account's original vesting amount
+func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
+	return bva.OriginalVesting
+}
+
+// GetEndTime returns a vesting account's end time
+func (bva BaseVestingAccount) GetEndTime() int64 {
+	return bva.EndTime
+}
+
+// Validate checks for errors on the account fields
+func (bva BaseVestingAccount) Validate() error {
+	if !bva.OriginalVesting.IsValid() || bva.OriginalVesting.IsZero() {
+		return errors.New("invalid original vesting amount")
+	}
+
+	return bva.BaseAccount.Validate()
+}
+
+//-----------------------------------------------------------------------------
+// Continuous Vesting Account
+
+var _ VestingAccount = (*ContinuousVestingAccount)(nil)
+
+// ContinuousVestingAccount implements the VestingAccount interface. It
+// continuously vests by unlocking coins linearly with respect to time.
+type ContinuousVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime int64 `json:"start_time" yaml:"start_time"` // when the coins start to vest
+}
+
+// NewContinuousVestingAccountRaw creates a new continuous vesting account from base vesting account
+func NewContinuousVestingAccountRaw(bva *BaseVestingAccount, startTime int64) *ContinuousVestingAccount {
+	return &ContinuousVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+	}
+}
+
+// NewContinuousVestingAccount returns a new ContinuousVestingAccount vesting all coins of base account
+func NewContinuousVestingAccount(baseAcc *BaseAccount, startTime, endTime int64) *ContinuousVestingAccount {
+	return NewContinuousVestingAccountRaw(NewBaseVestingAccount(baseAcc, baseAcc.Coins, endTime), startTime)
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= cva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	// calculate the vesting scalar
+	x := blockTime.Unix() - cva.StartTime
+	y := cva.EndTime - cva.StartTime
+	s := sdk.NewDec(x).Quo(sdk.NewDec(y))
+
+	for _, ovc := range cva.OriginalVesting {
+		vestedAmt := ovc.Amount.ToDec().Mul(s).RoundInt()
+		if vestedAmt.IsPositive() {
+			vestedCoins = vestedCoins.Add(sdk.Coins{sdk.NewCoin(ovc.Denom, vestedAmt)})
+		}
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// continuous vesting account.
+func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+}
+
+// GetStartTime returns the time when vesting starts for a continuous vesting
+// account.
+func (cva ContinuousVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// Validate checks for errors on the account fields
+func (cva ContinuousVestingAccount) Validate() error {
+	if cva.StartTime >= cva.EndTime {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+// String implements fmt.Stringer
+func (cva ContinuousVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ContinuousVestingAccount.
+func (cva ContinuousVestingAccount) MarshalYAML() (interface{}, error) {
+	return marshalVestingAccountYAML(cva, cva.BaseVestingAccount, cva.StartTime)
+}
+
+//-----------------------------------------------------------------------------
+// Delayed Vesting Account
+
+var _ VestingAccount = (*DelayedVestingAccount)(nil)
+
+// DelayedVestingAccount implements the VestingAccount interface. It vests all
+// coins after a specific time, but non prior. In other words, it keeps them
+// locked until a specified time.
+type DelayedVestingAccount struct {
+	*BaseVestingAccount
+}
+
+// NewDelayedVestingAccountRaw creates a new delayed vesting account from base vesting account
+func NewDelayedVestingAccountRaw(bva *BaseVestingAccount) *DelayedVestingAccount {
+	return &DelayedVestingAccount{
+		BaseVestingAccount: bva,
+	}
+}
+
+// NewDelayedVestingAccount returns a DelayedVestingAccount vesting all coins of base account
+func NewDelayedVestingAccount(baseAcc *BaseAccount, endTime int64) *DelayedVestingAccount {
+	return NewDelayedVestingAccountRaw(NewBaseVestingAccount(baseAcc, baseAcc.Coins, endTime))
+}
+
+// GetVestedCoins returns the total amount of vested coins for a delayed vesting
+// account. All coins are only vested once the schedule has elapsed.
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= dva.EndTime {
+		return dva.OriginalVesting
+	}
+
+	return nil
+}
+
+// GetVestingCoins returns the total number of vesting coins for a delayed
+// vesting account.
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return dva.OriginalVesting.Sub(dva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins for a delayed
+// vesting account.
+func (dva DelayedVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+}
+
+// GetStartTime returns zero since a delayed vesting account has no start time.
+func (dva DelayedVestingAccount) GetStartTime() int64 {
+	return 0
+}
+
+// String implements fmt.Stringer
+func (dva DelayedVestingAccount) String() string {
+	out, _ := dva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a DelayedVestingAccount.
+func (dva DelayedVestingAccount) MarshalYAML() (interface{}, error) {
+	return marshalVestingAccountYAML(dva, dva.BaseVestingAccount, 0)
+}
+
+//-----------------------------------------------------------------------------
+// Vesting account output
+
+// VestingAccountOutput is the vesting account with its vested and vesting amounts
+// at given time, used as query output
+type VestingAccountOutput struct {
+	Account      VestingAccount `json:"account" yaml:"account"`
+	VestedCoins  sdk.Coins      `json:"vested_coins" yaml:"vested_coins"`
+	VestingCoins sdk.Coins      `json:"vesting_coins" yaml:"vesting_coins"`
+}
+
+// NewVestingAccountOutput creates vesting account output at given time
+func NewVestingAccountOutput(acc VestingAccount, blockTime time.Time) VestingAccountOutput {
+	return VestingAccountOutput{
+		Account:      acc,
+		VestedCoins:  acc.GetVestedCoins(blockTime),
+		VestingCoins: acc.GetVestingCoins(blockTime),
+	}
+}
+
+func marshalVestingAccountYAML(acc VestingAccount, bva *BaseVestingAccount, startTime int64) (interface{}, error) {
+	bs, err := yaml.Marshal(struct {
+		Address         types.HeimdallAddress
+		Coins           sdk.Coins
+		AccountNumber   uint64
+		Sequence        uint64
+		OriginalVesting sdk.Coins
+		StartTime       int64
+		EndTime         int64
+	}{
+		Address:         acc.GetAddress(),
+		Coins:           acc.GetCoins(),
+		AccountNumber:   acc.GetAccountNumber(),
+		Sequence:        acc.GetSequence(),
+		OriginalVesting: bva.OriginalVesting,
+		StartTime:       startTime,
+		EndTime:         bva.EndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bs), nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func newVestingBaseAccount(coins sdk.Coins) *BaseAccount {
+	bacc := NewBaseAccountWithAddress(hmTypes.HexToHeimdallAddress("0x1"))
+	bacc.Coins = coins
+	return &bacc
+}
+
+func TestContinuousVestingAccount(t *testing.T) {
+	now := time.Now()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(FeeToken, 1000))
+	cva := NewContinuousVestingAccount(newVestingBaseAccount(coins), now.Unix(), now.Add(10*time.Second).Unix())
+	require.NoError(t, cva.Validate())
+
+	// nothing is vested before start
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Equal(t, coins, cva.GetVestingCoins(now))
+	require.Nil(t, cva.SpendableCoins(now))
+
+	// coins vest linearly
+	blockTime := now.Add(3 * time.Second)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(FeeToken, 300)), cva.GetVestedCoins(blockTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(FeeToken, 700)), cva.GetVestingCoins(blockTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(FeeToken, 300)), cva.SpendableCoins(blockTime))
+
+	// everything is vested after end
+	blockTime = now.Add(10 * time.Second)
+	require.Equal(t, coins, cva.GetVestedCoins(blockTime))
+	require.True(t, cva.GetVestingCoins(blockTime).IsZero())
+	require.Equal(t, coins, cva.SpendableCoins(blockTime))
+
+	// start after end is invalid
+	require.Error(t, NewContinuousVestingAccount(newVestingBaseAccount(coins), now.Unix(), now.Unix()).Validate())
+}
+
+func TestDelayedVestingAccount(t *testing.T) {
+	now := time.Now()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(FeeToken, 1000))
+	dva := NewDelayedVestingAccount(newVestingBaseAccount(coins), now.Add(10*time.Second).Unix())
+
+	// coins are locked until end
+	blockTime := now.Add(9 * time.Second)
+	require.Nil(t, dva.GetVestedCoins(blockTime))
+	require.Equal(t, coins, dva.GetVestingCoins(blockTime))
+	require.Nil(t, dva.SpendableCoins(blockTime))
+
+	// received coins are spendable
+	dva.Coins = coins.Add(sdk.NewCoins(sdk.NewInt64Coin(FeeToken, 50)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(FeeToken, 50)), dva.SpendableCoins(blockTime))
+
+	// all coins are unlocked at end
+	blockTime = now.Add(10 * time.Second)
+	require.Equal(t, coins, dva.GetVestedCoins(blockTime))
+	require.Equal(t, dva.Coins, dva.SpendableCoins(blockTime))
+}
+
+func TestVestingGenesisAccount(t *testing.T) {
+	now := time.Now()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(FeeToken, 1000))
+	gacc := GenesisAccount{
+		Address:         hmTypes.HexToHeimdallAddress("0x1"),
+		Coins:           coins,
+		OriginalVesting: coins,
+		StartTime:       now.Unix(),
+		EndTime:         now.Add(time.Hour).Unix(),
+	}
+	require.NoError(t, gacc.Validate())
+
+	// continuous vesting account
+	acc := gacc.ToAccount()
+	require.IsType(t, &ContinuousVestingAccount{}, acc)
+	exported, err := NewGenesisAccountI(acc)
+	require.NoError(t, err)
+	require.Equal(t, gacc, exported)
+
+	// delayed vesting account
+	gacc.StartTime = 0
+	require.IsType(t, &DelayedVestingAccount{}, gacc.ToAccount())
+
+	// invalid vesting
+	gacc.OriginalVesting = coins.Add(coins)
+	require.Error(t, gacc.Validate())
+	gacc.OriginalVesting = coins
+	gacc.EndTime = 0
+	require.Error(t, gacc.Validate())
+	gacc.EndTime = now.Unix()
+	gacc.ModuleName = "module"
+	require.Error(t, gacc.Validate())
+}
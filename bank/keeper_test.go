@@ -2,6 +2,7 @@ package bank_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
@@ -94,3 +95,37 @@ func (suite *KeeperTestSuite) TestGetSendEnabled() {
 	res := keeper.GetSendEnabled(ctx)
 	require.True(t, res)
 }
+
+func (suite *KeeperTestSuite) TestSubtractCoinsVesting() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.BankKeeper
+	address := hmTypes.HexToHeimdallAddress("789")
+	now := time.Now()
+	ctx = ctx.WithBlockTime(now)
+
+	// half of vesting coins is vested
+	coins := sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(100)))
+	bacc := authTypes.NewBaseAccountWithAddress(address)
+	bacc.Coins = coins
+	vacc := authTypes.NewContinuousVestingAccount(&bacc, now.Add(-time.Hour).Unix(), now.Add(time.Hour).Unix())
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, vacc))
+
+	// locked coins can't be spent
+	_, err := keeper.SubtractCoins(ctx, address, sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(51))))
+	require.Error(t, err)
+
+	_, err = keeper.SubtractCoins(ctx, address, sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(50))))
+	require.NoError(t, err)
+
+	// received coins are spendable
+	_, err = keeper.AddCoins(ctx, address, sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(10))))
+	require.NoError(t, err)
+	_, err = keeper.SubtractCoins(ctx, address, sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(10))))
+	require.NoError(t, err)
+
+	// all coins are vested after end time
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	_, err = keeper.SubtractCoins(ctx, address, sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(50))))
+	require.NoError(t, err)
+	require.True(t, keeper.GetCoins(ctx, address).IsZero())
+}
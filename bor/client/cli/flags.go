@@ -5,4 +5,8 @@ const (
 	FlagBorChainId      = "bor-chain-id"
 	FlagStartBlock      = "start-block"
 	FlagSpanId          = "span-id"
+	FlagSeed            = "seed"
+	FlagAlgorithm       = "algorithm"
+	FlagSecret          = "secret"
+	FlagSpans           = "spans"
 )
@@ -13,8 +13,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -35,6 +37,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetSpan(cdc),
 			GetLatestSpan(cdc),
 			GetQueryParams(cdc),
+			GetPreviewProducers(cdc),
+			GetSchedule(cdc),
 		)...,
 	)
 
@@ -116,6 +120,148 @@ func GetLatestSpan(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetPreviewProducers previews producers selection for given seed
+func GetPreviewProducers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview-producers",
+		Short: "preview producers selected for next span with given seed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Preview producers selected from current span eligible validators.
+
+Example:
+$ %s query bor preview-producers --seed 0xc46afc66ad9f4b237414c23a0cf0c469aeb60f52176565990644a9ee36a17667 --algorithm top-stake
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			seedStr := viper.GetString(FlagSeed)
+			if seedStr == "" {
+				return fmt.Errorf("seed cannot be empty")
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryPreviewProducersParams(hmTypes.HexToHeimdallHash(seedStr), viper.GetString(FlagAlgorithm)))
+			if err != nil {
+				return err
+			}
+
+			// fetch producers
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPreviewProducers), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagSeed, "", "--seed=<seed hash>")
+	cmd.Flags().String(FlagAlgorithm, "", fmt.Sprintf("--algorithm=<%s>", strings.Join(types.ProducerSelectionAlgorithms, "|")))
+	return cmd
+}
+
+// GetSchedule simulates producer schedule for upcoming spans
+func GetSchedule(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "show expected producers per span and sprint for current and upcoming spans",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Show producers of latest span, then simulate producer selection for next span using current span
+eligible validators and next span seed. Seeds of spans after next span are not known yet, so those spans
+are shown with "seed unknown" status and without producers.
+
+Example:
+$ %s query bor schedule --spans 3
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			spans := viper.GetUint64(FlagSpans)
+			if spans == 0 {
+				return errors.New("spans must be greater than 0")
+			}
+
+			// fetch params
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := json.Unmarshal(res, &params); err != nil {
+				return err
+			}
+
+			// fetch latest span
+			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLatestSpan), nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("Latest span not found")
+			}
+
+			var lastSpan hmTypes.Span
+			if err := json.Unmarshal(res, &lastSpan); err != nil {
+				return err
+			}
+
+			// fetch next span seed
+			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextSpanSeed), nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("next span seed not found")
+			}
+
+			var seed common.Hash
+			if err := json.Unmarshal(res, &seed); err != nil {
+				return err
+			}
+
+			// producers are selected by node against current span eligible validators
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryPreviewProducersParams(hmTypes.BytesToHeimdallHash(seed.Bytes()), params.ProducerSelectionAlgorithm))
+			if err != nil {
+				return err
+			}
+
+			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPreviewProducers), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var producers []hmTypes.Validator
+			if err := json.Unmarshal(res, &producers); err != nil {
+				return err
+			}
+
+			schedules := types.NewSpanSchedules(lastSpan, seed, producers, spans, params)
+
+			out, err := json.MarshalIndent(schedules, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSpans, 1, "--spans=<number of upcoming spans>")
+	return cmd
+}
+
 // GetQueryParams implements the params query command.
 func GetQueryParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -35,6 +35,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		client.PostCommands(
 			PostSendProposeSpanTx(cdc),
+			PostSendCommitSpanSeedTx(cdc),
+			PostSendRevealSpanSeedTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -140,3 +142,84 @@ func PostSendProposeSpanTx(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// PostSendCommitSpanSeedTx send commit span seed transaction
+func PostSendCommitSpanSeedTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-span-seed",
+		Short: "send commitment of secret for span seed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			spanID := viper.GetUint64(FlagSpanId)
+			if spanID == 0 {
+				return fmt.Errorf("Span Id cannot be empty")
+			}
+
+			secretStr := viper.GetString(FlagSecret)
+			if secretStr == "" {
+				return fmt.Errorf("Secret cannot be empty")
+			}
+
+			from := helper.GetFromAddress(cliCtx)
+			msg := types.NewMsgCommitSpanSeed(
+				from,
+				spanID,
+				types.SpanSeedCommitment(hmTypes.HexToHeimdallHash(secretStr), from),
+			)
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSpanId, 0, "--span-id=<span-id>")
+	cmd.Flags().String(FlagSecret, "", "--secret=<32 byte secret hex>")
+	if err := cmd.MarkFlagRequired(FlagSpanId); err != nil {
+		cliLogger.Error("PostSendCommitSpanSeedTx | MarkFlagRequired | FlagSpanId", "Error", err)
+	}
+	if err := cmd.MarkFlagRequired(FlagSecret); err != nil {
+		cliLogger.Error("PostSendCommitSpanSeedTx | MarkFlagRequired | FlagSecret", "Error", err)
+	}
+
+	return cmd
+}
+
+// PostSendRevealSpanSeedTx send reveal span seed transaction
+func PostSendRevealSpanSeedTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-span-seed",
+		Short: "send secret committed for span seed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			spanID := viper.GetUint64(FlagSpanId)
+			if spanID == 0 {
+				return fmt.Errorf("Span Id cannot be empty")
+			}
+
+			secretStr := viper.GetString(FlagSecret)
+			if secretStr == "" {
+				return fmt.Errorf("Secret cannot be empty")
+			}
+
+			msg := types.NewMsgRevealSpanSeed(
+				helper.GetFromAddress(cliCtx),
+				spanID,
+				hmTypes.HexToHeimdallHash(secretStr),
+			)
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSpanId, 0, "--span-id=<span-id>")
+	cmd.Flags().String(FlagSecret, "", "--secret=<32 byte secret hex>")
+	if err := cmd.MarkFlagRequired(FlagSpanId); err != nil {
+		cliLogger.Error("PostSendRevealSpanSeedTx | MarkFlagRequired | FlagSpanId", "Error", err)
+	}
+	if err := cmd.MarkFlagRequired(FlagSecret); err != nil {
+		cliLogger.Error("PostSendRevealSpanSeedTx | MarkFlagRequired | FlagSecret", "Error", err)
+	}
+
+	return cmd
+}
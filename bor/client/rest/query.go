@@ -24,6 +24,7 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bor/prepare-next-span", prepareNextSpanHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bor/next-span-seed", fetchNextSpanSeedHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bor/params", paramsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bor/preview-producers", previewProducersHandlerFn(cliCtx)).Methods("GET")
 }
 
 func fetchNextSpanSeedHandlerFn(
@@ -57,6 +58,41 @@ func fetchNextSpanSeedHandlerFn(
 	}
 }
 
+func previewProducersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get seed
+		if vars.Get("seed") == "" {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "seed is required")
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryPreviewProducersParams(hmTypes.HexToHeimdallHash(vars.Get("seed")), vars.Get("algorithm")))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPreviewProducers), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while previewing producers ", "Error", err.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func spanListHandlerFn(
 	cliCtx context.CLIContext,
 ) http.HandlerFunc {
@@ -22,6 +22,14 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/bor/propose-span",
 		postProposeSpanHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/bor/commit-span-seed",
+		postCommitSpanSeedHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/bor/reveal-span-seed",
+		postRevealSpanSeedHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 // ProposeSpanReq struct for proposing new span
@@ -33,6 +41,22 @@ type ProposeSpanReq struct {
 	BorChainID string `json:"bor_chain_id"`
 }
 
+// CommitSpanSeedReq struct for committing span seed secret
+type CommitSpanSeedReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	SpanID     uint64 `json:"span_id"`
+	Commitment string `json:"commitment"`
+}
+
+// RevealSpanSeedReq struct for revealing span seed secret
+type RevealSpanSeedReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	SpanID uint64 `json:"span_id"`
+	Secret string `json:"secret"`
+}
+
 func postProposeSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
@@ -95,3 +119,53 @@ func postProposeSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postCommitSpanSeedHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// read req from request
+		var req CommitSpanSeedReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// draft a commit span seed message
+		msg := types.NewMsgCommitSpanSeed(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.SpanID,
+			hmTypes.HexToHeimdallHash(req.Commitment),
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postRevealSpanSeedHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// read req from request
+		var req RevealSpanSeedReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// draft a reveal span seed message
+		msg := types.NewMsgRevealSpanSeed(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.SpanID,
+			hmTypes.HexToHeimdallHash(req.Secret),
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
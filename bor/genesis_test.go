@@ -8,6 +8,7 @@ import (
 	"github.com/maticnetwork/heimdall/bor"
 	"github.com/maticnetwork/heimdall/bor/types"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 
 	"github.com/stretchr/testify/require"
@@ -40,6 +41,7 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	end := uint64(0)
 	valSet := chSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
 	app.BorKeeper.SetParams(ctx, params)
+	app.StakingKeeper.SetParams(ctx, stakingTypes.DefaultParams())
 	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
 	producers, _ := app.BorKeeper.SelectNextProducers(ctx, hmTypes.ZeroHeimdallHash.EthHash())
 	for i := 0; i < spancount; i++ {
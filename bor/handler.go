@@ -17,6 +17,10 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgProposeSpan:
 			return HandleMsgProposeSpan(ctx, msg, k)
+		case types.MsgCommitSpanSeed:
+			return HandleMsgCommitSpanSeed(ctx, msg, k)
+		case types.MsgRevealSpanSeed:
+			return HandleMsgRevealSpanSeed(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in bor module").Result()
 		}
@@ -88,3 +92,115 @@ func HandleMsgProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, k Keeper) s
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// HandleMsgCommitSpanSeed handles commit span seed msg
+func HandleMsgCommitSpanSeed(ctx sdk.Context, msg types.MsgCommitSpanSeed, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating commit span seed msg",
+		"spanId", msg.SpanID,
+		"from", msg.From.String(),
+		"commitment", msg.Commitment.String(),
+	)
+
+	// only active validators can commit
+	validator, err := k.sk.GetActiveValidatorInfo(ctx, msg.From.Bytes())
+	if err != nil {
+		k.Logger(ctx).Error("Validator not found or not active", "from", msg.From.String(), "error", err)
+		return common.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch last span", "Error", err)
+		return common.ErrSpanNotFound(k.Codespace()).Result()
+	}
+
+	// secrets are committed one span ahead of reveal
+	if msg.SpanID != lastSpan.ID+2 {
+		k.Logger(ctx).Error("Span seed commit not accepted for span", "lastSpanId", lastSpan.ID, "spanId", msg.SpanID)
+		return common.ErrInvalidSeedCommit(k.Codespace()).Result()
+	}
+
+	if _, ok := k.GetSpanSeedCommit(ctx, msg.SpanID, validator.ID); ok {
+		k.Logger(ctx).Error("Span seed already committed", "spanId", msg.SpanID, "validatorId", validator.ID)
+		return common.ErrInvalidSeedCommit(k.Codespace()).Result()
+	}
+
+	if err := k.SetSpanSeedCommit(ctx, types.NewSpanSeedCommit(msg.SpanID, validator.ID, msg.Commitment)); err != nil {
+		return common.ErrInvalidSeedCommit(k.Codespace()).Result()
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCommitSpanSeed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(msg.SpanID, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyCommitment, msg.Commitment.String()),
+		),
+	})
+
+	// draft result with events
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// HandleMsgRevealSpanSeed handles reveal span seed msg
+func HandleMsgRevealSpanSeed(ctx sdk.Context, msg types.MsgRevealSpanSeed, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating reveal span seed msg",
+		"spanId", msg.SpanID,
+		"from", msg.From.String(),
+	)
+
+	validator, err := k.sk.GetValidatorInfo(ctx, msg.From.Bytes())
+	if err != nil {
+		k.Logger(ctx).Error("Validator not found", "from", msg.From.String(), "error", err)
+		return common.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch last span", "Error", err)
+		return common.ErrSpanNotFound(k.Codespace()).Result()
+	}
+
+	// secrets are revealed in span before the span they seed
+	if msg.SpanID != lastSpan.ID+1 {
+		k.Logger(ctx).Error("Span seed reveal not accepted for span", "lastSpanId", lastSpan.ID, "spanId", msg.SpanID)
+		return common.ErrInvalidSeedReveal(k.Codespace()).Result()
+	}
+
+	commit, ok := k.GetSpanSeedCommit(ctx, msg.SpanID, validator.ID)
+	if !ok || commit.Revealed {
+		k.Logger(ctx).Error("No pending span seed commit found", "spanId", msg.SpanID, "validatorId", validator.ID)
+		return common.ErrInvalidSeedReveal(k.Codespace()).Result()
+	}
+
+	// check secret against commitment
+	if !types.SpanSeedCommitment(msg.Secret, msg.From).Equals(commit.Commitment) {
+		k.Logger(ctx).Error("Secret doesn't match commitment", "spanId", msg.SpanID, "validatorId", validator.ID)
+		return common.ErrInvalidSeedReveal(k.Codespace()).Result()
+	}
+
+	commit.Secret = msg.Secret
+	commit.Revealed = true
+	if err := k.SetSpanSeedCommit(ctx, commit); err != nil {
+		return common.ErrInvalidSeedReveal(k.Codespace()).Result()
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealSpanSeed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(msg.SpanID, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+		),
+	})
+
+	// draft result with events
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
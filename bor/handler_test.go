@@ -2,14 +2,19 @@ package bor_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	borCommon "github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/bor"
 	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	"github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper/mocks"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -153,3 +158,94 @@ func (suite handlerSuite) TestHandleMsgProposeSpan() {
 
 	}
 }
+
+func (suite *handlerSuite) TestHandleMsgSpanSeedCommitReveal() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.BorKeeper
+
+	valSet := chSim.LoadValidatorSet(3, t, app.StakingKeeper, ctx, false, 0)
+	suite.Require().NoError(keeper.AddNewSpan(ctx, hmTypes.Span{ID: 1, StartBlock: 0, EndBlock: 255, ChainID: "15001"}))
+
+	secrets := []hmTypes.HeimdallHash{
+		hmTypes.BytesToHeimdallHash([]byte{0x01}),
+		hmTypes.BytesToHeimdallHash([]byte{0x02}),
+		hmTypes.BytesToHeimdallHash([]byte{0x08}),
+	}
+
+	// commits are accepted only for span after next
+	signer := valSet.Validators[0].Signer
+	result := bor.HandleMsgCommitSpanSeed(ctx, borTypes.NewMsgCommitSpanSeed(signer, 2, borTypes.SpanSeedCommitment(secrets[0], signer)), keeper)
+	suite.Equal(common.ErrInvalidSeedCommit("1").Result(), result, "commit for next span should fail")
+
+	// commit from non-validator fails
+	result = bor.HandleMsgCommitSpanSeed(ctx, borTypes.NewMsgCommitSpanSeed(hmTypes.BytesToHeimdallAddress([]byte("unknown")), 3, secrets[0]), keeper)
+	suite.Equal(common.ErrNoValidator("1").Result(), result, "commit from non-validator should fail")
+
+	for i, val := range valSet.Validators {
+		result = bor.HandleMsgCommitSpanSeed(ctx, borTypes.NewMsgCommitSpanSeed(val.Signer, 3, borTypes.SpanSeedCommitment(secrets[i], val.Signer)), keeper)
+		suite.True(result.IsOK(), "commit should succeed")
+	}
+
+	result = bor.HandleMsgCommitSpanSeed(ctx, borTypes.NewMsgCommitSpanSeed(signer, 3, borTypes.SpanSeedCommitment(secrets[0], signer)), keeper)
+	suite.Equal(common.ErrInvalidSeedCommit("1").Result(), result, "duplicate commit should fail")
+
+	// reveals are accepted only once span before is frozen
+	result = bor.HandleMsgRevealSpanSeed(ctx, borTypes.NewMsgRevealSpanSeed(signer, 3, secrets[0]), keeper)
+	suite.Equal(common.ErrInvalidSeedReveal("1").Result(), result, "early reveal should fail")
+
+	suite.Require().NoError(keeper.AddNewSpan(ctx, hmTypes.Span{ID: 2, StartBlock: 256, EndBlock: 511, ChainID: "15001"}))
+
+	// secret not matching commitment
+	result = bor.HandleMsgRevealSpanSeed(ctx, borTypes.NewMsgRevealSpanSeed(signer, 3, secrets[1]), keeper)
+	suite.Equal(common.ErrInvalidSeedReveal("1").Result(), result, "reveal with wrong secret should fail")
+
+	// single reveal is insufficient
+	result = bor.HandleMsgRevealSpanSeed(ctx, borTypes.NewMsgRevealSpanSeed(signer, 3, secrets[0]), keeper)
+	suite.True(result.IsOK(), "reveal should succeed")
+	_, ok := keeper.GetSpanSeedFromReveals(ctx, 3)
+	suite.False(ok, "seed should not be derived with insufficient reveals")
+
+	result = bor.HandleMsgRevealSpanSeed(ctx, borTypes.NewMsgRevealSpanSeed(signer, 3, secrets[0]), keeper)
+	suite.Equal(common.ErrInvalidSeedReveal("1").Result(), result, "duplicate reveal should fail")
+
+	result = bor.HandleMsgRevealSpanSeed(ctx, borTypes.NewMsgRevealSpanSeed(valSet.Validators[1].Signer, 3, secrets[1]), keeper)
+	suite.True(result.IsOK(), "reveal should succeed")
+
+	seed, ok := keeper.GetSpanSeedFromReveals(ctx, 3)
+	suite.True(ok, "seed should be derived from reveals")
+	suite.Equal(borCommon.BytesToHash([]byte{0x03}), seed, "seed should be xor of reveals")
+
+	nonRevealers := keeper.GetSpanSeedNonRevealers(ctx, 3)
+	suite.Equal([]hmTypes.ValidatorID{valSet.Validators[2].ID}, nonRevealers)
+
+	// next span seed is derived from reveals, mainchain block hash is used before the upgrade
+	mockCaller := mocks.IContractCaller{}
+	ethHeader := ethTypes.Header{Number: big.NewInt(1)}
+	mockCaller.On("GetMainChainBlock", big.NewInt(1)).Return(&ethHeader, nil)
+
+	nextSpanSeed, err := keeper.GetNextSpanSeed(ctx, &mockCaller)
+	suite.Require().NoError(err)
+	suite.Equal(seed, nextSpanSeed)
+
+	preUpgradeCtx, _ := ctx.CacheContext()
+	preUpgradeCtx.KVStore(app.GetKey(govTypes.StoreKey)).Delete(govTypes.DoneUpgradeKey(hmTypes.UpgradeV03))
+	nextSpanSeed, err = keeper.GetNextSpanSeed(preUpgradeCtx, &mockCaller)
+	suite.Require().NoError(err)
+	suite.Equal(ethHeader.Hash(), nextSpanSeed)
+
+	// reveal window is open until span is frozen, validators yet to reveal are not excluded from preview
+	producers, err := keeper.SelectNextProducers(ctx, seed)
+	suite.Require().NoError(err)
+	suite.Len(producers, 3)
+
+	// non revealer is excluded once span is frozen, commits are pruned
+	suite.Require().NoError(keeper.FreezeSet(ctx, 3, 512, 767, "15001", seed))
+	suite.Empty(keeper.GetSpanSeedCommits(ctx, 3))
+
+	span, err := keeper.GetSpan(ctx, 3)
+	suite.Require().NoError(err)
+	suite.Len(span.SelectedProducers, 2)
+	for _, producer := range span.SelectedProducers {
+		suite.NotEqual(valSet.Validators[2].ID, producer.ID, "non revealer should not be selected")
+	}
+}
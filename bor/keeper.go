@@ -2,6 +2,7 @@ package bor
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 	"strconv"
 
@@ -28,6 +29,7 @@ var (
 	SpanPrefixKey         = []byte{0x36} // prefix key to store span
 	SpanCacheKey          = []byte{0x37} // key to store Cache for span
 	LastProcessedEthBlock = []byte{0x38} // key to store last processed eth block for seed
+	SpanSeedCommitPrefix  = []byte{0x39} // prefix key to store span seed commits
 )
 
 // Keeper stores all related data
@@ -44,6 +46,8 @@ type Keeper struct {
 	ContractCaller helper.ContractCaller
 	// chain manager keeper
 	chainKeeper chainmanager.Keeper
+	// upgrade keeper
+	upgradeKeeper hmTypes.UpgradeKeeper
 }
 
 // NewKeeper create new keeper
@@ -55,6 +59,7 @@ func NewKeeper(
 	chainKeeper chainmanager.Keeper,
 	stakingKeeper staking.Keeper,
 	caller helper.ContractCaller,
+	upgradeKeeper hmTypes.UpgradeKeeper,
 ) Keeper {
 	// create keeper
 	keeper := Keeper{
@@ -65,6 +70,7 @@ func NewKeeper(
 		chainKeeper:    chainKeeper,
 		sk:             stakingKeeper,
 		ContractCaller: caller,
+		upgradeKeeper:  upgradeKeeper,
 	}
 	return keeper
 }
@@ -198,11 +204,49 @@ func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
 	return k.GetSpan(ctx, lastSpanID)
 }
 
+// GetSpanForBlock returns span covering bor block. Spans cover consecutive bor blocks
+// in order of their ids, so the span is looked up by a binary search over span ids.
+func (k *Keeper) GetSpanForBlock(ctx sdk.Context, blockNumber uint64) (*hmTypes.Span, error) {
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if blockNumber > lastSpan.EndBlock {
+		return nil, fmt.Errorf("span not found for bor block %d", blockNumber)
+	}
+
+	low, high := uint64(0), lastSpan.ID
+	for low <= high {
+		mid := low + (high-low)/2
+		span, err := k.GetSpan(ctx, mid)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case blockNumber < span.StartBlock:
+			if mid == 0 {
+				return nil, fmt.Errorf("span not found for bor block %d", blockNumber)
+			}
+			high = mid - 1
+		case blockNumber > span.EndBlock:
+			low = mid + 1
+		default:
+			return span, nil
+		}
+	}
+
+	return nil, fmt.Errorf("span not found for bor block %d", blockNumber)
+}
+
 // FreezeSet freezes validator set for next span
 func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlock uint64, borChainID string, seed common.Hash) error {
+	// reveal window of span closes as it is frozen, validators who withheld their reveal are not selected
+	spanEligibleVals := k.excludeSpanSeedNonRevealers(ctx, id, k.sk.GetSpanEligibleValidators(ctx))
 
 	// select next producers
-	newProducers, err := k.SelectNextProducers(ctx, seed)
+	newProducers, err := k.selectProducers(ctx, seed, k.GetProducerSelectionAlgorithm(ctx), spanEligibleVals)
 	if err != nil {
 		return err
 	}
@@ -210,6 +254,19 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 	// increment last eth block
 	k.IncrementLastEthBlock(ctx)
 
+	// record validators who committed but didn't reveal and prune commits for span
+	for _, valID := range k.GetSpanSeedNonRevealers(ctx, id) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSpanSeedNoReveal,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(id, 10)),
+				sdk.NewAttribute(types.AttributeKeyValidatorID, valID.String()),
+			),
+		)
+	}
+	k.DeleteSpanSeedCommits(ctx, id)
+
 	// generate new span
 	newSpan := hmTypes.NewSpan(
 		id,
@@ -223,22 +280,45 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 	return k.AddNewSpan(ctx, newSpan)
 }
 
-// SelectNextProducers selects producers for next span
+// SelectNextProducers selects producers for next span.
+// Validators who have not revealed their span seed secret yet are not excluded, FreezeSet
+// excludes those who withheld it once the reveal window of the span has closed.
 func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []hmTypes.Validator, err error) {
+	return k.SelectNextProducersWithAlgorithm(ctx, seed, k.GetProducerSelectionAlgorithm(ctx))
+}
+
+// GetProducerSelectionAlgorithm returns the algorithm used to select producers for next span.
+// Producers are selected by weighted random before v0.3 upgrade.
+func (k *Keeper) GetProducerSelectionAlgorithm(ctx sdk.Context) string {
+	if !k.upgradeKeeper.IsUpgradeDone(ctx, hmTypes.UpgradeV03) {
+		return types.ProducerSelectionWeightedRandom
+	}
+
+	return k.GetParams(ctx).ProducerSelectionAlgorithm
+}
+
+// SelectNextProducersWithAlgorithm selects producers for next span using given producer selection algorithm
+func (k *Keeper) SelectNextProducersWithAlgorithm(ctx sdk.Context, seed common.Hash, algorithm string) (vals []hmTypes.Validator, err error) {
 	// spanEligibleVals are current validators who are not getting deactivated in between next span
-	spanEligibleVals := k.sk.GetSpanEligibleValidators(ctx)
-	producerCount := k.GetParams(ctx).ProducerCount
-	if err != nil {
-		return vals, err
+	return k.selectProducers(ctx, seed, algorithm, k.sk.GetSpanEligibleValidators(ctx))
+}
+
+// selectProducers selects producers among span eligible validators using given producer selection algorithm
+func (k *Keeper) selectProducers(ctx sdk.Context, seed common.Hash, algorithm string, spanEligibleVals []hmTypes.Validator) (vals []hmTypes.Validator, err error) {
+	if !types.IsValidProducerSelectionAlgorithm(algorithm) {
+		return vals, fmt.Errorf("unknown producer selection algorithm: %s", algorithm)
 	}
 
+	params := k.GetParams(ctx)
+	producerCount := params.ProducerCount
+
 	// if producers to be selected is more than current validators no need to select/shuffle
 	if len(spanEligibleVals) <= int(producerCount) {
 		return spanEligibleVals, nil
 	}
 
 	// select next producers using seed as blockheader hash
-	newProducersIds, err := SelectNextProducers(seed, spanEligibleVals, producerCount)
+	newProducersIds, err := SelectNextProducersWithAlgorithm(algorithm, seed, spanEligibleVals, producerCount, params.MaxProducerSlots)
 	if err != nil {
 		return vals, err
 	}
@@ -291,7 +371,21 @@ func (k *Keeper) GetLastEthBlock(ctx sdk.Context) *big.Int {
 	return lastEthBlock
 }
 
+// GetNextSpanSeed returns seed for next span. Seed is derived from validators' revealed secrets once
+// v0.3 upgrade is done, mainchain block hash is used before it and as fallback if reveals are insufficient.
+//
+// NOTE: the seed can still be biased by the last validator to reveal. It sees the secrets revealed
+// before its own and may withhold its reveal to pick between two seeds. Withholding costs the
+// validator its producer slots for the span and emits a span-seed-no-reveal event, it is not slashed.
 func (k Keeper) GetNextSpanSeed(ctx sdk.Context, contractCaller helper.IContractCaller) (common.Hash, error) {
+	if k.upgradeKeeper.IsUpgradeDone(ctx, hmTypes.UpgradeV03) {
+		if lastSpan, err := k.GetLastSpan(ctx); err == nil && lastSpan != nil {
+			if seed, ok := k.GetSpanSeedFromReveals(ctx, lastSpan.ID+1); ok {
+				return seed, nil
+			}
+		}
+	}
+
 	lastEthBlock := k.GetLastEthBlock(ctx)
 
 	// increment last processed header block number
@@ -309,6 +403,137 @@ func (k Keeper) GetNextSpanSeed(ctx sdk.Context, contractCaller helper.IContract
 	return blockHeader.Hash(), nil
 }
 
+// -----------------------------------------------------------------------------
+// Span seed commit-reveal
+
+// GetSpanSeedCommitKey returns key for span seed commit of validator
+func GetSpanSeedCommitKey(spanID uint64, valID hmTypes.ValidatorID) []byte {
+	return append(GetSpanSeedCommitsKey(spanID), sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetSpanSeedCommitsKey returns prefix key for all span seed commits of span
+func GetSpanSeedCommitsKey(spanID uint64) []byte {
+	return append(SpanSeedCommitPrefix, sdk.Uint64ToBigEndian(spanID)...)
+}
+
+// SetSpanSeedCommit stores span seed commit
+func (k *Keeper) SetSpanSeedCommit(ctx sdk.Context, commit types.SpanSeedCommit) error {
+	store := ctx.KVStore(k.storeKey)
+	out, err := k.cdc.MarshalBinaryBare(commit)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling span seed commit", "error", err)
+		return err
+	}
+
+	store.Set(GetSpanSeedCommitKey(commit.SpanID, commit.ValidatorID), out)
+	return nil
+}
+
+// GetSpanSeedCommit returns span seed commit of validator for span
+func (k *Keeper) GetSpanSeedCommit(ctx sdk.Context, spanID uint64, valID hmTypes.ValidatorID) (commit types.SpanSeedCommit, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetSpanSeedCommitKey(spanID, valID)
+	if !store.Has(key) {
+		return commit, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &commit); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling span seed commit", "error", err)
+		return commit, false
+	}
+
+	return commit, true
+}
+
+// GetSpanSeedCommits returns all span seed commits for span
+func (k *Keeper) GetSpanSeedCommits(ctx sdk.Context, spanID uint64) (commits []types.SpanSeedCommit) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, GetSpanSeedCommitsKey(spanID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var commit types.SpanSeedCommit
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &commit); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling span seed commit", "error", err)
+			continue
+		}
+		commits = append(commits, commit)
+	}
+
+	return commits
+}
+
+// DeleteSpanSeedCommits deletes all span seed commits for span
+func (k *Keeper) DeleteSpanSeedCommits(ctx sdk.Context, spanID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, commit := range k.GetSpanSeedCommits(ctx, spanID) {
+		store.Delete(GetSpanSeedCommitKey(commit.SpanID, commit.ValidatorID))
+	}
+}
+
+// GetSpanSeedFromReveals derives span seed from revealed secrets, returns false if reveals are insufficient
+func (k *Keeper) GetSpanSeedFromReveals(ctx sdk.Context, spanID uint64) (common.Hash, bool) {
+	minReveals := k.GetParams(ctx).MinSeedReveals
+	if minReveals == 0 {
+		return common.Hash{}, false
+	}
+
+	commits := k.GetSpanSeedCommits(ctx, spanID)
+
+	var reveals uint64
+	for _, commit := range commits {
+		if commit.Revealed {
+			reveals++
+		}
+	}
+
+	if reveals < minReveals {
+		return common.Hash{}, false
+	}
+
+	return types.XORSpanSeedSecrets(commits), true
+}
+
+// GetSpanSeedNonRevealers returns validators who committed secret for span but didn't reveal it
+func (k *Keeper) GetSpanSeedNonRevealers(ctx sdk.Context, spanID uint64) (valIDs []hmTypes.ValidatorID) {
+	for _, commit := range k.GetSpanSeedCommits(ctx, spanID) {
+		if !commit.Revealed {
+			valIDs = append(valIDs, commit.ValidatorID)
+		}
+	}
+
+	return valIDs
+}
+
+// excludeSpanSeedNonRevealers removes validators who withheld their reveal for span from producer selection,
+// must be called only once reveal window of span has closed
+func (k *Keeper) excludeSpanSeedNonRevealers(ctx sdk.Context, spanID uint64, validators []hmTypes.Validator) []hmTypes.Validator {
+	nonRevealers := k.GetSpanSeedNonRevealers(ctx, spanID)
+	if len(nonRevealers) == 0 {
+		return validators
+	}
+
+	excluded := make(map[hmTypes.ValidatorID]bool)
+	for _, valID := range nonRevealers {
+		excluded[valID] = true
+	}
+
+	var result []hmTypes.Validator
+	for _, val := range validators {
+		if !excluded[val.ID] {
+			result = append(result, val)
+		}
+	}
+
+	// keep all validators if no one is left to produce blocks
+	if len(result) == 0 {
+		return validators
+	}
+
+	return result
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
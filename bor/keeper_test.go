@@ -11,6 +11,7 @@ import (
 	"github.com/maticnetwork/heimdall/bor"
 	bortypes "github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/checkpoint/simulation"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -155,7 +156,7 @@ func (suite *keeperTest) TestBorKeeperSelectNextProducers() {
 		c.out = simulation.LoadValidatorSet(c.valCount, suite.T(), suite.app.StakingKeeper, suite.ctx, true, 0) // load a random number of validators
 		// cVals is used to check if validators are being modified during execution
 		cVals := suite.app.StakingKeeper.GetValidatorSet(suite.ctx)
-		suite.app.BorKeeper.SetParams(suite.ctx, bortypes.Params{SprintDuration: 1, SpanDuration: 1, ProducerCount: c.producerCount})
+		suite.app.BorKeeper.SetParams(suite.ctx, bortypes.NewParams(1, 1, c.producerCount, bortypes.DefaultProducerSelectionAlgorithm, bortypes.DefaultMaxProducerSlots, bortypes.DefaultMinSeedReveals))
 		cMsg := fmt.Sprintf("i: %v, msg: %v", i, c.msg)
 		out, err := suite.app.BorKeeper.SelectNextProducers(suite.ctx, c.seed)
 
@@ -177,6 +178,17 @@ func (suite *keeperTest) TestBorKeeperSelectNextProducers() {
 	}
 }
 
+func (suite *keeperTest) TestGetProducerSelectionAlgorithm() {
+	params := bortypes.DefaultParams()
+	params.ProducerSelectionAlgorithm = bortypes.ProducerSelectionTopStake
+	suite.app.BorKeeper.SetParams(suite.ctx, params)
+	suite.Equal(bortypes.ProducerSelectionTopStake, suite.app.BorKeeper.GetProducerSelectionAlgorithm(suite.ctx))
+
+	// producers are selected by weighted random before the upgrade
+	suite.ctx.KVStore(suite.app.GetKey(govTypes.StoreKey)).Delete(govTypes.DoneUpgradeKey(hmTypes.UpgradeV03))
+	suite.Equal(bortypes.ProducerSelectionWeightedRandom, suite.app.BorKeeper.GetProducerSelectionAlgorithm(suite.ctx))
+}
+
 func (suite *keeperTest) TestGetAllSpans() {
 	tc := []struct {
 		span *hmTypes.Span
@@ -196,6 +208,37 @@ func (suite *keeperTest) TestGetAllSpans() {
 	}
 }
 
+func (suite *keeperTest) TestGetSpanForBlock() {
+	for id := uint64(0); id < 10; id++ {
+		span := hmTypes.Span{ID: id, StartBlock: id * 100, EndBlock: id*100 + 99}
+		suite.Nil(suite.app.BorKeeper.AddNewSpan(suite.ctx, span))
+	}
+
+	tc := []struct {
+		blockNumber uint64
+		spanID      uint64
+		found       bool
+	}{
+		{blockNumber: 0, spanID: 0, found: true},
+		{blockNumber: 99, spanID: 0, found: true},
+		{blockNumber: 100, spanID: 1, found: true},
+		{blockNumber: 555, spanID: 5, found: true},
+		{blockNumber: 999, spanID: 9, found: true},
+		{blockNumber: 1000, found: false},
+	}
+	for i, c := range tc {
+		msg := fmt.Sprintf("i: %v, blockNumber: %v", i, c.blockNumber)
+		span, err := suite.app.BorKeeper.GetSpanForBlock(suite.ctx, c.blockNumber)
+		if !c.found {
+			suite.Error(err, msg)
+			continue
+		}
+
+		suite.Nil(err, msg)
+		suite.Equal(c.spanID, span.ID, msg)
+	}
+}
+
 func (suite *keeperTest) TestGetLastEthBlock() {
 	tc := []struct {
 		msg                string
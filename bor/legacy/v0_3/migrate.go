@@ -0,0 +1,44 @@
+package v0_3
+
+import (
+	"encoding/json"
+
+	"github.com/maticnetwork/heimdall/migrate"
+)
+
+// Values of the producer selection params added in v0.3. Weighted random
+// selection is how producers were selected before.
+const (
+	ProducerSelectionAlgorithm        = "weighted-random"
+	MaxProducerSlots           uint64 = 1
+	MinSeedReveals             uint64 = 2
+)
+
+// Migrate adds the producer selection and span seed params
+func Migrate(oldGenState json.RawMessage) (json.RawMessage, error) {
+	genState, err := migrate.DecodeObject(oldGenState)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := genState.Object("params")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := params.SetDefault("producer_selection_algorithm", ProducerSelectionAlgorithm); err != nil {
+		return nil, err
+	}
+	if err := params.SetDefault("max_producer_slots", MaxProducerSlots); err != nil {
+		return nil, err
+	}
+	if err := params.SetDefault("min_seed_reveals", MinSeedReveals); err != nil {
+		return nil, err
+	}
+
+	if err := genState.Set("params", params); err != nil {
+		return nil, err
+	}
+
+	return genState.Marshal()
+}
@@ -179,7 +179,7 @@ func (AppModule) RegisterStoreDecoder(sdr hmModule.StoreDecoderRegistry) {
 	return
 }
 
-// WeightedOperations doesn't return any chainmanager module operation.
-func (AppModule) WeightedOperations(_ hmModule.SimulationState) []simTypes.WeightedOperation {
-	return nil
+// WeightedOperations returns all the bor module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState hmModule.SimulationState) []simTypes.WeightedOperation {
+	return WeightedOperations(simState.AppParams, simState.Cdc, am.keeper, simState.SideChannel)
 }
@@ -0,0 +1,100 @@
+package bor
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/simulation"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgProposeSpan = "op_weight_msg_propose_span"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simTypes.AppParams, cdc *codec.Codec, k Keeper, sideChannel *simTypes.SideChannel) simulation.WeightedOperations {
+	var weightMsgProposeSpan int
+	appParams.GetOrGenerate(cdc, OpWeightMsgProposeSpan, &weightMsgProposeSpan, nil,
+		func(_ *rand.Rand) {
+			weightMsgProposeSpan = 20
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgProposeSpan, SimulateMsgProposeSpan(k, sideChannel)),
+	}
+}
+
+// SimulateMsgProposeSpan generates a MsgProposeSpan following the last span,
+// occasionally with an invalid chain id, range, seed or while the last span is
+// not yet in progress on bor.
+func SimulateMsgProposeSpan(k Keeper, sideChannel *simTypes.SideChannel) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+
+		lastSpan, err := k.GetLastSpan(ctx)
+		if err != nil || lastSpan == nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		proposer, _ := simTypes.RandomAcc(r, accs)
+		borChainID := k.chainKeeper.GetParams(ctx).ChainParams.BorChainID
+		spanDuration := k.GetParams(ctx).SpanDuration
+
+		// main chain block used as seed and current bor block
+		mainChainBlock := &ethTypes.Header{
+			Number: new(big.Int).Add(k.GetLastEthBlock(ctx), big.NewInt(1)),
+			Extra:  simTypes.RandBytes(r, 32),
+		}
+		childBlock := &ethTypes.Header{
+			Number: new(big.Int).SetUint64(lastSpan.StartBlock + uint64(r.Int63n(int64(lastSpan.EndBlock-lastSpan.StartBlock+1)))),
+		}
+
+		contractCaller := &mocks.IContractCaller{}
+		contractCaller.On("GetMainChainBlock", mock.Anything).Return(mainChainBlock, nil)
+		contractCaller.On("GetMaticChainBlock", mock.Anything).Return(childBlock, nil)
+
+		seed, err := k.GetNextSpanSeed(ctx, contractCaller)
+		if err != nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgProposeSpan(
+			lastSpan.ID+1,
+			proposer.Address,
+			lastSpan.EndBlock+1,
+			lastSpan.EndBlock+spanDuration,
+			borChainID,
+			seed,
+		)
+
+		switch r.Intn(10) {
+		case 0:
+			msg.ChainID = simTypes.RandStringOfLength(r, 5)
+		case 1:
+			msg.EndBlock++
+		case 2:
+			msg.Seed = common.BytesToHash(simTypes.RandBytes(r, 32))
+		case 3:
+			childBlock.Number = new(big.Int).SetUint64(lastSpan.EndBlock + 1)
+		}
+
+		opMsg := simulation.DeliverSideTx(r, sideChannel, ctx, msg,
+			NewHandler(k),
+			NewSideTxHandler(k, contractCaller),
+		)
+
+		return opMsg, nil, nil
+	}
+}
@@ -34,6 +34,8 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryNextProducers(ctx, req, keeper, contractCaller)
 		case types.QueryNextSpanSeed:
 			return handlerQueryNextSpanSeed(ctx, req, keeper, contractCaller)
+		case types.QueryPreviewProducers:
+			return handleQueryPreviewProducers(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -171,6 +173,29 @@ func handleQueryNextProducers(ctx sdk.Context, req abci.RequestQuery, keeper Kee
 	return bz, nil
 }
 
+func handleQueryPreviewProducers(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPreviewProducersParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	algorithm := params.Algorithm
+	if algorithm == "" {
+		algorithm = keeper.GetProducerSelectionAlgorithm(ctx)
+	}
+
+	producers, err := keeper.SelectNextProducersWithAlgorithm(ctx, params.Seed.EthHash(), algorithm)
+	if err != nil {
+		return nil, sdk.ErrInternal((sdk.AppendMsgToErr("cannot preview producers", err.Error())))
+	}
+
+	bz, err := json.Marshal(producers)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handlerQueryNextSpanSeed(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
 	nextSpanSeed, err := keeper.GetNextSpanSeed(ctx, contractCaller)
 
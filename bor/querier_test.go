@@ -53,6 +53,12 @@ func (suite *querierHandlerSuite) TestNewQueirer() {
 	querySpanParams, err := suite.app.Codec().MarshalJSON(hmTypes.QueryPaginationParams{Page: 1, Limit: 1})
 	suite.Nil(err)
 
+	previewProducersParams, err := suite.app.Codec().MarshalJSON(types.NewQueryPreviewProducersParams(hmTypes.BytesToHeimdallHash(ethHeader.Hash().Bytes()), types.ProducerSelectionTopStake))
+	suite.Nil(err)
+
+	invalidPreviewProducersParams, err := suite.app.Codec().MarshalJSON(types.NewQueryPreviewProducersParams(hmTypes.BytesToHeimdallHash(ethHeader.Hash().Bytes()), "unknown"))
+	suite.Nil(err)
+
 	tc := []struct {
 		span                 *hmTypes.Span
 		path                 []string
@@ -70,7 +76,7 @@ func (suite *querierHandlerSuite) TestNewQueirer() {
 		},
 		{
 
-			expResp: []byte(`{"sprint_duration":64,"span_duration":6400,"producer_count":4}`),
+			expResp: []byte(`{"sprint_duration":64,"span_duration":6400,"producer_count":4,"producer_selection_algorithm":"weighted-random","max_producer_slots":1,"min_seed_reveals":2}`),
 			path:    []string{types.QueryParams},
 			msg:     "happy flow for empty path query",
 		},
@@ -161,6 +167,18 @@ func (suite *querierHandlerSuite) TestNewQueirer() {
 			expResp: []byte(`"0x8f5bab218b6bb34476f51ca588e9f4553a3a7ce5e13a66c660a5283e97e9a85a"`),
 			msg:     "happy flow: query Next span seed",
 		},
+		{
+			path:    []string{types.QueryPreviewProducers},
+			req:     abci.RequestQuery{Data: previewProducersParams},
+			expResp: []byte(`null`),
+			msg:     "happy flow: preview producers without validators",
+		},
+		{
+			path:   []string{types.QueryPreviewProducers},
+			req:    abci.RequestQuery{Data: invalidPreviewProducersParams},
+			expErr: sdk.ErrInternal(sdk.AppendMsgToErr("cannot preview producers", "unknown producer selection algorithm: unknown")),
+			msg:    "error: preview producers with unknown algorithm",
+		},
 		{
 			path:   []string{types.QueryNextSpanSeed},
 			expErr: sdk.ErrInternal(sdk.AppendMsgToErr("Error fetching next span seed", "error")),
@@ -2,10 +2,12 @@ package bor
 
 import (
 	"encoding/binary"
+	"fmt"
 	"math"
 	"math/rand"
 
 	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -45,6 +47,33 @@ func randomRangeInclusive(min uint64, max uint64) uint64 {
 	return min + randomValue%rangeLength
 }
 
+// seedRandom extracts seed from hash and seeds pseudo random generator with it
+func seedRandom(blkHash common.Hash) {
+	seedBytes := helper.ToBytes32(blkHash.Bytes()[:32])
+	seed := int64(binary.BigEndian.Uint64(seedBytes[:]))
+	rand.Seed(seed)
+}
+
+// SelectNextProducersWithAlgorithm selects producers for next span using given producer selection algorithm
+func SelectNextProducersWithAlgorithm(
+	algorithm string,
+	blkHash common.Hash,
+	spanEligibleValidators []hmTypes.Validator,
+	producerCount uint64,
+	maxProducerSlots uint64,
+) ([]uint64, error) {
+	switch algorithm {
+	case types.ProducerSelectionWeightedRandom:
+		return SelectNextProducers(blkHash, spanEligibleValidators, producerCount)
+	case types.ProducerSelectionTopStake:
+		return SelectTopStakeProducers(spanEligibleValidators, producerCount)
+	case types.ProducerSelectionCappedWeightedRandom:
+		return SelectCappedNextProducers(blkHash, spanEligibleValidators, producerCount, maxProducerSlots)
+	default:
+		return nil, fmt.Errorf("unknown producer selection algorithm: %s", algorithm)
+	}
+}
+
 // SelectNextProducers selects producers for next span by converting power to tickets
 func SelectNextProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
 	selectedProducers := make([]uint64, 0)
@@ -57,10 +86,8 @@ func SelectNextProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.V
 		return selectedProducers, nil
 	}
 
-	// extract seed from hash
-	seedBytes := helper.ToBytes32(blkHash.Bytes()[:32])
-	seed := int64(binary.BigEndian.Uint64(seedBytes[:]))
-	rand.Seed(seed)
+	// seed random from hash
+	seedRandom(blkHash)
 
 	// weighted range from validators' voting power
 	votingPower := make([]uint64, len(spanEligibleValidators))
@@ -85,6 +112,74 @@ func SelectNextProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.V
 	return selectedProducers[:producerCount], nil
 }
 
+// SelectTopStakeProducers deterministically selects validators with highest stake as producers for next span,
+// validators with equal stake are selected by lower ID
+func SelectTopStakeProducers(spanEligibleValidators []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
+	selectedProducers := make([]uint64, 0)
+
+	// sort copy of validators by power
+	validators := make([]*hmTypes.Validator, len(spanEligibleValidators))
+	for idx := range spanEligibleValidators {
+		validators[idx] = &spanEligibleValidators[idx]
+	}
+	hmTypes.SortValidatorByPower(validators)
+
+	for _, validator := range validators {
+		if uint64(len(selectedProducers)) == producerCount {
+			break
+		}
+		selectedProducers = append(selectedProducers, validator.ID.Uint64())
+	}
+
+	return selectedProducers, nil
+}
+
+// SelectCappedNextProducers selects producers for next span by converting power to tickets,
+// a validator stops getting tickets once it holds maxProducerSlots producer slots
+func SelectCappedNextProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.Validator, producerCount uint64, maxProducerSlots uint64) ([]uint64, error) {
+	selectedProducers := make([]uint64, 0)
+
+	if len(spanEligibleValidators) <= int(producerCount) {
+		for _, validator := range spanEligibleValidators {
+			selectedProducers = append(selectedProducers, uint64(validator.ID))
+		}
+
+		return selectedProducers, nil
+	}
+
+	if maxProducerSlots == 0 {
+		return nil, fmt.Errorf("invalid max producer slots: %d", maxProducerSlots)
+	}
+
+	// seed random from hash
+	seedRandom(blkHash)
+
+	// weighted range from validators' voting power
+	votingPower := make([]uint64, len(spanEligibleValidators))
+	for idx, validator := range spanEligibleValidators {
+		votingPower[idx] = uint64(validator.VotingPower)
+	}
+
+	slots := make([]uint64, len(spanEligibleValidators))
+	weightedRanges, totalVotingPower := createWeightedRanges(votingPower)
+
+	// select producers, with replacement till validator reaches max slots
+	for uint64(len(selectedProducers)) < producerCount && totalVotingPower > 0 {
+		targetWeight := randomRangeInclusive(1, totalVotingPower)
+		index := binarySearch(weightedRanges, targetWeight)
+		selectedProducers = append(selectedProducers, spanEligibleValidators[index].ID.Uint64())
+
+		// remove validator from next draws once it is capped
+		slots[index]++
+		if slots[index] >= maxProducerSlots {
+			votingPower[index] = 0
+			weightedRanges, totalVotingPower = createWeightedRanges(votingPower)
+		}
+	}
+
+	return selectedProducers, nil
+}
+
 // createWeightedRanges converts array [1, 2, 3] into cumulative form [1, 3, 6]
 func createWeightedRanges(weights []uint64) ([]uint64, uint64) {
 	weightedRanges := make([]uint64, len(weights))
@@ -11,6 +11,7 @@ import (
 
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/crypto"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
@@ -101,6 +102,75 @@ func TestSelectNextProducers(t *testing.T) {
 	}
 }
 
+func TestNewSprintSchedules(t *testing.T) {
+	var validators []hmTypes.Validator
+	require.NoError(t, json.Unmarshal([]byte(testValidators), &validators))
+
+	// producer with twice the slots produces twice as many sprints
+	producers := []hmTypes.Validator{validators[0], validators[1]}
+	producers[0].VotingPower = 2
+	producers[1].VotingPower = 1
+
+	sprints := borTypes.NewSprintSchedules(producers, 256, 256+64*6-1, 64)
+	require.Len(t, sprints, 6)
+	require.Equal(t, uint64(256), sprints[0].StartBlock)
+	require.Equal(t, uint64(256+64*6-1), sprints[5].EndBlock)
+
+	count := make(map[hmTypes.ValidatorID]int)
+	for i, sprint := range sprints {
+		require.Equal(t, uint64(256+64*i), sprint.StartBlock)
+		count[sprint.ProducerID]++
+	}
+	require.Equal(t, 4, count[producers[0].ID])
+	require.Equal(t, 2, count[producers[1].ID])
+
+	// last sprint is truncated at span end
+	sprints = borTypes.NewSprintSchedules(producers, 0, 99, 64)
+	require.Len(t, sprints, 2)
+	require.Equal(t, uint64(99), sprints[1].EndBlock)
+
+	require.Empty(t, borTypes.NewSprintSchedules(nil, 0, 99, 64))
+}
+
+func TestNewSpanSchedules(t *testing.T) {
+	var validators []hmTypes.Validator
+	require.NoError(t, json.Unmarshal([]byte(testValidators), &validators))
+
+	params := borTypes.DefaultParams()
+	params.SpanDuration = 100
+	params.SprintDuration = 50
+	lastSpan := hmTypes.Span{ID: 5, StartBlock: 401, EndBlock: 500, SelectedProducers: validators[:1]}
+	seed := common.HexToHash("0x1")
+
+	schedules := borTypes.NewSpanSchedules(lastSpan, seed, validators[1:3], 3, params)
+	require.Len(t, schedules, 4)
+
+	// latest span is shown from its selected producers
+	require.Equal(t, borTypes.SpanScheduleCurrent, schedules[0].Status)
+	require.Nil(t, schedules[0].Seed)
+	require.Equal(t, validators[:1], schedules[0].Producers)
+	require.Len(t, schedules[0].Sprints, 2)
+
+	// next span is previewed from known seed
+	require.Equal(t, borTypes.SpanScheduleNext, schedules[1].Status)
+	require.Equal(t, seed, *schedules[1].Seed)
+	require.Equal(t, uint64(6), schedules[1].ID)
+	require.Equal(t, uint64(501), schedules[1].StartBlock)
+	require.Equal(t, uint64(600), schedules[1].EndBlock)
+	require.Equal(t, validators[1:3], schedules[1].Producers)
+	require.Len(t, schedules[1].Sprints, 2)
+
+	// later spans are listed with unknown seed
+	for i, schedule := range schedules[2:] {
+		require.Equal(t, borTypes.SpanScheduleSeedUnknown, schedule.Status)
+		require.Equal(t, uint64(7+i), schedule.ID)
+		require.Equal(t, uint64(601+100*i), schedule.StartBlock)
+		require.Nil(t, schedule.Seed)
+		require.Empty(t, schedule.Producers)
+		require.Empty(t, schedule.Sprints)
+	}
+}
+
 func getSelectedValidatorsFromIDs(validators []hmTypes.Validator, producerIds []uint64) ([]hmTypes.Validator, int64) {
 	var vals []hmTypes.Validator
 	IDToPower := make(map[uint64]uint64)
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/maticnetwork/bor/common"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/types"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
@@ -64,6 +65,67 @@ func TestValShuffle(t *testing.T) {
 	require.Empty(t, err, "Error has to be nil")
 }
 
+func TestTopStakeValShuffle(t *testing.T) {
+	initialVals := GenRandomVal(10, 0, 100, uint64(10), false, 1)
+	for i := range initialVals {
+		initialVals[i].VotingPower = int64(10 * (i + 1))
+	}
+
+	selectedProducerIndices, err := SelectTopStakeProducers(initialVals, 4)
+	require.Empty(t, err, "Error has to be nil")
+	require.Equal(t, []uint64{10, 9, 8, 7}, selectedProducerIndices, "Validators with highest stake should be selected")
+
+	// equal stake is broken by lower ID
+	initialVals[0].VotingPower = 100
+	selectedProducerIndices, err = SelectTopStakeProducers(initialVals, 2)
+	require.Empty(t, err, "Error has to be nil")
+	require.Equal(t, []uint64{1, 10}, selectedProducerIndices, "Lower ID should be selected for equal stake")
+}
+
+func TestCappedValShuffle(t *testing.T) {
+	seedHash1 := common.HexToHash("0xc46afc66ad9f4b237414c23a0cf0c469aeb60f52176565990644a9ee36a17667")
+	initialVals := GenRandomVal(50, 0, 100, uint64(10), true, 1)
+	initialVals[0].VotingPower = 100000
+
+	selectedProducerIndices, err := SelectCappedNextProducers(seedHash1, initialVals, 40, 2)
+	require.Empty(t, err, "Error has to be nil")
+	require.Len(t, selectedProducerIndices, 40)
+
+	IDToPower := make(map[uint64]int64)
+	for _, ID := range selectedProducerIndices {
+		IDToPower[ID] = IDToPower[ID] + 1
+	}
+
+	for ID, power := range IDToPower {
+		require.LessOrEqual(t, power, int64(2), "Validator %v exceeds max producer slots", ID)
+	}
+	require.Equal(t, int64(2), IDToPower[initialVals[0].ID.Uint64()], "Validator with high stake should be capped")
+
+	// same seed selects same producers
+	reselectedProducerIndices, err := SelectCappedNextProducers(seedHash1, initialVals, 40, 2)
+	require.Empty(t, err, "Error has to be nil")
+	require.Equal(t, selectedProducerIndices, reselectedProducerIndices)
+
+	// not enough slots to select all producers
+	selectedProducerIndices, err = SelectCappedNextProducers(seedHash1, initialVals[:10], 40, 1)
+	require.Empty(t, err, "Error has to be nil")
+	require.Len(t, selectedProducerIndices, 10)
+}
+
+func TestSelectNextProducersWithAlgorithm(t *testing.T) {
+	seedHash1 := common.HexToHash("0xc46afc66ad9f4b237414c23a0cf0c469aeb60f52176565990644a9ee36a17667")
+	initialVals := GenRandomVal(50, 0, 100, uint64(10), true, 1)
+
+	for _, algorithm := range borTypes.ProducerSelectionAlgorithms {
+		selectedProducerIndices, err := SelectNextProducersWithAlgorithm(algorithm, seedHash1, initialVals, 10, 1)
+		require.Empty(t, err, "Error has to be nil for %v", algorithm)
+		require.Len(t, selectedProducerIndices, 10, "Invalid producer count for %v", algorithm)
+	}
+
+	_, err := SelectNextProducersWithAlgorithm("unknown", seedHash1, initialVals, 10, 1)
+	require.Error(t, err, "Unknown algorithm should fail")
+}
+
 // Generate random validators
 func GenRandomVal(count int, startBlock uint64, power int64, timeAlive uint64, randomise bool, startID uint64) (validators []types.Validator) {
 	for i := 0; i < count; i++ {
@@ -1,9 +1,6 @@
 package simulation
 
 import (
-	"math/rand"
-	"time"
-
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/module"
@@ -12,8 +9,7 @@ import (
 
 // RandomizedGenState return dummy genesis
 func RandomizedGenState(simState *module.SimulationState) {
-	s1 := rand.NewSource(time.Now().UnixNano())
-	r1 := rand.New(s1)
+	r1 := simState.Rand
 	n := 5
 	params := types.DefaultParams()
 	spans := []*hmTypes.Span{}
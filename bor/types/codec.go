@@ -6,6 +6,8 @@ import (
 
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgProposeSpan{}, "bor/MsgProposeSpan", nil)
+	cdc.RegisterConcrete(MsgCommitSpanSeed{}, "bor/MsgCommitSpanSeed", nil)
+	cdc.RegisterConcrete(MsgRevealSpanSeed{}, "bor/MsgRevealSpanSeed", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
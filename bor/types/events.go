@@ -2,12 +2,17 @@ package types
 
 // staking module event types
 const (
-	EventTypeProposeSpan = "propose-span"
+	EventTypeProposeSpan      = "propose-span"
+	EventTypeCommitSpanSeed   = "commit-span-seed"
+	EventTypeRevealSpanSeed   = "reveal-span-seed"
+	EventTypeSpanSeedNoReveal = "span-seed-no-reveal"
 
 	AttributeKeySuccess        = "success"
 	AttributeKeySpanID         = "span-id"
 	AttributeKeySpanStartBlock = "start-block"
 	AttributeKeySpanEndBlock   = "end-block"
+	AttributeKeyValidatorID    = "validator-id"
+	AttributeKeyCommitment     = "commitment"
 
 	AttributeValueCategory = ModuleName
 )
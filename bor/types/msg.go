@@ -78,3 +78,133 @@ func (msg MsgProposeSpan) ValidateBasic() sdk.Error {
 func (msg MsgProposeSpan) GetSideSignBytes() []byte {
 	return nil
 }
+
+//
+// Commit span seed Msg
+//
+
+var _ sdk.Msg = &MsgCommitSpanSeed{}
+var _ hmTypes.UpgradeMsg = &MsgCommitSpanSeed{}
+
+// MsgCommitSpanSeed commits hashed secret for span seed
+type MsgCommitSpanSeed struct {
+	From       hmTypes.HeimdallAddress `json:"from"`
+	SpanID     uint64                  `json:"span_id"`
+	Commitment hmTypes.HeimdallHash    `json:"commitment"`
+}
+
+// NewMsgCommitSpanSeed creates new commit span seed message
+func NewMsgCommitSpanSeed(from hmTypes.HeimdallAddress, spanID uint64, commitment hmTypes.HeimdallHash) MsgCommitSpanSeed {
+	return MsgCommitSpanSeed{
+		From:       from,
+		SpanID:     spanID,
+		Commitment: commitment,
+	}
+}
+
+// Type returns message type
+func (msg MsgCommitSpanSeed) Type() string {
+	return "commit-span-seed"
+}
+
+// Route returns route for message
+func (msg MsgCommitSpanSeed) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgCommitSpanSeed) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes for commit span seed message type
+func (msg MsgCommitSpanSeed) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic validates the message and returns error
+func (msg MsgCommitSpanSeed) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+
+	if msg.Commitment.Empty() {
+		return sdk.ErrUnknownRequest("Commitment cannot be empty")
+	}
+
+	return nil
+}
+
+// RequiredUpgrade returns the software upgrade adding span seed commits
+func (msg MsgCommitSpanSeed) RequiredUpgrade() string {
+	return hmTypes.UpgradeV03
+}
+
+//
+// Reveal span seed Msg
+//
+
+var _ sdk.Msg = &MsgRevealSpanSeed{}
+var _ hmTypes.UpgradeMsg = &MsgRevealSpanSeed{}
+
+// MsgRevealSpanSeed reveals secret committed for span seed
+type MsgRevealSpanSeed struct {
+	From   hmTypes.HeimdallAddress `json:"from"`
+	SpanID uint64                  `json:"span_id"`
+	Secret hmTypes.HeimdallHash    `json:"secret"`
+}
+
+// NewMsgRevealSpanSeed creates new reveal span seed message
+func NewMsgRevealSpanSeed(from hmTypes.HeimdallAddress, spanID uint64, secret hmTypes.HeimdallHash) MsgRevealSpanSeed {
+	return MsgRevealSpanSeed{
+		From:   from,
+		SpanID: spanID,
+		Secret: secret,
+	}
+}
+
+// Type returns message type
+func (msg MsgRevealSpanSeed) Type() string {
+	return "reveal-span-seed"
+}
+
+// Route returns route for message
+func (msg MsgRevealSpanSeed) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgRevealSpanSeed) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes for reveal span seed message type
+func (msg MsgRevealSpanSeed) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic validates the message and returns error
+func (msg MsgRevealSpanSeed) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+
+	if msg.Secret.Empty() {
+		return sdk.ErrUnknownRequest("Secret cannot be empty")
+	}
+
+	return nil
+}
+
+// RequiredUpgrade returns the software upgrade adding span seed reveals
+func (msg MsgRevealSpanSeed) RequiredUpgrade() string {
+	return hmTypes.UpgradeV03
+}
@@ -19,13 +19,38 @@ const (
 	DefaultSpanDuration      uint64 = 100 * DefaultSprintDuration
 	DefaultFirstSpanDuration uint64 = 256
 	DefaultProducerCount     uint64 = 4
+
+	DefaultProducerSelectionAlgorithm        = ProducerSelectionWeightedRandom
+	DefaultMaxProducerSlots           uint64 = 1
+	DefaultMinSeedReveals             uint64 = 2
 )
 
+// Producer selection algorithms
+const (
+	// ProducerSelectionWeightedRandom selects producers randomly, weighted by stake, with replacement
+	ProducerSelectionWeightedRandom = "weighted-random"
+	// ProducerSelectionTopStake selects validators with highest stake, deterministic
+	ProducerSelectionTopStake = "top-stake"
+	// ProducerSelectionCappedWeightedRandom selects producers randomly, weighted by stake, with max slots per validator
+	ProducerSelectionCappedWeightedRandom = "capped-weighted-random"
+)
+
+// ProducerSelectionAlgorithms lists all supported producer selection algorithms
+var ProducerSelectionAlgorithms = []string{
+	ProducerSelectionWeightedRandom,
+	ProducerSelectionTopStake,
+	ProducerSelectionCappedWeightedRandom,
+}
+
 // Parameter keys
 var (
 	KeySprintDuration = []byte("SprintDuration")
 	KeySpanDuration   = []byte("SpanDuration")
 	KeyProducerCount  = []byte("ProducerCount")
+
+	KeyProducerSelectionAlgorithm = []byte("ProducerSelectionAlgorithm")
+	KeyMaxProducerSlots           = []byte("MaxProducerSlots")
+	KeyMinSeedReveals             = []byte("MinSeedReveals")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -35,14 +60,28 @@ type Params struct {
 	SprintDuration uint64 `json:"sprint_duration" yaml:"sprint_duration"` // sprint duration
 	SpanDuration   uint64 `json:"span_duration" yaml:"span_duration"`     // span duration ie number of blocks for which val set is frozen on heimdall
 	ProducerCount  uint64 `json:"producer_count" yaml:"producer_count"`   // producer count per span
+
+	ProducerSelectionAlgorithm string `json:"producer_selection_algorithm" yaml:"producer_selection_algorithm"` // algorithm used to select producers for span
+	MaxProducerSlots           uint64 `json:"max_producer_slots" yaml:"max_producer_slots"`                     // max producer slots per validator for capped selection
+	MinSeedReveals             uint64 `json:"min_seed_reveals" yaml:"min_seed_reveals"`                         // min seed reveals required to derive span seed, 0 to always use mainchain block hash
 }
 
 // NewParams creates a new Params object
-func NewParams(sprintDuration uint64, spanDuration uint64, producerCount uint64) Params {
+func NewParams(
+	sprintDuration uint64,
+	spanDuration uint64,
+	producerCount uint64,
+	producerSelectionAlgorithm string,
+	maxProducerSlots uint64,
+	minSeedReveals uint64,
+) Params {
 	return Params{
-		SprintDuration: sprintDuration,
-		SpanDuration:   spanDuration,
-		ProducerCount:  producerCount,
+		SprintDuration:             sprintDuration,
+		SpanDuration:               spanDuration,
+		ProducerCount:              producerCount,
+		ProducerSelectionAlgorithm: producerSelectionAlgorithm,
+		MaxProducerSlots:           maxProducerSlots,
+		MinSeedReveals:             minSeedReveals,
 	}
 }
 
@@ -54,6 +93,9 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeySprintDuration, &p.SprintDuration},
 		{KeySpanDuration, &p.SpanDuration},
 		{KeyProducerCount, &p.ProducerCount},
+		{KeyProducerSelectionAlgorithm, &p.ProducerSelectionAlgorithm},
+		{KeyMaxProducerSlots, &p.MaxProducerSlots},
+		{KeyMinSeedReveals, &p.MinSeedReveals},
 	}
 }
 
@@ -71,6 +113,9 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("SprintDuration: %d\n", p.SprintDuration))
 	sb.WriteString(fmt.Sprintf("SpanDuration: %d\n", p.SpanDuration))
 	sb.WriteString(fmt.Sprintf("ProducerCount: %d\n", p.ProducerCount))
+	sb.WriteString(fmt.Sprintf("ProducerSelectionAlgorithm: %s\n", p.ProducerSelectionAlgorithm))
+	sb.WriteString(fmt.Sprintf("MaxProducerSlots: %d\n", p.MaxProducerSlots))
+	sb.WriteString(fmt.Sprintf("MinSeedReveals: %d\n", p.MinSeedReveals))
 	return sb.String()
 }
 
@@ -88,6 +133,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateProducerSelectionAlgorithm(p.ProducerSelectionAlgorithm); err != nil {
+		return err
+	}
+
+	if err := validateMaxProducerSlots(p.MaxProducerSlots); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -95,6 +148,17 @@ func (p Params) Validate() error {
 // Extra functions
 //
 
+// IsValidProducerSelectionAlgorithm checks if producer selection algorithm is supported
+func IsValidProducerSelectionAlgorithm(algorithm string) bool {
+	for _, a := range ProducerSelectionAlgorithms {
+		if a == algorithm {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
 	return subspace.NewKeyTable().RegisterParamSet(&Params{})
@@ -106,6 +170,10 @@ func DefaultParams() Params {
 		SprintDuration: DefaultSprintDuration,
 		SpanDuration:   DefaultSpanDuration,
 		ProducerCount:  DefaultProducerCount,
+
+		ProducerSelectionAlgorithm: DefaultProducerSelectionAlgorithm,
+		MaxProducerSlots:           DefaultMaxProducerSlots,
+		MinSeedReveals:             DefaultMinSeedReveals,
 	}
 }
 
@@ -147,3 +215,29 @@ func validateProducerCount(i interface{}) error {
 
 	return nil
 }
+
+func validateProducerSelectionAlgorithm(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !IsValidProducerSelectionAlgorithm(v) {
+		return fmt.Errorf("invalid producer selection algorithm: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxProducerSlots(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid max producer slots: %d", v)
+	}
+
+	return nil
+}
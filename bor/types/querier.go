@@ -1,5 +1,9 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
 	QueryParams        = "params"
@@ -10,6 +14,8 @@ const (
 	QueryNextProducers = "next-producers"
 	QueryNextSpanSeed  = "next-span-seed"
 
+	QueryPreviewProducers = "preview-producers"
+
 	ParamSpan          = "span"
 	ParamSprint        = "sprint"
 	ParamProducerCount = "producer-count"
@@ -25,3 +31,14 @@ type QuerySpanParams struct {
 func NewQuerySpanParams(recordID uint64) QuerySpanParams {
 	return QuerySpanParams{RecordID: recordID}
 }
+
+// QueryPreviewProducersParams defines the params for previewing producers selection.
+type QueryPreviewProducersParams struct {
+	Seed      hmTypes.HeimdallHash `json:"seed"`
+	Algorithm string               `json:"algorithm"` // current param is used if empty
+}
+
+// NewQueryPreviewProducersParams creates a new instance of QueryPreviewProducersParams.
+func NewQueryPreviewProducersParams(seed hmTypes.HeimdallHash, algorithm string) QueryPreviewProducersParams {
+	return QueryPreviewProducersParams{Seed: seed, Algorithm: algorithm}
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"github.com/maticnetwork/bor/common"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SprintSchedule represents expected producer of a sprint
+type SprintSchedule struct {
+	StartBlock uint64                  `json:"start_block" yaml:"start_block"`
+	EndBlock   uint64                  `json:"end_block" yaml:"end_block"`
+	ProducerID hmTypes.ValidatorID     `json:"producer_id" yaml:"producer_id"`
+	Producer   hmTypes.HeimdallAddress `json:"producer" yaml:"producer"`
+}
+
+// span schedule status
+const (
+	SpanScheduleCurrent     = "current"
+	SpanScheduleNext        = "next"
+	SpanScheduleSeedUnknown = "seed unknown"
+)
+
+// SpanSchedule represents expected producers of a span along with sprint rotation
+type SpanSchedule struct {
+	ID         uint64              `json:"span_id" yaml:"span_id"`
+	StartBlock uint64              `json:"start_block" yaml:"start_block"`
+	EndBlock   uint64              `json:"end_block" yaml:"end_block"`
+	Status     string              `json:"status" yaml:"status"`
+	Seed       *common.Hash        `json:"seed,omitempty" yaml:"seed,omitempty"`
+	Producers  []hmTypes.Validator `json:"producers" yaml:"producers"`
+	Sprints    []SprintSchedule    `json:"sprints" yaml:"sprints"`
+}
+
+// NewSpanSchedules returns schedule of latest span from its selected producers, followed by
+// `spans` upcoming spans. Next span is previewed with producers selected from next span seed,
+// seeds of later spans are not known yet so they are returned without producers.
+func NewSpanSchedules(lastSpan hmTypes.Span, nextSeed common.Hash, nextProducers []hmTypes.Validator, spans uint64, params Params) []SpanSchedule {
+	schedules := []SpanSchedule{
+		{
+			ID:         lastSpan.ID,
+			StartBlock: lastSpan.StartBlock,
+			EndBlock:   lastSpan.EndBlock,
+			Status:     SpanScheduleCurrent,
+			Producers:  lastSpan.SelectedProducers,
+			Sprints:    NewSprintSchedules(lastSpan.SelectedProducers, lastSpan.StartBlock, lastSpan.EndBlock, params.SprintDuration),
+		},
+	}
+
+	startBlock := lastSpan.EndBlock + 1
+	for i := uint64(1); i <= spans; i++ {
+		endBlock := startBlock + params.SpanDuration - 1
+		schedule := SpanSchedule{
+			ID:         lastSpan.ID + i,
+			StartBlock: startBlock,
+			EndBlock:   endBlock,
+			Status:     SpanScheduleSeedUnknown,
+		}
+
+		if i == 1 {
+			seed := nextSeed
+			schedule.Status = SpanScheduleNext
+			schedule.Seed = &seed
+			schedule.Producers = nextProducers
+			schedule.Sprints = NewSprintSchedules(nextProducers, startBlock, endBlock, params.SprintDuration)
+		}
+
+		schedules = append(schedules, schedule)
+		startBlock = endBlock + 1
+	}
+
+	return schedules
+}
+
+// NewSprintSchedules returns producer of each sprint between start and end block. Bor rotates
+// producers every sprint by incrementing proposer priority, weighted by producer's voting power.
+func NewSprintSchedules(producers []hmTypes.Validator, startBlock uint64, endBlock uint64, sprintDuration uint64) (sprints []SprintSchedule) {
+	if len(producers) == 0 || sprintDuration == 0 {
+		return sprints
+	}
+
+	vals := make([]*hmTypes.Validator, 0, len(producers))
+	for _, producer := range producers {
+		val := producer.Copy()
+		val.ProposerPriority = 0
+		vals = append(vals, val)
+	}
+	producerSet := hmTypes.NewValidatorSet(vals)
+
+	for sprintStart := startBlock; sprintStart <= endBlock; sprintStart += sprintDuration {
+		sprintEnd := sprintStart + sprintDuration - 1
+		if sprintEnd > endBlock {
+			sprintEnd = endBlock
+		}
+
+		proposer := producerSet.GetProposer()
+		sprints = append(sprints, SprintSchedule{
+			StartBlock: sprintStart,
+			EndBlock:   sprintEnd,
+			ProducerID: proposer.ID,
+			Producer:   proposer.Signer,
+		})
+
+		producerSet.IncrementProposerPriority(1)
+	}
+
+	return sprints
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SpanSeedCommit represents validator's commitment (and reveal) towards span seed
+type SpanSeedCommit struct {
+	SpanID      uint64               `json:"span_id" yaml:"span_id"`
+	ValidatorID hmTypes.ValidatorID  `json:"validator_id" yaml:"validator_id"`
+	Commitment  hmTypes.HeimdallHash `json:"commitment" yaml:"commitment"`
+	Secret      hmTypes.HeimdallHash `json:"secret" yaml:"secret"`
+	Revealed    bool                 `json:"revealed" yaml:"revealed"`
+}
+
+// NewSpanSeedCommit creates new span seed commit
+func NewSpanSeedCommit(spanID uint64, validatorID hmTypes.ValidatorID, commitment hmTypes.HeimdallHash) SpanSeedCommit {
+	return SpanSeedCommit{
+		SpanID:      spanID,
+		ValidatorID: validatorID,
+		Commitment:  commitment,
+	}
+}
+
+// SpanSeedCommitment returns commitment for secret. Signer is part of commitment
+// so that validators cannot replay each other's commitments to cancel reveals out.
+func SpanSeedCommitment(secret hmTypes.HeimdallHash, signer hmTypes.HeimdallAddress) hmTypes.HeimdallHash {
+	return hmTypes.BytesToHeimdallHash(crypto.Keccak256(secret.Bytes(), signer.Bytes()))
+}
+
+// XORSpanSeedSecrets derives span seed by xor-ing all revealed secrets
+func XORSpanSeedSecrets(commits []SpanSeedCommit) (seed common.Hash) {
+	for _, commit := range commits {
+		if !commit.Revealed {
+			continue
+		}
+
+		secret := commit.Secret.EthHash()
+		for i := range seed {
+			seed[i] ^= secret[i]
+		}
+	}
+
+	return seed
+}
@@ -71,7 +71,8 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
 		WithTxEncoder(txEncoder).
 		WithAccountNumber(tb.accNum).
 		WithSequence(tb.lastSeqNo).
-		WithChainID(chainID)
+		WithChainID(chainID).
+		WithFeeGranter(hmTypes.HexToHeimdallAddress(helper.GetConfig().FeeGranter))
 
 	txResponse, err := helper.BuildAndBroadcastMsgs(tb.cliCtx, txBldr, []sdk.Msg{msg})
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
 	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 )
 
@@ -189,6 +190,8 @@ func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight
 		hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight)
 	case slashingTypes.EventTypeTickConfirm:
 		hl.sendBlockTask("sendTickToRootchain", eventBytes, blockHeight)
+	case sidechannelTypes.EventTypeSideTxRetry:
+		hl.sendBlockTask("sendRetrySideTxToHeimdall", eventBytes, blockHeight)
 	default:
 		hl.Logger.Debug("BlockEvent Type mismatch", "eventType", event.Type)
 	}
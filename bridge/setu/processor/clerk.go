@@ -1,8 +1,11 @@
 package processor
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
+	"math/big"
+	"time"
 
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/maticnetwork/bor/accounts/abi"
@@ -14,10 +17,16 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+// maxStateSyncConfirmScan limits number of state ids checked on bor in a poll
+const maxStateSyncConfirmScan = 100
+
 // ClerkProcessor - sync state/deposit events
 type ClerkProcessor struct {
 	BaseProcessor
 	stateSenderAbi *abi.ABI
+
+	// state sync confirmation polling
+	cancelConfirmPolling context.CancelFunc
 }
 
 // NewClerkProcessor - add statesender abi to clerk processor
@@ -31,6 +40,11 @@ func NewClerkProcessor(stateSenderAbi *abi.ABI) *ClerkProcessor {
 // Start starts new block subscription
 func (cp *ClerkProcessor) Start() error {
 	cp.Logger.Info("Starting")
+	// state sync confirmation
+	confirmCtx, cancelConfirmPolling := context.WithCancel(context.Background())
+	cp.cancelConfirmPolling = cancelConfirmPolling
+	cp.Logger.Info("Start polling for state sync confirmation", "pollInterval", helper.GetConfig().ClerkPollInterval)
+	go cp.startPollingForStateSyncConfirmation(confirmCtx, helper.GetConfig().ClerkPollInterval)
 	return nil
 }
 
@@ -110,6 +124,100 @@ func (cp *ClerkProcessor) sendStateSyncedToHeimdall(eventName string, logBytes s
 	return nil
 }
 
+func (cp *ClerkProcessor) startPollingForStateSyncConfirmation(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	// stop ticker when everything done
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			go cp.sendStateSyncConfirmationToHeimdall()
+		case <-ctx.Done():
+			cp.Logger.Info("State sync confirmation polling stopped")
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// sendStateSyncConfirmationToHeimdall - confirms state ids committed by bor's state receiver
+// 1. check if i am the current proposer
+// 2. find last state id committed on bor after last synced state id on heimdall
+// 3. create and broadcast confirm state sync transaction to heimdall
+func (cp *ClerkProcessor) sendStateSyncConfirmationToHeimdall() {
+	isProposer, err := util.IsCurrentProposer(cp.cliCtx)
+	if err != nil {
+		cp.Logger.Error("Error checking isCurrentProposer", "error", err)
+		return
+	}
+
+	if !isProposer {
+		cp.Logger.Debug("Not current proposer, skipping state sync confirmation")
+		return
+	}
+
+	params, err := cp.paramsContext.GetParams()
+	if err != nil {
+		cp.Logger.Error("Error fetching chainmanager params", "error", err)
+		return
+	}
+
+	chainParams := params.ChainmanagerParams.ChainParams
+
+	lastSyncedStateID, err := cp.fetchLastSyncedStateID()
+	if err != nil {
+		return
+	}
+
+	// bor commits states in order, scan until first state not committed yet
+	stateID := lastSyncedStateID
+	for i := uint64(1); i <= maxStateSyncConfirmScan; i++ {
+		synced, err := cp.contractConnector.IsStateSynced(chainParams.StateReceiverAddress.EthAddress(), new(big.Int).SetUint64(lastSyncedStateID+i))
+		if err != nil {
+			cp.Logger.Error("Error fetching state from state receiver", "stateId", lastSyncedStateID+i, "error", err)
+			return
+		}
+
+		if !synced {
+			break
+		}
+		stateID = lastSyncedStateID + i
+	}
+
+	if stateID == lastSyncedStateID {
+		cp.Logger.Debug("No new state committed on bor", "lastSyncedStateId", lastSyncedStateID)
+		return
+	}
+
+	cp.Logger.Info("✅ Confirming state sync", "stateId", stateID, "lastSyncedStateId", lastSyncedStateID)
+
+	msg := clerkTypes.NewMsgConfirmStateSync(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		stateID,
+		chainParams.BorChainID,
+	)
+
+	// return broadcast to heimdall
+	if err := cp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+		cp.Logger.Error("Error while broadcasting state sync confirmation to heimdall", "error", err)
+	}
+}
+
+// fetchLastSyncedStateID - fetches last state id confirmed on heimdall
+func (cp *ClerkProcessor) fetchLastSyncedStateID() (stateID uint64, err error) {
+	response, err := helper.FetchFromAPI(cp.cliCtx, helper.GetHeimdallServerEndpoint(util.LastSyncedStateIDURL))
+	if err != nil {
+		cp.Logger.Error("Error fetching last synced state id from HeimdallServer", "error", err)
+		return stateID, err
+	}
+
+	if err := json.Unmarshal(response.Result, &stateID); err != nil {
+		cp.Logger.Error("Error unmarshalling last synced state id received from Heimdall Server", "error", err)
+		return stateID, err
+	}
+	return stateID, nil
+}
+
 // isOldTx  checks if tx is already processed or not
 func (cp *ClerkProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, logIndex uint64) (bool, error) {
 	queryParam := map[string]interface{}{
@@ -138,3 +246,9 @@ func (cp *ClerkProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, l
 
 	return status, nil
 }
+
+// Stop stops all necessary go routines
+func (cp *ClerkProcessor) Stop() {
+	// cancel state sync confirmation polling
+	cp.cancelConfirmPolling()
+}
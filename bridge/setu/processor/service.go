@@ -1,14 +1,20 @@
 package processor
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/common"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
+	tmTypes "github.com/tendermint/tendermint/types"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/helper"
 )
 
@@ -16,6 +22,12 @@ const (
 	processorServiceStr = "processor-service"
 )
 
+// contractMigrationQuery matches new blocks with contract migration events
+var contractMigrationQuery = fmt.Sprintf("%s = '%s' AND %s.%s = '%s'",
+	tmTypes.EventTypeKey, tmTypes.EventNewBlock,
+	chainmanagerTypes.EventTypeContractMigration, sdk.AttributeKeyModule, chainmanagerTypes.AttributeValueCategory,
+)
+
 // ProcessorService starts and stops all event processors
 type ProcessorService struct {
 	// Base service
@@ -24,6 +36,11 @@ type ProcessorService struct {
 	// queue connector
 	queueConnector *queue.QueueConnector
 
+	// tendermint client, params context and subscription refreshing params on contract migration
+	httpClient         *httpClient.HTTP
+	paramsContext      *util.ParamsContext
+	cancelSubscription context.CancelFunc
+
 	processors []Processor
 }
 
@@ -39,6 +56,8 @@ func NewProcessorService(
 	// creating processor object
 	processorService := &ProcessorService{
 		queueConnector: queueConnector,
+		httpClient:     httpClient,
+		paramsContext:  paramsContext,
 	}
 
 	contractCaller, err := helper.NewContractCaller()
@@ -76,6 +95,10 @@ func NewProcessorService(
 	slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
 	slashingProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, paramsContext, "slashing", slashingProcessor)
 
+	// initialize sidechannel processor
+	sidechannelProcessor := &SidechannelProcessor{}
+	sidechannelProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, paramsContext, "sidechannel", sidechannelProcessor)
+
 	//
 	// Select processors
 	//
@@ -92,6 +115,7 @@ func NewProcessorService(
 			feeProcessor,
 			spanProcessor,
 			slashingProcessor,
+			sidechannelProcessor,
 		)
 	} else {
 		for _, service := range onlyServices {
@@ -108,6 +132,8 @@ func NewProcessorService(
 				processorService.processors = append(processorService.processors, spanProcessor)
 			case "slashing":
 				processorService.processors = append(processorService.processors, slashingProcessor)
+			case "sidechannel":
+				processorService.processors = append(processorService.processors, sidechannelProcessor)
 			}
 		}
 	}
@@ -131,13 +157,45 @@ func (processorService *ProcessorService) OnStart() error {
 		go processor.Start()
 	}
 
+	// refresh params of processors in this process on contract migration
+	ctx, cancelSubscription := context.WithCancel(context.Background())
+	processorService.cancelSubscription = cancelSubscription
+	go processorService.startParamsRefresh(ctx)
+
 	processorService.Logger.Info("all processors Started")
 	return nil
 }
 
+// startParamsRefresh drops cached params when a block applies a contract migration, so that
+// processors re-read contract addresses from chainmanager params
+func (processorService *ProcessorService) startParamsRefresh(ctx context.Context) {
+	eventCh, err := processorService.httpClient.Subscribe(ctx, processorServiceStr, contractMigrationQuery)
+	if err != nil {
+		processorService.Logger.Error("Error subscribing to contract migration events", "error", err)
+		return
+	}
+
+	for {
+		select {
+		case event := <-eventCh:
+			if data, ok := event.Data.(tmTypes.EventDataNewBlock); ok {
+				processorService.Logger.Info("Contract migration applied, refreshing params", "blockHeight", data.Block.Height)
+			}
+			processorService.paramsContext.Invalidate()
+		case <-ctx.Done():
+			processorService.Logger.Info("Params refresh stopped")
+			return
+		}
+	}
+}
+
 // OnStop stops all necessary go routines
 func (processorService *ProcessorService) OnStop() {
 	processorService.BaseService.OnStop() // Always call the overridden method.
+	// stop params refresh
+	if processorService.cancelSubscription != nil {
+		processorService.cancelSubscription()
+	}
 	// start chain listeners
 	for _, processor := range processorService.processors {
 		processor.Stop()
@@ -0,0 +1,75 @@
+package processor
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SidechannelProcessor - process sidechannel related events
+type SidechannelProcessor struct {
+	BaseProcessor
+}
+
+// Start starts new block subscription
+func (sp *SidechannelProcessor) Start() error {
+	sp.Logger.Info("Starting")
+	return nil
+}
+
+// RegisterTasks - Registers sidechannel related tasks with machinery
+func (sp *SidechannelProcessor) RegisterTasks() {
+	sp.Logger.Info("Registering sidechannel related tasks")
+	if err := sp.queueConnector.Server.RegisterTask("sendRetrySideTxToHeimdall", sp.sendRetrySideTxToHeimdall); err != nil {
+		sp.Logger.Error("RegisterTasks | sendRetrySideTxToHeimdall", "error", err)
+	}
+}
+
+// sendRetrySideTxToHeimdall - proposes due staged side-tx for another vote round
+func (sp *SidechannelProcessor) sendRetrySideTxToHeimdall(eventBytes string, blockHeight int64) error {
+	sp.Logger.Info("Recevied sendRetrySideTxToHeimdall request", "eventBytes", eventBytes, "blockHeight", blockHeight)
+	var event = sdk.StringEvent{}
+	if err := json.Unmarshal([]byte(eventBytes), &event); err != nil {
+		sp.Logger.Error("Error unmarshalling event from heimdall", "error", err)
+		return err
+	}
+
+	txHash := hmTypes.HexBytes{}
+	for _, attr := range event.Attributes {
+		if attr.Key == sidechannelTypes.AttributeKeyTxHash {
+			txHash = hmTypes.HexToHexBytes(attr.Value)
+		}
+	}
+
+	// only current proposer retries side-tx
+	isCurrentProposer, err := util.IsCurrentProposer(sp.cliCtx)
+	if err != nil {
+		sp.Logger.Error("Error checking isCurrentProposer", "error", err)
+		return err
+	}
+
+	if !isCurrentProposer {
+		sp.Logger.Info("I am not the current proposer. Ignoring", "eventType", event.Type)
+		return nil
+	}
+
+	sp.Logger.Info("✅ Creating and broadcasting side-tx retry", "txHash", txHash.String())
+
+	msg := sidechannelTypes.NewMsgRetrySideTx(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		txHash,
+	)
+
+	// return broadcast to heimdall
+	if err := sp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+		sp.Logger.Error("Error while broadcasting side-tx retry msg to heimdall", "error", err)
+		return err
+	}
+
+	return nil
+}
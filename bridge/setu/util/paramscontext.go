@@ -49,6 +49,11 @@ func (paramsContext *ParamsContext) GetParams() (params Params, err error) {
 	return
 }
 
+// Invalidate drops cached params so that next call fetches latest params
+func (paramsContext *ParamsContext) Invalidate() {
+	paramsContext.paramsCache.Delete(paramsContext.key)
+}
+
 func fetchLatestParams(cliContext cliContext.CLIContext) (params Params, err error) {
 	chainmanagerParams, err := GetChainmanagerParams(cliContext)
 	if err != nil {
@@ -1,3 +1,6 @@
 package cli
 
-const ()
+const (
+	FlagMigrationID = "migration-id"
+	FlagValidatorID = "validator-id"
+)
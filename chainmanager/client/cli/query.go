@@ -26,6 +26,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		client.GetCommands(
 			GetQueryParams(cdc),
+			GetQueryContractMigrations(cdc),
 		)...,
 	)
 	return txCmd
@@ -63,3 +64,33 @@ $ %s query chainmanager params
 		},
 	}
 }
+
+// GetQueryContractMigrations implements the pending contract migrations query command.
+func GetQueryContractMigrations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-migrations",
+		Args:  cobra.NoArgs,
+		Short: "show passed contract migrations pending verification",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query contract migrations waiting for on chain verification.
+
+Example:
+$ %s query chainmanager contract-migrations
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryContractMigrations)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+}
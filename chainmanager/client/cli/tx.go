@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	chainmanagerUtils "github.com/maticnetwork/heimdall/chainmanager/client/utils"
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmClient "github.com/maticnetwork/heimdall/client"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+var logger = helper.Logger.With("module", "chainmanager/client/cli")
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Chainmanager transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		client.PostCommands(
+			ExecuteContractMigration(cdc),
+		)...,
+	)
+	return txCmd
+}
+
+// ExecuteContractMigration sends contract migration transaction for passed proposal
+func ExecuteContractMigration(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-migration",
+		Short: "verify and apply passed contract migration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			migrationID := viper.GetUint64(FlagMigrationID)
+			if migrationID == 0 {
+				return fmt.Errorf("migration id cannot be empty")
+			}
+
+			msg := types.NewMsgContractMigration(
+				helper.GetFromAddress(cliCtx),
+				migrationID,
+			)
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Uint64(FlagMigrationID, 0, "--migration-id=<migration-id>")
+
+	if err := cmd.MarkFlagRequired(FlagMigrationID); err != nil {
+		logger.Error("ExecuteContractMigration | MarkFlagRequired | FlagMigrationID", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitProposal implements a command handler for submitting a contract
+// migration proposal transaction.
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-migration [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a contract migration proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a contract migration proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Once the proposal passes,
+new addresses are verified on chain with a contract-migration transaction before
+chain params are updated.
+
+Example:
+$ %s tx gov submit-proposal contract-migration <path/to/proposal.json> --validator-id=1 --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Root chain migration",
+  "description": "Move to new root chain contract",
+  "changes": [
+    {
+      "contract": "root_chain_address",
+      "address": "0x..."
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "matic",
+      "amount": "1000000000000000000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := chainmanagerUtils.ParseContractMigrationProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			from := helper.GetFromAddress(cliCtx)
+			content := types.NewContractMigrationProposal(proposal.Title, proposal.Description, proposal.Changes)
+
+			// create submit proposal
+			msg := govTypes.NewMsgSubmitProposal(content, proposal.Deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("GetCmdSubmitProposal | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
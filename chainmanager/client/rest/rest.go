@@ -9,4 +9,6 @@ import (
 // RegisterRoutes registers the auth module REST routes.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/chainmanager/params", paramsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/contract-migrations", contractMigrationsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/contract-migration", contractMigrationHandlerFn(cliCtx)).Methods("POST")
 }
@@ -0,0 +1,80 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainUtils "github.com/maticnetwork/heimdall/chainmanager/client/utils"
+	chainTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	govRest "github.com/maticnetwork/heimdall/gov/client/rest"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/types"
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+// ContractMigrationReq contract migration request object
+type ContractMigrationReq struct {
+	BaseReq hmRest.BaseReq `json:"base_req"`
+
+	MigrationID uint64 `json:"migration_id"`
+}
+
+func contractMigrationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// read req from request
+		var req ContractMigrationReq
+		if !hmRest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create contract migration msg
+		msg := chainTypes.NewMsgContractMigration(
+			types.HexToHeimdallAddress(req.BaseReq.From),
+			req.MigrationID,
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the contract
+// migration REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "contract_migration",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req chainUtils.ContractMigrationProposalReq
+		if !hmRest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := chainTypes.NewContractMigrationProposal(req.Title, req.Description, req.Changes)
+
+		msg := govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
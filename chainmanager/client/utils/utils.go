@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+type (
+	// ContractMigrationProposalJSON defines a ContractMigrationProposal with a deposit used
+	// to parse contract migration proposals from a JSON file.
+	ContractMigrationProposalJSON struct {
+		Title       string                 `json:"title" yaml:"title"`
+		Description string                 `json:"description" yaml:"description"`
+		Changes     []types.ContractChange `json:"changes" yaml:"changes"`
+		Deposit     sdk.Coins              `json:"deposit" yaml:"deposit"`
+		Validator   hmTypes.ValidatorID    `json:"validator" yaml:"validator"`
+	}
+
+	// ContractMigrationProposalReq defines a contract migration proposal request body.
+	ContractMigrationProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string                  `json:"title" yaml:"title"`
+		Description string                  `json:"description" yaml:"description"`
+		Changes     []types.ContractChange  `json:"changes" yaml:"changes"`
+		Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+		Validator   hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+	}
+)
+
+// ParseContractMigrationProposalJSON reads and parses a ContractMigrationProposalJSON from
+// file.
+func ParseContractMigrationProposalJSON(cdc *codec.Codec, proposalFile string) (ContractMigrationProposalJSON, error) {
+	proposal := ContractMigrationProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
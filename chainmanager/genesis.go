@@ -9,6 +9,14 @@ import (
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+
+	for _, migration := range data.ContractMigrations {
+		keeper.SetContractMigration(ctx, migration)
+	}
+
+	if data.NextContractMigrationID != 0 {
+		keeper.SetNextContractMigrationID(ctx, data.NextContractMigrationID)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -17,5 +25,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 
 	return types.NewGenesisState(
 		params,
+		keeper.GetContractMigrations(ctx),
+		keeper.GetNextContractMigrationID(ctx),
 	)
 }
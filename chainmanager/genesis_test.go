@@ -7,6 +7,7 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -35,7 +36,8 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	params := types.DefaultParams()
 
 	genesisState := types.GenesisState{
-		Params: params,
+		Params:                  params,
+		NextContractMigrationID: 1,
 	}
 	chainmanager.InitGenesis(ctx, app.ChainKeeper, genesisState)
 
@@ -43,3 +45,28 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	require.Equal(t, genesisState, actualParams)
 
 }
+
+// TestInitExportContractMigrations test pending contract migrations survive export and import
+func (suite *GenesisTestSuite) TestInitExportContractMigrations() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	address := hmTypes.HexToHeimdallAddress("0x000000000000000000000000000000000000dead")
+	app.ChainKeeper.AddContractMigration(ctx, []types.ContractChange{types.NewContractChange(types.ContractStakingInfo, address)})
+	migration := app.ChainKeeper.AddContractMigration(ctx, []types.ContractChange{types.NewContractChange(types.ContractRootChain, address)})
+	app.ChainKeeper.DeleteContractMigration(ctx, 1)
+
+	genesisState := chainmanager.ExportGenesis(ctx, app.ChainKeeper)
+	require.Equal(t, []types.ContractMigration{migration}, genesisState.ContractMigrations)
+	require.Equal(t, uint64(3), genesisState.NextContractMigrationID)
+	require.NoError(t, types.ValidateGenesis(genesisState))
+
+	newApp, newCtx := createTestApp(true)
+	chainmanager.InitGenesis(newCtx, newApp.ChainKeeper, genesisState)
+	require.Equal(t, genesisState, chainmanager.ExportGenesis(newCtx, newApp.ChainKeeper))
+
+	// ids are not reused after import
+	require.Equal(t, uint64(3), newApp.ChainKeeper.AddContractMigration(newCtx, migration.Changes).ID)
+
+	genesisState.NextContractMigrationID = 2
+	require.Error(t, types.ValidateGenesis(genesisState))
+}
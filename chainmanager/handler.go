@@ -0,0 +1,49 @@
+package chainmanager
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/common"
+)
+
+// NewHandler creates new handler for handling messages for chainmanager module
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgContractMigration:
+			return handleMsgContractMigration(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in chainmanager module").Result()
+		}
+	}
+}
+
+func handleMsgContractMigration(ctx sdk.Context, msg types.MsgContractMigration, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating contract migration msg",
+		"migrationID", msg.MigrationID,
+	)
+
+	// only passed migrations can be executed
+	if _, found := k.GetContractMigration(ctx, msg.MigrationID); !found {
+		k.Logger(ctx).Error("Contract migration not found", "migrationID", msg.MigrationID)
+		return common.ErrContractMigrationNotFound(k.Codespace()).Result()
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeContractMigration,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyMigrationID, strconv.FormatUint(msg.MigrationID, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
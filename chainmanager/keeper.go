@@ -1,6 +1,8 @@
 package chainmanager
 
 import (
+	"encoding/binary"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -42,6 +44,11 @@ func NewKeeper(
 	return keeper
 }
 
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -60,3 +67,99 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return
 }
+
+// -----------------------------------------------------------------------------
+// Contract migrations
+
+// GetContractMigrationKey returns key for contract migration id
+func GetContractMigrationKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(types.ContractMigrationPrefixKey, bz...)
+}
+
+// AddContractMigration stores passed contract changes as pending migration and returns its id
+func (k Keeper) AddContractMigration(ctx sdk.Context, changes []types.ContractChange) types.ContractMigration {
+	id := k.GetNextContractMigrationID(ctx)
+	k.SetNextContractMigrationID(ctx, id+1)
+
+	migration := types.NewContractMigration(id, changes)
+	k.SetContractMigration(ctx, migration)
+	return migration
+}
+
+// GetNextContractMigrationID returns id of the next contract migration
+func (k Keeper) GetNextContractMigrationID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ContractMigrationIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextContractMigrationID sets id of the next contract migration
+func (k Keeper) SetNextContractMigrationID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	store.Set(types.ContractMigrationIDKey, bz)
+}
+
+// SetContractMigration stores pending contract migration
+func (k Keeper) SetContractMigration(ctx sdk.Context, migration types.ContractMigration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetContractMigrationKey(migration.ID), k.cdc.MustMarshalBinaryBare(migration))
+}
+
+// GetContractMigration returns pending contract migration for id
+func (k Keeper) GetContractMigration(ctx sdk.Context, id uint64) (migration types.ContractMigration, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetContractMigrationKey(id))
+	if bz == nil {
+		return migration, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &migration)
+	return migration, true
+}
+
+// GetContractMigrations returns all pending contract migrations
+func (k Keeper) GetContractMigrations(ctx sdk.Context) (migrations []types.ContractMigration) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ContractMigrationPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var migration types.ContractMigration
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &migration)
+		migrations = append(migrations, migration)
+	}
+
+	return
+}
+
+// DeleteContractMigration removes pending contract migration
+func (k Keeper) DeleteContractMigration(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetContractMigrationKey(id))
+}
+
+// ApplyContractMigration updates chain params with migrated contract addresses
+func (k Keeper) ApplyContractMigration(ctx sdk.Context, migration types.ContractMigration) error {
+	params := k.GetParams(ctx)
+
+	chainParams := params.ChainParams
+	for _, change := range migration.Changes {
+		var err error
+		if chainParams, err = chainParams.WithContractChange(change); err != nil {
+			return err
+		}
+	}
+
+	params.ChainParams = chainParams
+	k.SetParams(ctx, params)
+	k.DeleteContractMigration(ctx, migration.ID)
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/maticnetwork/heimdall/chainmanager/simulation"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmModule "github.com/maticnetwork/heimdall/types/module"
 	simTypes "github.com/maticnetwork/heimdall/types/simulation"
 )
@@ -25,6 +26,7 @@ var (
 	_ module.AppModule             = AppModule{}
 	_ module.AppModuleBasic        = AppModuleBasic{}
 	_ hmModule.HeimdallModuleBasic = AppModule{}
+	_ hmModule.SideModule          = AppModule{}
 	// _ module.AppModuleSimulation = AppModule{}
 )
 
@@ -69,7 +71,7 @@ func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router
 
 // GetTxCmd returns the root tx command for the auth module.
 func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	return chainmanagerCli.GetTxCmd(cdc)
 }
 
 // GetQueryCmd returns the root query command for the auth module.
@@ -111,7 +113,7 @@ func (AppModule) Route() string {
 
 // NewHandler returns an sdk.Handler for the module.
 func (am AppModule) NewHandler() sdk.Handler {
-	return nil
+	return NewHandler(am.keeper)
 }
 
 // QuerierRoute returns the auth module's querier route name.
@@ -124,6 +126,16 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// NewSideTxHandler side tx handler
+func (am AppModule) NewSideTxHandler() hmTypes.SideTxHandler {
+	return NewSideTxHandler(am.keeper, am.contractCaller)
+}
+
+// NewPostTxHandler side tx handler
+func (am AppModule) NewPostTxHandler() hmTypes.PostTxHandler {
+	return NewPostTxHandler(am.keeper, am.contractCaller)
+}
+
 // InitGenesis performs genesis initialization for the auth module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
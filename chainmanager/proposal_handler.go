@@ -0,0 +1,47 @@
+package chainmanager
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+)
+
+// NewContractMigrationProposalHandler creates handler for contract migration proposals
+func NewContractMigrationProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.ContractMigrationProposal:
+			return handleContractMigrationProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized chainmanager proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+// handleContractMigrationProposal stores passed migration as pending.
+// Chain params are updated only after new contracts are verified with side-tx.
+func handleContractMigrationProposal(ctx sdk.Context, k Keeper, p types.ContractMigrationProposal) sdk.Error {
+	if err := types.ValidateContractChanges(p.Changes); err != nil {
+		return err
+	}
+
+	migration := k.AddContractMigration(ctx, p.Changes)
+
+	k.Logger(ctx).Info("Contract migration is pending verification", "migrationID", migration.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContractMigrationPending,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyMigrationID, strconv.FormatUint(migration.ID, 10)),
+		),
+	)
+
+	return nil
+}
@@ -15,6 +15,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryParams:
 			return queryParams(ctx, req, keeper)
+		case types.QueryContractMigrations:
+			return queryContractMigrations(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown chainmanager query endpoint")
 		}
@@ -28,3 +30,11 @@ func queryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 	}
 	return bz, nil
 }
+
+func queryContractMigrations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetContractMigrations(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,192 @@
+package chainmanager
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	errNoContractCode             = errors.New("no contract code at address")
+	errUnexpectedContractResponse = errors.New("unexpected contract response")
+)
+
+// NewSideTxHandler returns a side handler for "chainmanager" type messages.
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case types.MsgContractMigration:
+			return SideHandleMsgContractMigration(ctx, k, msg, contractCaller)
+		default:
+			return abci.ResponseDeliverSideTx{
+				Code: uint32(sdk.CodeUnknownRequest),
+			}
+		}
+	}
+}
+
+// NewPostTxHandler returns a post handler for "chainmanager" type messages.
+func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case types.MsgContractMigration:
+			return PostHandleMsgContractMigration(ctx, k, msg, sideTxResult)
+		default:
+			return sdk.ErrUnknownRequest("Unknown msg type").Result()
+		}
+	}
+}
+
+// SideHandleMsgContractMigration verifies new contracts are deployed and answer expected calls
+func SideHandleMsgContractMigration(ctx sdk.Context, k Keeper, msg types.MsgContractMigration, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for contract migration msg",
+		"migrationID", msg.MigrationID,
+	)
+
+	migration, found := k.GetContractMigration(ctx, msg.MigrationID)
+	if !found {
+		k.Logger(ctx).Error("Contract migration not found", "migrationID", msg.MigrationID)
+		return common.ErrorSideTx(k.Codespace(), common.CodeContractMigrationNotFound)
+	}
+
+	for _, change := range migration.Changes {
+		if err := verifyContract(change, contractCaller); err != nil {
+			k.Logger(ctx).Error("Contract verification failed", "migrationID", msg.MigrationID, "contract", change.Contract, "address", change.Address, "error", err)
+			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidContractMigration)
+		}
+	}
+
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// PostHandleMsgContractMigration updates chain params with verified contract addresses
+func PostHandleMsgContractMigration(ctx sdk.Context, k Keeper, msg types.MsgContractMigration, sideTxResult abci.SideTxResultType) sdk.Result {
+	// check for replay
+	migration, found := k.GetContractMigration(ctx, msg.MigrationID)
+	if !found {
+		k.Logger(ctx).Debug("Skipping contract migration as it's already processed")
+		return common.ErrOldTx(k.Codespace()).Result()
+	}
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// add events
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeContractMigration,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyMigrationID, strconv.FormatUint(msg.MigrationID, 10)),
+		),
+	}
+
+	// migration not verified on chain is dropped, contracts need a new proposal
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Info("Dropping contract migration since side-tx didn't get yes votes", "migrationID", msg.MigrationID, "result", sideTxResult)
+		k.DeleteContractMigration(ctx, msg.MigrationID)
+		ctx.EventManager().EmitEvents(events)
+
+		return sdk.Result{
+			Events: ctx.EventManager().Events(),
+		}
+	}
+
+	if err := k.ApplyContractMigration(ctx, migration); err != nil {
+		k.Logger(ctx).Error("Unable to apply contract migration", "migrationID", msg.MigrationID, "error", err)
+		return common.ErrInvalidContractMigration(k.Codespace()).Result()
+	}
+
+	k.Logger(ctx).Info("Contract migration applied", "migrationID", msg.MigrationID)
+
+	for _, change := range migration.Changes {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeContractMigration,
+			sdk.NewAttribute(types.AttributeKeyContract, change.Contract),
+			sdk.NewAttribute(types.AttributeKeyAddress, change.Address.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// verifyContract checks contract bytecode exists and contract answers expected ABI call
+func verifyContract(change types.ContractChange, contractCaller helper.IContractCaller) error {
+	address := change.Address.EthAddress()
+
+	// check bytecode on respective chain
+	var hasCode bool
+	var err error
+	if change.IsMaticChainContract() {
+		hasCode, err = contractCaller.HasMaticChainCode(address)
+	} else {
+		hasCode, err = contractCaller.HasMainChainCode(address)
+	}
+	if err != nil {
+		return err
+	}
+	if !hasCode {
+		return errNoContractCode
+	}
+
+	// check contract answers expected calls
+	switch change.Contract {
+	case types.ContractRootChain:
+		rootChainInstance, err := contractCaller.GetRootChainInstance(address)
+		if err != nil {
+			return err
+		}
+		_, err = contractCaller.GetLastChildBlock(rootChainInstance)
+		return err
+
+	case types.ContractStakingInfo:
+		stakingInfoInstance, err := contractCaller.GetStakingInfoInstance(address)
+		if err != nil {
+			return err
+		}
+		_, err = contractCaller.CurrentAccountStateRoot(stakingInfoInstance)
+		return err
+
+	case types.ContractStateSender:
+		stateSenderInstance, err := contractCaller.GetStateSenderInstance(address)
+		if err != nil {
+			return err
+		}
+		if contractCaller.CurrentStateCounter(stateSenderInstance) == nil {
+			return errUnexpectedContractResponse
+		}
+
+	case types.ContractValidatorSet:
+		validatorSetInstance, err := contractCaller.GetValidatorSetInstance(address)
+		if err != nil {
+			return err
+		}
+		if contractCaller.CurrentSpanNumber(validatorSetInstance) == nil {
+			return errUnexpectedContractResponse
+		}
+
+	case types.ContractStateReceiver:
+		_, err := contractCaller.IsStateSynced(address, big.NewInt(0))
+		return err
+	}
+
+	return nil
+}
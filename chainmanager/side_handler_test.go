@@ -0,0 +1,162 @@
+package chainmanager_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/chainmanager"
+	"github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Create test suite
+//
+
+// SideHandlerTestSuite integrate test suite context object
+type SideHandlerTestSuite struct {
+	suite.Suite
+
+	app            *app.HeimdallApp
+	ctx            sdk.Context
+	sideHandler    hmTypes.SideTxHandler
+	postHandler    hmTypes.PostTxHandler
+	contractCaller mocks.IContractCaller
+}
+
+func (suite *SideHandlerTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.sideHandler = chainmanager.NewSideTxHandler(suite.app.ChainKeeper, &suite.contractCaller)
+	suite.postHandler = chainmanager.NewPostTxHandler(suite.app.ChainKeeper, &suite.contractCaller)
+}
+
+func TestSideHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SideHandlerTestSuite))
+}
+
+//
+// Test cases
+//
+
+func (suite *SideHandlerTestSuite) TestContractMigrationProposal() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	handler := chainmanager.NewContractMigrationProposalHandler(app.ChainKeeper)
+
+	newAddress := hmTypes.HexToHeimdallAddress("0x000000000000000000000000000000000000abcd")
+	proposal := types.NewContractMigrationProposal("title", "description", []types.ContractChange{
+		types.NewContractChange(types.ContractRootChain, newAddress),
+	})
+
+	err := handler(ctx, proposal)
+	require.Nil(t, err)
+
+	// params are unchanged until migration is verified
+	require.NotEqual(t, newAddress, app.ChainKeeper.GetParams(ctx).ChainParams.RootChainAddress)
+
+	migration, found := app.ChainKeeper.GetContractMigration(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, proposal.Changes, migration.Changes)
+
+	// unknown contract is rejected
+	invalid := types.NewContractMigrationProposal("title", "description", []types.ContractChange{
+		types.NewContractChange("unknown_address", newAddress),
+	})
+	require.NotNil(t, handler(ctx, invalid))
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgContractMigration() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	newAddress := hmTypes.HexToHeimdallAddress("0x000000000000000000000000000000000000abcd")
+	migration := app.ChainKeeper.AddContractMigration(ctx, []types.ContractChange{
+		types.NewContractChange(types.ContractRootChain, newAddress),
+	})
+	msg := types.NewMsgContractMigration(hmTypes.HexToHeimdallAddress("0x1"), migration.ID)
+
+	suite.Run("Success", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		rootChainInstance := &rootchain.Rootchain{}
+		suite.contractCaller.On("HasMainChainCode", newAddress.EthAddress()).Return(true, nil)
+		suite.contractCaller.On("GetRootChainInstance", newAddress.EthAddress()).Return(rootChainInstance, nil)
+		suite.contractCaller.On("GetLastChildBlock", rootChainInstance).Return(uint64(10000), nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code)
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result)
+	})
+
+	suite.Run("NoCode", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("HasMainChainCode", newAddress.EthAddress()).Return(false, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result)
+	})
+
+	suite.Run("UnexpectedABI", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		rootChainInstance := &rootchain.Rootchain{}
+		suite.contractCaller.On("HasMainChainCode", newAddress.EthAddress()).Return(true, nil)
+		suite.contractCaller.On("GetRootChainInstance", newAddress.EthAddress()).Return(rootChainInstance, nil)
+		suite.contractCaller.On("GetLastChildBlock", mock.Anything).Return(uint64(0), errors.New("execution reverted"))
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result)
+	})
+
+	suite.Run("NotFound", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		result := suite.sideHandler(ctx, types.NewMsgContractMigration(hmTypes.HexToHeimdallAddress("0x1"), migration.ID+1))
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgContractMigration() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	newAddress := hmTypes.HexToHeimdallAddress("0x000000000000000000000000000000000000abcd")
+	migration := app.ChainKeeper.AddContractMigration(ctx, []types.ContractChange{
+		types.NewContractChange(types.ContractStakingInfo, newAddress),
+	})
+	msg := types.NewMsgContractMigration(hmTypes.HexToHeimdallAddress("0x1"), migration.ID)
+
+	// rejected side-tx drops migration without changing params
+	for _, sideTxResult := range []abci.SideTxResultType{abci.SideTxResultType_No, abci.SideTxResultType_Skip} {
+		rejected := app.ChainKeeper.AddContractMigration(ctx, []types.ContractChange{
+			types.NewContractChange(types.ContractStakingInfo, newAddress),
+		})
+		result := suite.postHandler(ctx, types.NewMsgContractMigration(hmTypes.HexToHeimdallAddress("0x1"), rejected.ID), sideTxResult)
+		require.True(t, result.IsOK(), "expected post handler to succeed, got %v", result)
+		require.NotEqual(t, newAddress, app.ChainKeeper.GetParams(ctx).ChainParams.StakingInfoAddress)
+
+		_, found := app.ChainKeeper.GetContractMigration(ctx, rejected.ID)
+		require.False(t, found, sideTxResult.String())
+	}
+
+	result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected post handler to succeed, got %v", result)
+	require.Equal(t, newAddress, app.ChainKeeper.GetParams(ctx).ChainParams.StakingInfoAddress)
+
+	_, found := app.ChainKeeper.GetContractMigration(ctx, migration.ID)
+	require.False(t, found)
+
+	// replay
+	result = suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+	require.False(t, result.IsOK())
+}
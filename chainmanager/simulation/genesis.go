@@ -84,7 +84,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		ValidatorSetAddress:   validatorSetAddress,
 	}
 	params := types.NewParams(mainchainTxConfirmations, maticchainTxConfirmations, chainParams)
-	chainManagerGenesis := types.NewGenesisState(params)
+	chainManagerGenesis := types.NewGenesisState(params, nil, 0)
 	fmt.Printf("Selected randomly generated chainmanager parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, chainManagerGenesis))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(chainManagerGenesis)
 }
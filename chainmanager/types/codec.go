@@ -15,5 +15,6 @@ func init() {
 
 // RegisterCodec registers all necessary param module types with a given codec.
 func RegisterCodec(cdc *codec.Codec) {
-	//TODO: implement here
+	cdc.RegisterConcrete(MsgContractMigration{}, "chainmanager/MsgContractMigration", nil)
+	cdc.RegisterConcrete(ContractMigrationProposal{}, "heimdall/ContractMigrationProposal", nil)
 }
@@ -0,0 +1,13 @@
+package types
+
+// chainmanager module event types
+const (
+	EventTypeContractMigrationPending = "contract-migration-pending"
+	EventTypeContractMigration        = "contract-migration"
+
+	AttributeKeyMigrationID = "migration-id"
+	AttributeKeyContract    = "contract"
+	AttributeKeyAddress     = "address"
+
+	AttributeValueCategory = ModuleName
+)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 //
@@ -10,24 +11,44 @@ import (
 
 // GenesisState - all chainmanager state that must be provided at genesis
 type GenesisState struct {
-	Params Params `json:"params" yaml:"params"`
+	Params                  Params              `json:"params" yaml:"params"`
+	ContractMigrations      []ContractMigration `json:"contract_migrations" yaml:"contract_migrations"`               // migrations accepted by governance, pending on-chain verification
+	NextContractMigrationID uint64              `json:"next_contract_migration_id" yaml:"next_contract_migration_id"` // id of the next migration, 0 to start from 1
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params Params) GenesisState {
+func NewGenesisState(params Params, contractMigrations []ContractMigration, nextContractMigrationID uint64) GenesisState {
 	return GenesisState{
-		Params: params,
+		Params:                  params,
+		ContractMigrations:      contractMigrations,
+		NextContractMigrationID: nextContractMigrationID,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams())
+	return NewGenesisState(DefaultParams(), nil, 0)
 }
 
 // ValidateGenesis performs basic validation of auth genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	seen := make(map[uint64]bool)
+	for _, migration := range data.ContractMigrations {
+		if migration.ID == 0 || seen[migration.ID] {
+			return fmt.Errorf("invalid or duplicate contract migration id %d", migration.ID)
+		}
+		seen[migration.ID] = true
+
+		if data.NextContractMigrationID != 0 && migration.ID >= data.NextContractMigrationID {
+			return fmt.Errorf("contract migration id %d is not lower than next id %d", migration.ID, data.NextContractMigrationID)
+		}
+
+		if err := ValidateContractChanges(migration.Changes); err != nil {
+			return fmt.Errorf("contract migration %d: %s", migration.ID, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -16,3 +16,8 @@ const (
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
 )
+
+var (
+	ContractMigrationPrefixKey = []byte{0x11} // prefix key for pending contract migrations
+	ContractMigrationIDKey     = []byte{0x12} // key for next contract migration id
+)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// MsgContractMigration - requests on chain verification and execution of a passed contract migration
+type MsgContractMigration struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	MigrationID uint64                  `json:"migration_id"`
+}
+
+var _ sdk.Msg = MsgContractMigration{}
+
+// NewMsgContractMigration - construct contract migration msg
+func NewMsgContractMigration(from hmTypes.HeimdallAddress, migrationID uint64) MsgContractMigration {
+	return MsgContractMigration{
+		From:        from,
+		MigrationID: migrationID,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgContractMigration) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgContractMigration) Type() string { return "contract-migration" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgContractMigration) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgContractMigration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgContractMigration) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgContractMigration) GetSideSignBytes() []byte {
+	return nil
+}
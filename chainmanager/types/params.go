@@ -59,6 +59,32 @@ func (cp ChainParams) String() string {
 		cp.BorChainID, cp.MaticTokenAddress, cp.StakingManagerAddress, cp.SlashManagerAddress, cp.RootChainAddress, cp.StakingInfoAddress, cp.StateSenderAddress, cp.StateReceiverAddress, cp.ValidatorSetAddress)
 }
 
+// WithContractChange returns chain params with contract address replaced
+func (cp ChainParams) WithContractChange(change ContractChange) (ChainParams, error) {
+	switch change.Contract {
+	case ContractMaticToken:
+		cp.MaticTokenAddress = change.Address
+	case ContractStakingManager:
+		cp.StakingManagerAddress = change.Address
+	case ContractSlashManager:
+		cp.SlashManagerAddress = change.Address
+	case ContractRootChain:
+		cp.RootChainAddress = change.Address
+	case ContractStakingInfo:
+		cp.StakingInfoAddress = change.Address
+	case ContractStateSender:
+		cp.StateSenderAddress = change.Address
+	case ContractStateReceiver:
+		cp.StateReceiverAddress = change.Address
+	case ContractValidatorSet:
+		cp.ValidatorSetAddress = change.Address
+	default:
+		return cp, fmt.Errorf("unknown contract %s", change.Contract)
+	}
+
+	return cp, nil
+}
+
 // Params defines the parameters for the chainmanager module.
 type Params struct {
 	MainchainTxConfirmations  uint64      `json:"mainchain_tx_confirmations" yaml:"mainchain_tx_confirmations"`
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// ProposalTypeContractMigration defines the type for a ContractMigrationProposal
+	ProposalTypeContractMigration = "ContractMigration"
+)
+
+// Chain contracts which can be migrated, named after chain params json fields
+const (
+	ContractMaticToken     = "matic_token_address"
+	ContractStakingManager = "staking_manager_address"
+	ContractSlashManager   = "slash_manager_address"
+	ContractRootChain      = "root_chain_address"
+	ContractStakingInfo    = "staking_info_address"
+	ContractStateSender    = "state_sender_address"
+	ContractStateReceiver  = "state_receiver_address"
+	ContractValidatorSet   = "validator_set_address"
+)
+
+// Assert ContractMigrationProposal implements govTypes.Content at compile-time
+var _ govTypes.Content = ContractMigrationProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeContractMigration)
+	govTypes.RegisterProposalTypeCodec(ContractMigrationProposal{}, "heimdall/ContractMigrationProposal")
+}
+
+// ContractMigrationProposal defines a proposal which migrates chain contracts to new addresses.
+// New addresses are verified on chain by validators before chain params are updated.
+type ContractMigrationProposal struct {
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	Changes     []ContractChange `json:"changes" yaml:"changes"`
+}
+
+// NewContractMigrationProposal creates a new contract migration proposal
+func NewContractMigrationProposal(title, description string, changes []ContractChange) ContractMigrationProposal {
+	return ContractMigrationProposal{title, description, changes}
+}
+
+// GetTitle returns the title of a contract migration proposal.
+func (cmp ContractMigrationProposal) GetTitle() string { return cmp.Title }
+
+// GetDescription returns the description of a contract migration proposal.
+func (cmp ContractMigrationProposal) GetDescription() string { return cmp.Description }
+
+// ProposalRoute returns the routing key of a contract migration proposal.
+func (cmp ContractMigrationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a contract migration proposal.
+func (cmp ContractMigrationProposal) ProposalType() string { return ProposalTypeContractMigration }
+
+// ValidateBasic validates the contract migration proposal
+func (cmp ContractMigrationProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(govTypes.DefaultCodespace, cmp); err != nil {
+		return err
+	}
+
+	return ValidateContractChanges(cmp.Changes)
+}
+
+// String implements the Stringer interface.
+func (cmp ContractMigrationProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Contract Migration Proposal:
+  Title:       %s
+  Description: %s
+  Changes:
+`, cmp.Title, cmp.Description))
+
+	for _, c := range cmp.Changes {
+		b.WriteString(fmt.Sprintf(`    Contract Change:
+      Contract: %s
+      Address:  %s
+`, c.Contract, c.Address))
+	}
+
+	return b.String()
+}
+
+// ContractChange defines a new address for a chain contract
+type ContractChange struct {
+	Contract string                  `json:"contract" yaml:"contract"`
+	Address  hmTypes.HeimdallAddress `json:"address" yaml:"address"`
+}
+
+// NewContractChange creates a new contract change
+func NewContractChange(contract string, address hmTypes.HeimdallAddress) ContractChange {
+	return ContractChange{contract, address}
+}
+
+// String implements the Stringer interface.
+func (c ContractChange) String() string {
+	return fmt.Sprintf(`Contract Change:
+  Contract: %s
+  Address:  %s
+`, c.Contract, c.Address)
+}
+
+// IsMaticChainContract returns true if contract is deployed on bor chain
+func (c ContractChange) IsMaticChainContract() bool {
+	return c.Contract == ContractStateReceiver || c.Contract == ContractValidatorSet
+}
+
+// ValidateContractChanges performs basic validation checks over a set of ContractChange
+func ValidateContractChanges(changes []ContractChange) sdk.Error {
+	if len(changes) == 0 {
+		return govTypes.ErrInvalidProposalContent(govTypes.DefaultCodespace, "contract changes cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, c := range changes {
+		if _, err := (ChainParams{}).WithContractChange(c); err != nil {
+			return govTypes.ErrInvalidProposalContent(govTypes.DefaultCodespace, err.Error())
+		}
+
+		if c.Address.Empty() {
+			return govTypes.ErrInvalidProposalContent(govTypes.DefaultCodespace, fmt.Sprintf("address for %s cannot be empty", c.Contract))
+		}
+
+		if seen[c.Contract] {
+			return govTypes.ErrInvalidProposalContent(govTypes.DefaultCodespace, fmt.Sprintf("duplicate change for %s", c.Contract))
+		}
+		seen[c.Contract] = true
+	}
+
+	return nil
+}
+
+// ContractMigration is a passed contract migration waiting for on chain verification
+type ContractMigration struct {
+	ID      uint64           `json:"id" yaml:"id"`
+	Changes []ContractChange `json:"changes" yaml:"changes"`
+}
+
+// NewContractMigration creates a new contract migration
+func NewContractMigration(id uint64, changes []ContractChange) ContractMigration {
+	return ContractMigration{ID: id, Changes: changes}
+}
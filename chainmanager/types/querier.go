@@ -2,5 +2,6 @@ package types
 
 // query endpoints supported by the chain-manager Querier
 const (
-	QueryParams = "params"
+	QueryParams             = "params"
+	QueryContractMigrations = "contract-migrations"
 )
@@ -15,6 +15,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -37,6 +38,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetLastNoACK(cdc),
 			GetHeaderFromIndex(cdc),
 			GetCheckpointCount(cdc),
+			GetFeeReward(cdc),
+			GetFeeRewards(cdc),
 		)...,
 	)
 
@@ -194,3 +197,65 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetFeeReward get withdrawable fee reward of validator
+func GetFeeReward(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-reward",
+		Short: "get withdrawable fee reward of validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			validatorID := viper.GetUint64(FlagValidatorID)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryFeeRewardParams(hmTypes.NewValidatorID(validatorID)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeReward), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var reward types.FeeReward
+			if err := json.Unmarshal(res, &reward); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(reward)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--validator-id=<validator-id>")
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("GetFeeReward | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetFeeRewards get withdrawable fee rewards of all validators
+func GetFeeRewards(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-rewards",
+		Short: "get withdrawable fee rewards of all validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeRewards), nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("No fee rewards found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -39,6 +39,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 			SendCheckpointTx(cdc),
 			SendCheckpointACKTx(cdc),
 			SendCheckpointNoACKTx(cdc),
+			SendWithdrawFeeRewardTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -289,3 +290,24 @@ func SendCheckpointNoACKTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
 	return cmd
 }
+
+// SendWithdrawFeeRewardTx send withdraw fee reward transaction
+func SendWithdrawFeeRewardTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-fee-reward",
+		Short: "withdraw checkpoint fee reward of validator to its signer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// create new withdraw fee reward msg
+			msg := types.NewMsgWithdrawFeeReward(
+				helper.GetFromAddress(cliCtx),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -20,6 +20,7 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	).Methods("POST")
 	r.HandleFunc("/checkpoint/ack", newCheckpointACKHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/checkpoint/no-ack", newCheckpointNoACKHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/checkpoint/withdraw-fee-reward", newWithdrawFeeRewardHandler(cliCtx)).Methods("POST")
 }
 
 type (
@@ -56,6 +57,11 @@ type (
 
 		Proposer hmTypes.HeimdallAddress `json:"proposer"`
 	}
+
+	// WithdrawFeeRewardReq struct for withdrawing fee reward of validator
+	WithdrawFeeRewardReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+	}
 )
 
 func newCheckpointHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -135,3 +141,25 @@ func newCheckpointNoACKHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func newWithdrawFeeRewardHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req WithdrawFeeRewardReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// draft a message and send response
+		msg := types.NewMsgWithdrawFeeReward(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
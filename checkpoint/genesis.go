@@ -2,6 +2,7 @@ package checkpoint
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -44,6 +45,20 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 	// Set initial ack count
 	keeper.UpdateACKCountWithValue(ctx, data.AckCount)
+
+	// Set fee rewards, fee reward pool module account must hold all of them
+	keeper.SetUndistributedFees(ctx, data.UndistributedFees)
+	pool := data.UndistributedFees
+	for _, reward := range data.FeeRewards {
+		keeper.SetFeeReward(ctx, reward.ValidatorID, reward.Amount)
+		pool = pool.Add(reward.Amount)
+	}
+
+	if !pool.Empty() {
+		if moduleAcc := keeper.GetFeeRewardPool(ctx); moduleAcc == nil || !moduleAcc.GetCoins().IsAllGTE(pool) {
+			panic(fmt.Sprintf("%s module account does not hold fee rewards", types.FeeRewardPoolName))
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -51,11 +66,24 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
+	ackCount := keeper.GetACKCount(ctx)
+
+	// export checkpoints in checkpoint number order, so that checkpoints with
+	// the same timestamp keep their numbers on import
+	checkpoints := make([]hmTypes.Checkpoint, 0, ackCount)
+	for number := uint64(1); number <= ackCount; number++ {
+		if checkpoint, err := keeper.GetCheckpointByNumber(ctx, number); err == nil {
+			checkpoints = append(checkpoints, checkpoint)
+		}
+	}
+
 	return types.NewGenesisState(
 		params,
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
-		keeper.GetACKCount(ctx),
-		hmTypes.SortHeaders(keeper.GetCheckpoints(ctx)),
+		ackCount,
+		hmTypes.SortHeaders(checkpoints),
+		keeper.GetUndistributedFees(ctx),
+		keeper.GetFeeRewards(ctx),
 	)
 }
@@ -24,6 +24,8 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 			return handleMsgCheckpointAck(ctx, msg, k, contractCaller)
 		case types.MsgCheckpointNoAck:
 			return handleMsgCheckpointNoAck(ctx, msg, k)
+		case types.MsgWithdrawFeeReward:
+			return handleMsgWithdrawFeeReward(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in checkpoint module").Result()
 		}
@@ -254,3 +256,35 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// handleMsgWithdrawFeeReward withdraws fee reward of validator to its signer
+func handleMsgWithdrawFeeReward(ctx sdk.Context, msg types.MsgWithdrawFeeReward, k Keeper) sdk.Result {
+	logger := k.Logger(ctx)
+
+	// fee reward is withdrawn by validator signer only
+	validator, err := k.sk.GetValidatorInfo(ctx, msg.From.Bytes())
+	if err != nil {
+		logger.Error("No validator found for signer", "from", msg.From.String())
+		return common.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	amount, sdkErr := k.WithdrawFeeReward(ctx, validator.ID, msg.From)
+	if sdkErr != nil {
+		logger.Error("Unable to withdraw fee reward", "validatorID", validator.ID, "error", sdkErr)
+		return sdkErr.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawReward,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
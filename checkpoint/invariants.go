@@ -0,0 +1,59 @@
+package checkpoint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+// RegisterInvariants registers all checkpoint invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "fee-reward-pool", FeeRewardPoolInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "ack-count", ACKCountInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the checkpoint module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := FeeRewardPoolInvariant(keeper)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ACKCountInvariant(keeper)(ctx)
+	}
+}
+
+// FeeRewardPoolInvariant checks that the fee reward pool module account coins reflects
+// undistributed fees and withdrawable fee rewards tracked on store
+func FeeRewardPoolInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := keeper.GetUndistributedFees(ctx)
+		for _, reward := range keeper.GetFeeRewards(ctx) {
+			expected = expected.Add(reward.Amount)
+		}
+
+		coins := keeper.GetFeeRewardPool(ctx).GetCoins()
+		broken := !coins.IsAllGTE(expected) || !expected.IsAllGTE(coins)
+
+		return sdk.FormatInvariant(types.ModuleName, "fee reward pool",
+			fmt.Sprintf("\tfee reward pool ModuleAccount coins: %s\n\ttracked fees and rewards:            %s\n",
+				coins, expected)), broken
+	}
+}
+
+// ACKCountInvariant checks that the ACK count matches the number of
+// acknowledged checkpoints held on store
+func ACKCountInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		ackCount := keeper.GetACKCount(ctx)
+		checkpoints := uint64(len(keeper.GetCheckpoints(ctx)))
+		broken := ackCount != checkpoints
+
+		return sdk.FormatInvariant(types.ModuleName, "ack count",
+			fmt.Sprintf("\tACK count:           %d\n\tstored checkpoints: %d\n",
+				ackCount, checkpoints)), broken
+	}
+}
@@ -1,18 +1,24 @@
 package checkpoint
 
 import (
+	"encoding/binary"
 	"errors"
+	"sort"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/staking"
+	"github.com/maticnetwork/heimdall/supply"
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -23,11 +29,15 @@ var (
 	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
 	CheckpointKey       = []byte{0x13} // prefix key for when storing checkpoint after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+
+	UndistributedFeesKey = []byte{0x15} // key to store fees waiting for next checkpoint ack
+	FeeRewardKey         = []byte{0x16} // prefix key to store withdrawable fee rewards of validators
 )
 
 // ModuleCommunicator manages different module interaction
 type ModuleCommunicator interface {
 	GetAllDividendAccounts(ctx sdk.Context) []hmTypes.DividendAccount
+	GetSideTxVoters(ctx sdk.Context, txHash []byte) []abci.Validator
 }
 
 // Keeper stores all related data
@@ -36,6 +46,8 @@ type Keeper struct {
 	// staking keeper
 	sk staking.Keeper
 	ck chainmanager.Keeper
+	// supply keeper
+	supplyKeeper supply.Keeper
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 	// codespace
@@ -55,6 +67,7 @@ func NewKeeper(
 	codespace sdk.CodespaceType,
 	stakingKeeper staking.Keeper,
 	chainKeeper chainmanager.Keeper,
+	supplyKeeper supply.Keeper,
 	moduleCommunicator ModuleCommunicator,
 ) Keeper {
 	keeper := Keeper{
@@ -64,6 +77,7 @@ func NewKeeper(
 		codespace:          codespace,
 		sk:                 stakingKeeper,
 		ck:                 chainKeeper,
+		supplyKeeper:       supplyKeeper,
 		moduleCommunicator: moduleCommunicator,
 	}
 	return keeper
@@ -321,3 +335,207 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return
 }
+
+// -----------------------------------------------------------------------------
+// Fee rewards
+
+// GetFeeRewardPool returns the fee reward pool ModuleAccount
+func (k Keeper) GetFeeRewardPool(ctx sdk.Context) supplyTypes.ModuleAccountInterface {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.FeeRewardPoolName)
+}
+
+// GetUndistributedFees returns collected fees waiting for next checkpoint ack
+func (k Keeper) GetUndistributedFees(ctx sdk.Context) (fees sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(UndistributedFeesKey)
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &fees)
+	return fees
+}
+
+// SetUndistributedFees sets collected fees waiting for next checkpoint ack
+func (k Keeper) SetUndistributedFees(ctx sdk.Context, fees sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if fees.Empty() {
+		store.Delete(UndistributedFeesKey)
+		return
+	}
+	store.Set(UndistributedFeesKey, k.cdc.MustMarshalBinaryBare(fees))
+}
+
+// GetFeeRewardKey appends prefix to validator id
+func GetFeeRewardKey(valID hmTypes.ValidatorID) []byte {
+	return append(FeeRewardKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetFeeReward returns withdrawable fee reward of validator
+func (k Keeper) GetFeeReward(ctx sdk.Context, valID hmTypes.ValidatorID) (reward sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetFeeRewardKey(valID))
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &reward)
+	return reward
+}
+
+// SetFeeReward sets withdrawable fee reward of validator
+func (k Keeper) SetFeeReward(ctx sdk.Context, valID hmTypes.ValidatorID, reward sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if reward.Empty() {
+		store.Delete(GetFeeRewardKey(valID))
+		return
+	}
+	store.Set(GetFeeRewardKey(valID), k.cdc.MustMarshalBinaryBare(reward))
+}
+
+// GetFeeRewards returns withdrawable fee rewards of all validators
+func (k Keeper) GetFeeRewards(ctx sdk.Context) (rewards []types.FeeReward) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, FeeRewardKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var reward sdk.Coins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &reward)
+
+		valID := hmTypes.ValidatorID(binary.BigEndian.Uint64(iterator.Key()[len(FeeRewardKey):]))
+		rewards = append(rewards, types.NewFeeReward(valID, reward))
+	}
+
+	return rewards
+}
+
+// CollectFees moves collected fees from fee collector into fee reward pool
+func (k Keeper) CollectFees(ctx sdk.Context) {
+	feeCollector := k.supplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
+	amount := feeCollector.GetCoins().AmountOf(authTypes.FeeToken)
+	if !amount.IsPositive() {
+		return
+	}
+
+	coins := sdk.Coins{sdk.NewCoin(authTypes.FeeToken, amount)}
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName, types.FeeRewardPoolName, coins); err != nil {
+		k.Logger(ctx).Error("Unable to collect fees into fee reward pool", "error", err)
+		return
+	}
+
+	k.SetUndistributedFees(ctx, k.GetUndistributedFees(ctx).Add(coins))
+}
+
+// DistributeFees splits undistributed fees among validators who voted yes on checkpoint ack,
+// weighted by their voting power. Checkpoint proposer gets proposer bonus and rounding remainder.
+func (k Keeper) DistributeFees(ctx sdk.Context, proposer hmTypes.HeimdallAddress, voters []abci.Validator) {
+	logger := k.Logger(ctx)
+
+	fees := k.GetUndistributedFees(ctx)
+	total := fees.AmountOf(authTypes.FeeToken)
+	if !total.IsPositive() {
+		return
+	}
+
+	// resolve voters to validator ids
+	var voterIDs []hmTypes.ValidatorID
+	var voterPowers []int64
+	totalPower := int64(0)
+	for _, voter := range voters {
+		validator, err := k.sk.GetValidatorInfo(ctx, voter.Address)
+		if err != nil || voter.Power <= 0 {
+			continue
+		}
+		voterIDs = append(voterIDs, validator.ID)
+		voterPowers = append(voterPowers, voter.Power)
+		totalPower += voter.Power
+	}
+
+	proposerValidator, err := k.sk.GetValidatorInfo(ctx, proposer.Bytes())
+	hasProposer := err == nil
+	if !hasProposer && totalPower == 0 {
+		logger.Info("No checkpoint signers to distribute fees to, keeping fees for next checkpoint")
+		return
+	}
+
+	rewards := make(map[hmTypes.ValidatorID]sdk.Int)
+	distributed := sdk.ZeroInt()
+
+	// proposer bonus
+	if hasProposer {
+		bonusPercent := k.sk.GetParams(ctx).ProposerBonusPercent
+		bonus := total.MulRaw(bonusPercent).QuoRaw(100)
+		if totalPower == 0 {
+			bonus = total
+		}
+		rewards[proposerValidator.ID] = bonus
+		distributed = distributed.Add(bonus)
+	}
+
+	// split remaining fees among voters by power
+	remaining := total.Sub(distributed)
+	for i, valID := range voterIDs {
+		share := remaining.MulRaw(voterPowers[i]).QuoRaw(totalPower)
+		if _, ok := rewards[valID]; !ok {
+			rewards[valID] = sdk.ZeroInt()
+		}
+		rewards[valID] = rewards[valID].Add(share)
+		distributed = distributed.Add(share)
+	}
+
+	// rounding remainder goes to proposer, or to first voter if proposer is unknown
+	if dust := total.Sub(distributed); dust.IsPositive() {
+		dustReceiver := proposerValidator.ID
+		if !hasProposer {
+			dustReceiver = voterIDs[0]
+		}
+		rewards[dustReceiver] = rewards[dustReceiver].Add(dust)
+	}
+
+	// record rewards in deterministic order
+	var events sdk.Events
+	for _, valID := range k.sortedRewardIDs(rewards) {
+		amount := rewards[valID]
+		if !amount.IsPositive() {
+			continue
+		}
+
+		coins := sdk.Coins{sdk.NewCoin(authTypes.FeeToken, amount)}
+		k.SetFeeReward(ctx, valID, k.GetFeeReward(ctx, valID).Add(coins))
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeFeeReward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, valID.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		))
+	}
+
+	k.SetUndistributedFees(ctx, fees.Sub(sdk.Coins{sdk.NewCoin(authTypes.FeeToken, total)}))
+	ctx.EventManager().EmitEvents(events)
+}
+
+// WithdrawFeeReward transfers withdrawable fee reward of validator to recipient
+func (k Keeper) WithdrawFeeReward(ctx sdk.Context, valID hmTypes.ValidatorID, recipient hmTypes.HeimdallAddress) (sdk.Coins, sdk.Error) {
+	reward := k.GetFeeReward(ctx, valID)
+	if reward.Empty() {
+		return nil, cmn.ErrNoFeeReward(k.Codespace())
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeRewardPoolName, recipient, reward); err != nil {
+		return nil, err
+	}
+
+	k.SetFeeReward(ctx, valID, sdk.Coins{})
+	return reward, nil
+}
+
+func (k Keeper) sortedRewardIDs(rewards map[hmTypes.ValidatorID]sdk.Int) []hmTypes.ValidatorID {
+	ids := make([]hmTypes.ValidatorID, 0, len(rewards))
+	for id := range rewards {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -6,8 +6,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -114,3 +118,97 @@ func (suite *KeeperTestSuite) TestFlushCheckpointBuffer() {
 	result := keeper.HasStoreValue(ctx, key)
 	require.False(t, result)
 }
+
+func (suite *KeeperTestSuite) TestDistributeFees() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	valSet := chSim.LoadValidatorSet(3, t, app.StakingKeeper, ctx, false, 10)
+	proposer := valSet.Validators[0]
+	other := valSet.Validators[1]
+
+	// collect fees into fee reward pool
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
+	require.Nil(t, app.BankKeeper.SetCoins(ctx, feeCollector.GetAddress(), sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(1001))}))
+	keeper.CollectFees(ctx)
+	require.True(t, app.BankKeeper.GetCoins(ctx, feeCollector.GetAddress()).IsZero())
+	require.Equal(t, sdk.NewInt(1001), keeper.GetUndistributedFees(ctx).AmountOf(authTypes.FeeToken))
+
+	// unknown proposer and no voters keeps fees undistributed
+	keeper.DistributeFees(ctx, hmTypes.HexToHeimdallAddress("123"), nil)
+	require.Equal(t, sdk.NewInt(1001), keeper.GetUndistributedFees(ctx).AmountOf(authTypes.FeeToken))
+	require.Empty(t, keeper.GetFeeRewards(ctx))
+
+	voters := []abci.Validator{
+		{Address: proposer.Signer.Bytes(), Power: 1},
+		{Address: other.Signer.Bytes(), Power: 2},
+	}
+	keeper.DistributeFees(ctx, proposer.Signer, voters)
+
+	// 10% proposer bonus, rest by voting power, rounding remainder to proposer
+	require.True(t, keeper.GetUndistributedFees(ctx).IsZero())
+	require.Equal(t, sdk.NewInt(100+300+1), keeper.GetFeeReward(ctx, proposer.ID).AmountOf(authTypes.FeeToken))
+	require.Equal(t, sdk.NewInt(600), keeper.GetFeeReward(ctx, other.ID).AmountOf(authTypes.FeeToken))
+	require.True(t, keeper.GetFeeReward(ctx, valSet.Validators[2].ID).IsZero())
+	require.Len(t, keeper.GetFeeRewards(ctx), 2)
+
+	_, broken := checkpoint.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func (suite *KeeperTestSuite) TestWithdrawFeeReward() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	handler := checkpoint.NewHandler(keeper, nil)
+
+	valSet := chSim.LoadValidatorSet(1, t, app.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+
+	// nothing to withdraw
+	result := handler(ctx, types.NewMsgWithdrawFeeReward(validator.Signer))
+	require.False(t, result.IsOK())
+
+	// non validator can't withdraw
+	result = handler(ctx, types.NewMsgWithdrawFeeReward(hmTypes.HexToHeimdallAddress("123")))
+	require.False(t, result.IsOK())
+
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
+	require.Nil(t, app.BankKeeper.SetCoins(ctx, feeCollector.GetAddress(), sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(500))}))
+	keeper.CollectFees(ctx)
+	keeper.DistributeFees(ctx, validator.Signer, []abci.Validator{{Address: validator.Signer.Bytes(), Power: validator.VotingPower}})
+
+	result = handler(ctx, types.NewMsgWithdrawFeeReward(validator.Signer))
+	require.True(t, result.IsOK(), "expected withdraw to be ok, got %v", result)
+	require.Equal(t, sdk.NewInt(500), app.BankKeeper.GetCoins(ctx, validator.Signer).AmountOf(authTypes.FeeToken))
+	require.True(t, keeper.GetFeeReward(ctx, validator.ID).IsZero())
+
+	_, broken := checkpoint.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func (suite *KeeperTestSuite) TestACKCountInvariant() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	_, broken := checkpoint.ACKCountInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	Checkpoint := hmTypes.CreateBlock(
+		0,
+		256,
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	err := keeper.AddCheckpoint(ctx, uint64(10000), Checkpoint)
+	require.NoError(t, err)
+
+	// checkpoint stored without ACK
+	_, broken = checkpoint.ACKCountInvariant(keeper)(ctx)
+	require.True(t, broken)
+
+	keeper.UpdateACKCount(ctx)
+	_, broken = checkpoint.ACKCountInvariant(keeper)(ctx)
+	require.False(t, broken)
+}
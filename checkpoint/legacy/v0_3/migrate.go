@@ -0,0 +1,26 @@
+package v0_3
+
+import (
+	"encoding/json"
+
+	"github.com/maticnetwork/heimdall/migrate"
+)
+
+// Migrate adds the fee rewards of checkpoint signers, no fees are pending
+// distribution yet. Existing checkpoint state needs no migration, the empty
+// fee lists are set so migrated genesis has the layout of a v0.3 export.
+func Migrate(oldGenState json.RawMessage) (json.RawMessage, error) {
+	genState, err := migrate.DecodeObject(oldGenState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := genState.SetDefault("undistributed_fees", migrate.EmptyList); err != nil {
+		return nil, err
+	}
+	if err := genState.SetDefault("fee_rewards", migrate.EmptyList); err != nil {
+		return nil, err
+	}
+
+	return genState.Marshal()
+}
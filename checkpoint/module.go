@@ -123,8 +123,10 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the checkpoint invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the auth module.
 func (AppModule) Route() string {
@@ -205,9 +207,9 @@ func (AppModule) RegisterStoreDecoder(sdr hmModule.StoreDecoderRegistry) {
 	return
 }
 
-// WeightedOperations doesn't return any chainmanager module operation.
-func (AppModule) WeightedOperations(_ hmModule.SimulationState) []simTypes.WeightedOperation {
-	return nil
+// WeightedOperations returns all the checkpoint module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState hmModule.SimulationState) []simTypes.WeightedOperation {
+	return WeightedOperations(simState.AppParams, simState.Cdc, am.keeper, simState.SideChannel)
 }
 
 //
@@ -0,0 +1,164 @@
+package checkpoint
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/simulation"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCheckpoint    = "op_weight_msg_checkpoint"
+	OpWeightMsgCheckpointAck = "op_weight_msg_checkpoint_ack"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simTypes.AppParams, cdc *codec.Codec, k Keeper, sideChannel *simTypes.SideChannel) simulation.WeightedOperations {
+	var weightMsgCheckpoint int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCheckpoint, &weightMsgCheckpoint, nil,
+		func(_ *rand.Rand) {
+			weightMsgCheckpoint = 50
+		},
+	)
+
+	var weightMsgCheckpointAck int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCheckpointAck, &weightMsgCheckpointAck, nil,
+		func(_ *rand.Rand) {
+			weightMsgCheckpointAck = 50
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCheckpoint, SimulateMsgCheckpoint(k, sideChannel)),
+		simulation.NewWeightedOperation(weightMsgCheckpointAck, SimulateMsgCheckpointAck(k, sideChannel)),
+	}
+}
+
+// SimulateMsgCheckpoint generates a MsgCheckpoint following the last checkpoint,
+// occasionally with an invalid range, account root hash, proposer or root hash.
+func SimulateMsgCheckpoint(k Keeper, sideChannel *simTypes.SideChannel) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+
+		validatorSet := k.sk.GetValidatorSet(ctx)
+		if validatorSet.Proposer == nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		params := k.GetParams(ctx)
+		proposer := validatorSet.Proposer.Signer
+
+		var start uint64
+		if lastCheckpoint, err := k.GetLastCheckpoint(ctx); err == nil {
+			start = lastCheckpoint.EndBlock + 1
+		}
+		end := start + uint64(simTypes.RandIntBetween(r, 1, int(params.AvgCheckpointLength)))
+
+		accountRoot, err := types.GetAccountRootHash(k.moduleCommunicator.GetAllDividendAccounts(ctx))
+		if err != nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		rootHash := hmTypes.BytesToHeimdallHash(simTypes.RandBytes(r, 32))
+		contractRootHash := rootHash
+		blocksExist := true
+
+		switch r.Intn(10) {
+		case 0:
+			start++
+		case 1:
+			accountRoot = simTypes.RandBytes(r, 32)
+		case 2:
+			acc, _ := simTypes.RandomAcc(r, accs)
+			proposer = acc.Address
+		case 3:
+			contractRootHash = hmTypes.BytesToHeimdallHash(simTypes.RandBytes(r, 32))
+		case 4:
+			blocksExist = false
+		}
+
+		contractCaller := &mocks.IContractCaller{}
+		contractCaller.On("CheckIfBlocksExist", mock.Anything).Return(blocksExist)
+		contractCaller.On("GetRootHash", mock.Anything, mock.Anything, mock.Anything).Return(contractRootHash.Bytes(), nil)
+
+		msg := types.NewMsgCheckpointBlock(
+			proposer,
+			start,
+			end,
+			rootHash,
+			hmTypes.BytesToHeimdallHash(accountRoot),
+			k.ck.GetParams(ctx).ChainParams.BorChainID,
+		)
+
+		opMsg := simulation.DeliverSideTx(r, sideChannel, ctx, msg,
+			NewHandler(k, contractCaller),
+			NewSideTxHandler(k, contractCaller),
+		)
+
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgCheckpointAck generates a MsgCheckpointAck for the checkpoint in
+// buffer, occasionally not matching the checkpoint submitted on the root chain.
+func SimulateMsgCheckpointAck(k Keeper, sideChannel *simTypes.SideChannel) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+
+		checkpoint, err := k.GetCheckpointFromBuffer(ctx)
+		if err != nil || checkpoint == nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		from, _ := simTypes.RandomAcc(r, accs)
+		params := k.GetParams(ctx)
+
+		// checkpoint as submitted on the root chain
+		contractCheckpoint := *checkpoint
+		switch r.Intn(10) {
+		case 0:
+			contractCheckpoint.RootHash = hmTypes.BytesToHeimdallHash(simTypes.RandBytes(r, 32))
+		case 1:
+			contractCheckpoint.EndBlock++
+		}
+
+		contractCaller := &mocks.IContractCaller{}
+		contractCaller.On("GetRootChainInstance", mock.Anything).Return(&rootchain.Rootchain{}, nil)
+		contractCaller.On("GetHeaderInfo", mock.Anything, mock.Anything, params.ChildBlockInterval).Return(
+			contractCheckpoint.RootHash.EthHash(),
+			contractCheckpoint.StartBlock,
+			contractCheckpoint.EndBlock,
+			contractCheckpoint.TimeStamp,
+			contractCheckpoint.Proposer,
+			nil,
+		)
+
+		msg := types.NewMsgCheckpointAck(
+			from.Address,
+			k.GetACKCount(ctx)+1,
+			checkpoint.Proposer,
+			checkpoint.StartBlock,
+			checkpoint.EndBlock,
+			checkpoint.RootHash,
+			hmTypes.BytesToHeimdallHash(simTypes.RandBytes(r, 32)),
+			uint64(r.Intn(100)),
+		)
+
+		opMsg := simulation.DeliverSideTx(r, sideChannel, ctx, msg,
+			NewHandler(k, contractCaller),
+			NewSideTxHandler(k, contractCaller),
+		)
+
+		return opMsg, nil, nil
+	}
+}
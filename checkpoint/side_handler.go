@@ -289,6 +289,9 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	txBytes := ctx.TxBytes()
 	hash := tmTypes.Tx(txBytes).Hash()
 
+	// Distribute collected fees among checkpoint signers
+	k.DistributeFees(ctx, checkpointObj.Proposer, k.moduleCommunicator.GetSideTxVoters(ctx, hash))
+
 	// Emit event for checkpoints
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -1,8 +1,6 @@
 package simulation
 
 import (
-	"time"
-
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/module"
@@ -17,7 +15,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	rootHash := hmTypes.HexToHeimdallHash("123")
 
 	proposerAddress := hmTypes.HexToHeimdallAddress("123")
-	timestamp := uint64(time.Now().Unix())
+	timestamp := uint64(simState.GenTimestamp.Unix())
 	borChainID := "1234"
 
 	bufferedCheckpoint := hmTypes.CreateBlock(
@@ -42,6 +40,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		uint64(lastNoACK),
 		uint64(ackCount),
 		Checkpoints,
+		nil,
+		nil,
 	)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
 
@@ -8,6 +8,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCheckpoint{}, "checkpoint/MsgCheckpoint", nil)
 	cdc.RegisterConcrete(MsgCheckpointAck{}, "checkpoint/MsgCheckpointACK", nil)
 	cdc.RegisterConcrete(MsgCheckpointNoAck{}, "checkpoint/MsgCheckpointNoACK", nil)
+	cdc.RegisterConcrete(MsgWithdrawFeeReward{}, "checkpoint/MsgWithdrawFeeReward", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
@@ -5,6 +5,8 @@ var (
 	EventTypeCheckpoint      = "checkpoint"
 	EventTypeCheckpointAck   = "checkpoint-ack"
 	EventTypeCheckpointNoAck = "checkpoint-noack"
+	EventTypeFeeReward       = "fee-reward"
+	EventTypeWithdrawReward  = "withdraw-fee-reward"
 
 	AttributeKeyProposer    = "proposer"
 	AttributeKeyStartBlock  = "start-block"
@@ -13,6 +15,8 @@ var (
 	AttributeKeyNewProposer = "new-proposer"
 	AttributeKeyRootHash    = "root-hash"
 	AttributeKeyAccountHash = "account-hash"
+	AttributeKeyValidatorID = "validator-id"
+	AttributeKeyAmount      = "amount"
 
 	AttributeValueCategory = ModuleName
 )
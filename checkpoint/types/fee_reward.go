@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// FeeReward is the withdrawable fee reward of a validator earned by signing checkpoints
+type FeeReward struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	Amount      sdk.Coins           `json:"amount" yaml:"amount"`
+}
+
+// NewFeeReward creates a new fee reward
+func NewFeeReward(validatorID hmTypes.ValidatorID, amount sdk.Coins) FeeReward {
+	return FeeReward{
+		ValidatorID: validatorID,
+		Amount:      amount,
+	}
+}
+
+// String implements the Stringer interface.
+func (r FeeReward) String() string {
+	return fmt.Sprintf(`FeeReward:
+  ValidatorID: %d
+  Amount:      %s
+`, r.ValidatorID, r.Amount)
+}
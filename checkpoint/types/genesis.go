@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -16,6 +18,8 @@ type GenesisState struct {
 	LastNoACK          uint64               `json:"last_no_ack" yaml:"last_no_ack"`
 	AckCount           uint64               `json:"ack_count" yaml:"ack_count"`
 	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
+	UndistributedFees  sdk.Coins            `json:"undistributed_fees" yaml:"undistributed_fees"`
+	FeeRewards         []FeeReward          `json:"fee_rewards" yaml:"fee_rewards"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -25,6 +29,8 @@ func NewGenesisState(
 	lastNoACK uint64,
 	ackCount uint64,
 	checkpoints []hmTypes.Checkpoint,
+	undistributedFees sdk.Coins,
+	feeRewards []FeeReward,
 ) GenesisState {
 	return GenesisState{
 		Params:             params,
@@ -32,6 +38,8 @@ func NewGenesisState(
 		LastNoACK:          lastNoACK,
 		AckCount:           ackCount,
 		Checkpoints:        checkpoints,
+		UndistributedFees:  undistributedFees,
+		FeeRewards:         feeRewards,
 	}
 }
 
@@ -55,6 +63,22 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	if !data.UndistributedFees.IsValid() {
+		return errors.New("Invalid undistributed fees")
+	}
+
+	seen := make(map[hmTypes.ValidatorID]bool)
+	for _, reward := range data.FeeRewards {
+		if seen[reward.ValidatorID] {
+			return errors.New("Duplicate fee reward for validator " + reward.ValidatorID.String())
+		}
+		seen[reward.ValidatorID] = true
+
+		if !reward.Amount.IsValid() {
+			return errors.New("Invalid fee reward for validator " + reward.ValidatorID.String())
+		}
+	}
+
 	return nil
 }
 
@@ -16,3 +16,8 @@ const (
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
 )
+
+const (
+	// FeeRewardPoolName is the module account holding fees to be distributed to checkpoint signers
+	FeeRewardPoolName = "fee_rewards"
+)
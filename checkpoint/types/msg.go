@@ -237,3 +237,48 @@ func (msg MsgCheckpointNoAck) ValidateBasic() sdk.Error {
 
 	return nil
 }
+
+//
+// Msg Withdraw Fee Reward
+//
+
+var _ sdk.Msg = &MsgWithdrawFeeReward{}
+
+// MsgWithdrawFeeReward withdraws fee reward of validator to its signer
+type MsgWithdrawFeeReward struct {
+	From types.HeimdallAddress `json:"from"`
+}
+
+func NewMsgWithdrawFeeReward(from types.HeimdallAddress) MsgWithdrawFeeReward {
+	return MsgWithdrawFeeReward{
+		From: from,
+	}
+}
+
+func (msg MsgWithdrawFeeReward) Type() string {
+	return "withdraw-fee-reward"
+}
+
+func (msg MsgWithdrawFeeReward) Route() string {
+	return RouterKey
+}
+
+func (msg MsgWithdrawFeeReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgWithdrawFeeReward) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgWithdrawFeeReward) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	return nil
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
 	QueryParams           = "params"
@@ -11,6 +15,8 @@ const (
 	QueryNextCheckpoint   = "next-checkpoint"
 	QueryProposer         = "is-proposer"
 	QueryCurrentProposer  = "current-proposer"
+	QueryFeeReward        = "fee-reward"
+	QueryFeeRewards       = "fee-rewards"
 	StakingQuerierRoute   = "staking"
 )
 
@@ -33,3 +39,13 @@ type QueryBorChainID struct {
 func NewQueryBorChainID(chainID string) QueryBorChainID {
 	return QueryBorChainID{BorChainID: chainID}
 }
+
+// QueryFeeRewardParams defines the params for querying fee reward of a validator
+type QueryFeeRewardParams struct {
+	ValidatorID hmTypes.ValidatorID
+}
+
+// NewQueryFeeRewardParams creates a new instance of QueryFeeRewardParams.
+func NewQueryFeeRewardParams(validatorID hmTypes.ValidatorID) QueryFeeRewardParams {
+	return QueryFeeRewardParams{ValidatorID: validatorID}
+}
@@ -9,4 +9,7 @@ const (
 	FlagRecordID        = "id"
 	FlagData            = "data"
 	FlagBorChainId      = "bor-chain-id"
+	FlagStateID         = "state-id"
+	FlagPage            = "page"
+	FlagLimit           = "limit"
 )
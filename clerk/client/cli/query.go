@@ -14,6 +14,7 @@ import (
 
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 var logger = helper.Logger.With("module", "clerk/client/cli")
@@ -33,6 +34,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	queryCmds.AddCommand(
 		client.GetCommands(
 			GetStateRecord(cdc),
+			GetStateRecordListWithContract(cdc),
+			GetLastSyncedStateID(cdc),
 		)...,
 	)
 
@@ -90,3 +93,68 @@ func GetStateRecord(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetStateRecordListWithContract get state records sent to receiver contract
+func GetStateRecordListWithContract(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-list",
+		Short: "show state records sent to receiver contract",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract := hmTypes.HexToHeimdallAddress(viper.GetString(FlagContractAddress))
+			if contract.Empty() {
+				return fmt.Errorf("contract address cannot be empty")
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(clerkTypes.NewQueryRecordContractPaginationParams(contract, viper.GetUint64(FlagPage), viper.GetUint64(FlagLimit)))
+			if err != nil {
+				return err
+			}
+
+			// fetch state records
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", clerkTypes.QuerierRoute, clerkTypes.QueryRecordListContract),
+				queryParams,
+			)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagContractAddress, "", "--contract=<receiver contract address>")
+	cmd.Flags().Uint64(FlagPage, 1, "--page=<page>")
+	cmd.Flags().Uint64(FlagLimit, 50, "--limit=<limit>")
+
+	if err := cmd.MarkFlagRequired(FlagContractAddress); err != nil {
+		logger.Error("GetStateRecordListWithContract | MarkFlagRequired | FlagContractAddress", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetLastSyncedStateID get last state id confirmed by bor's state receiver
+func GetLastSyncedStateID(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-synced-state-id",
+		Short: "show last state id confirmed by bor state receiver",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", clerkTypes.QuerierRoute, clerkTypes.QueryLastSyncedStateID), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -30,6 +30,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		client.PostCommands(
 			CreateNewStateRecord(cdc),
+			ConfirmStateSync(cdc),
 		)...,
 	)
 	return txCmd
@@ -148,3 +149,44 @@ func CreateNewStateRecord(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// ConfirmStateSync send confirmation of state ids delivered to bor
+func ConfirmStateSync(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-state-sync",
+		Short: "confirm state ids committed by bor state receiver",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// bor chain id
+			borChainID := viper.GetString(FlagBorChainId)
+			if borChainID == "" {
+				return fmt.Errorf("BorChainID cannot be empty")
+			}
+
+			stateID := viper.GetUint64(FlagStateID)
+			if stateID == 0 {
+				return fmt.Errorf("state id cannot be empty")
+			}
+
+			msg := clerkTypes.NewMsgConfirmStateSync(
+				helper.GetFromAddress(cliCtx),
+				stateID,
+				borChainID,
+			)
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Uint64(FlagStateID, 0, "--state-id=<state-id>")
+	cmd.Flags().String(FlagBorChainId, "", "--bor-chain-id=<bor-chain-id>")
+
+	if err := cmd.MarkFlagRequired(FlagStateID); err != nil {
+		logger.Error("ConfirmStateSync | MarkFlagRequired | FlagStateID", "Error", err)
+	}
+	if err := cmd.MarkFlagRequired(FlagBorChainId); err != nil {
+		logger.Error("ConfirmStateSync | MarkFlagRequired | FlagBorChainId", "Error", err)
+	}
+
+	return cmd
+}
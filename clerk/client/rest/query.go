@@ -28,6 +28,14 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/clerk/isoldtx",
 		DepositTxStatusHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/clerk/last-synced-state-id",
+		lastSyncedStateIDHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clerk/params",
+		paramsHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // recordHandlerFn returns record by record id
@@ -130,6 +138,9 @@ func recordListHandlerFn(
 
 			// get result by till time-range query
 			res, err = tillTimeRangeQuery(cliCtx, fromID, toTime, limit)
+		} else if vars.Get("contract") != "" {
+			// get result by receiver contract query
+			res, err = contractQuery(cliCtx, hmTypes.HexToHeimdallAddress(vars.Get("contract")), page, limit)
 		} else {
 			// get result by range query
 			res, err = rangeQuery(cliCtx, page, limit)
@@ -236,6 +247,23 @@ func timeRangeQuery(cliCtx context.CLIContext, fromTime int64, toTime int64, pag
 	return res, nil
 }
 
+func contractQuery(cliCtx context.CLIContext, contract hmTypes.HeimdallAddress, page uint64, limit uint64) ([]byte, error) {
+	// get query params
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryRecordContractPaginationParams(contract, page, limit))
+	if err != nil {
+		return nil, err
+	}
+
+	// query records
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRecordListContract), queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// return result
+	return res, nil
+}
+
 func rangeQuery(cliCtx context.CLIContext, page uint64, limit uint64) ([]byte, error) {
 	// get query params
 	queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit))
@@ -321,3 +349,41 @@ func tillTimeRangeQuery(cliCtx context.CLIContext, fromID uint64, toTime int64,
 	// return result in json
 	return json.Marshal(result)
 }
+
+// lastSyncedStateIDHandlerFn returns last state id confirmed by bor's state receiver
+func lastSyncedStateIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastSyncedStateID), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the clerk params values
+func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
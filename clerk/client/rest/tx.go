@@ -18,6 +18,18 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/clerk/records",
 		newEventRecordHandler(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/clerk/confirm-state-sync",
+		confirmStateSyncHandler(cliCtx),
+	).Methods("POST")
+}
+
+// ConfirmStateSyncReq confirm state sync request object
+type ConfirmStateSyncReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	StateID    uint64 `json:"state_id"`
+	BorChainID string `json:"bor_chain_id"`
 }
 
 // AddRecordReq add validator request object
@@ -65,3 +77,28 @@ func newEventRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func confirmStateSyncHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// read req from request
+		var req ConfirmStateSyncReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create confirm state sync msg
+		msg := clerkTypes.NewMsgConfirmStateSync(
+			types.HexToHeimdallAddress(req.BaseReq.From),
+			req.StateID,
+			req.BorChainID,
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
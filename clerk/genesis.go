@@ -8,6 +8,8 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
 	// add checkpoint headers
 	if len(data.EventRecords) != 0 {
 		for _, record := range data.EventRecords {
@@ -21,9 +23,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		keeper.SetRecordSequence(ctx, sequence)
 	}
 
+	keeper.SetLastSyncedStateID(ctx, data.LastSyncedStateID)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	return types.NewGenesisState(keeper.GetAllEventRecords(ctx), keeper.GetRecordSequences(ctx))
+	return types.NewGenesisState(
+		keeper.GetParams(ctx),
+		keeper.GetAllEventRecords(ctx),
+		keeper.GetRecordSequences(ctx),
+		keeper.GetLastSyncedStateID(ctx),
+	)
 }
@@ -21,6 +21,8 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgEventRecord:
 			return handleMsgEventRecord(ctx, msg, k, contractCaller)
+		case types.MsgConfirmStateSync:
+			return handleMsgConfirmStateSync(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in clerk module").Result()
 		}
@@ -38,8 +40,8 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, c
 		"blockNumber", msg.BlockNumber,
 	)
 
-	// check if event record exists
-	if exists := k.HasEventRecord(ctx, msg.ID); exists {
+	// check if event record exists or is already delivered and pruned
+	if exists := k.HasEventRecord(ctx, msg.ID); exists || msg.ID <= k.GetLastSyncedStateID(ctx) {
 		return types.ErrEventRecordAlreadySynced(k.Codespace()).Result()
 	}
 
@@ -80,3 +82,38 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, c
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+func handleMsgConfirmStateSync(ctx sdk.Context, msg types.MsgConfirmStateSync, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating confirm state sync msg",
+		"stateId", msg.StateID,
+	)
+
+	// chainManager params
+	params := k.chainKeeper.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	// check chain id
+	if chainParams.BorChainID != msg.ChainID {
+		k.Logger(ctx).Error("Invalid Bor chain id", "msgChainID", msg.ChainID)
+		return common.ErrInvalidBorChainID(k.Codespace()).Result()
+	}
+
+	// only newer state ids known to heimdall can be confirmed
+	if msg.StateID <= k.GetLastSyncedStateID(ctx) || !k.HasEventRecord(ctx, msg.StateID) {
+		k.Logger(ctx).Error("Invalid state id to confirm", "stateId", msg.StateID, "lastSyncedStateId", k.GetLastSyncedStateID(ctx))
+		return types.ErrEventRecordInvalid(k.Codespace()).Result()
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConfirmStateSync,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyStateID, strconv.FormatUint(msg.StateID, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -27,7 +27,7 @@ func setupClerkGenesis() *app.HeimdallApp {
 	// initialize the chain with the default genesis state
 	genesisState := app.NewDefaultGenesisState()
 
-	clerkGenesis := types.NewGenesisState(types.DefaultGenesisState().EventRecords, types.DefaultGenesisState().RecordSequences)
+	clerkGenesis := types.NewGenesisState(types.DefaultParams(), types.DefaultGenesisState().EventRecords, types.DefaultGenesisState().RecordSequences, 0)
 	genesisState[types.ModuleName] = happ.Codec().MustMarshalJSON(clerkGenesis)
 
 	stateBytes, err := codec.MarshalJSONIndent(happ.Codec(), genesisState)
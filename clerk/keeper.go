@@ -25,8 +25,14 @@ var (
 	RecordSequencePrefixKey = []byte{0x12}
 
 	StateRecordPrefixKeyWithTime = []byte{0x13} // prefix key for when storing state with time
+
+	StateRecordPrefixKeyWithContract = []byte{0x14} // prefix key for when storing state with receiver contract
+	LastSyncedStateIDKey             = []byte{0x15} // key to store last state id confirmed by bor's state receiver
 )
 
+// MaxRecordsPrunedPerBlock limits number of records pruned in a block
+const MaxRecordsPrunedPerBlock = 100
+
 // Keeper stores all related data
 type Keeper struct {
 	cdc *codec.Codec
@@ -38,6 +44,8 @@ type Keeper struct {
 	paramSpace subspace.Subspace
 	// chain param keeper
 	chainKeeper chainmanager.Keeper
+	// upgrade keeper
+	upgradeKeeper hmTypes.UpgradeKeeper
 }
 
 // NewKeeper create new keeper
@@ -47,13 +55,15 @@ func NewKeeper(
 	paramSpace subspace.Subspace,
 	codespace sdk.CodespaceType,
 	chainKeeper chainmanager.Keeper,
+	upgradeKeeper hmTypes.UpgradeKeeper,
 ) Keeper {
 	keeper := Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		paramSpace:  paramSpace,
-		codespace:   codespace,
-		chainKeeper: chainKeeper,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSpace:    paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:     codespace,
+		chainKeeper:   chainKeeper,
+		upgradeKeeper: upgradeKeeper,
 	}
 	return keeper
 }
@@ -83,6 +93,21 @@ func (k *Keeper) SetEventRecordWithTime(ctx sdk.Context, record types.EventRecor
 	return nil
 }
 
+// SetEventRecordWithContract sets event record id with receiver contract
+func (k *Keeper) SetEventRecordWithContract(ctx sdk.Context, record types.EventRecord) error {
+	key := GetEventRecordKeyWithContract(record.Contract, record.ID)
+	value, err := k.cdc.MarshalBinaryBare(record.ID)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling record", "error", err)
+		return err
+	}
+
+	if err := k.setEventRecordStore(ctx, key, value); err != nil {
+		return err
+	}
+	return nil
+}
+
 // SetEventRecordWithID adds record to store with ID
 func (k *Keeper) SetEventRecordWithID(ctx sdk.Context, record types.EventRecord) error {
 	key := GetEventRecordKey(record.ID)
@@ -121,9 +146,23 @@ func (k *Keeper) SetEventRecord(ctx sdk.Context, record types.EventRecord) error
 	if err := k.SetEventRecordWithTime(ctx, record); err != nil {
 		return err
 	}
+	// receiver contract index is backfilled for older records on upgrade
+	if k.upgradeKeeper.IsUpgradeDone(ctx, hmTypes.UpgradeV03) {
+		if err := k.SetEventRecordWithContract(ctx, record); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// DeleteEventRecord deletes record and its indexes from store
+func (k *Keeper) DeleteEventRecord(ctx sdk.Context, record types.EventRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetEventRecordKey(record.ID))
+	store.Delete(GetEventRecordKeyWithTime(record.ID, record.RecordTime))
+	store.Delete(GetEventRecordKeyWithContract(record.Contract, record.ID))
+}
+
 // GetEventRecord returns record from store
 func (k *Keeper) GetEventRecord(ctx sdk.Context, stateID uint64) (*types.EventRecord, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -226,6 +265,132 @@ func (k *Keeper) GetEventRecordListWithTime(ctx sdk.Context, fromTime, toTime ti
 	return records, nil
 }
 
+// GetEventRecordListWithContract returns records sent to receiver contract with params like page and limit
+func (k *Keeper) GetEventRecordListWithContract(ctx sdk.Context, contract hmTypes.HeimdallAddress, page, limit uint64) ([]types.EventRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// create records
+	var records []types.EventRecord
+
+	// have max limit
+	if limit > 50 {
+		limit = 50
+	}
+
+	// get paginated iterator
+	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, GetEventRecordKeyWithContractPrefix(contract), uint(page), uint(limit))
+	defer iterator.Close()
+
+	// loop through records to get valid records
+	for ; iterator.Valid(); iterator.Next() {
+		var stateID uint64
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &stateID); err == nil {
+			record, err := k.GetEventRecord(ctx, stateID)
+			if err != nil {
+				k.Logger(ctx).Error("GetEventRecordListWithContract | GetEventRecord", "error", err)
+				continue
+			}
+			records = append(records, *record)
+		}
+	}
+
+	return records, nil
+}
+
+//
+// Pruning
+//
+
+// SetLastSyncedStateID sets last state id confirmed by bor's state receiver
+func (k *Keeper) SetLastSyncedStateID(ctx sdk.Context, stateID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(LastSyncedStateIDKey, []byte(strconv.FormatUint(stateID, 10)))
+}
+
+// GetLastSyncedStateID returns last state id confirmed by bor's state receiver
+func (k *Keeper) GetLastSyncedStateID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(LastSyncedStateIDKey) {
+		return 0
+	}
+
+	stateID, err := strconv.ParseUint(string(store.Get(LastSyncedStateIDKey)), 10, 64)
+	if err != nil {
+		k.Logger(ctx).Error("Error parsing last synced state id", "error", err)
+		return 0
+	}
+
+	return stateID
+}
+
+// PruneEventRecords deletes records confirmed by bor's state receiver and older than retention period.
+// Pruning stops at the first record not confirmed yet, so records waiting for bor are not rescanned
+// every block. Record sequences are kept to prevent replay of pruned records. Returns number of pruned records.
+func (k *Keeper) PruneEventRecords(ctx sdk.Context) (pruned int) {
+	if !k.upgradeKeeper.IsUpgradeDone(ctx, hmTypes.UpgradeV03) {
+		return 0
+	}
+
+	params := k.GetParams(ctx)
+	if !params.PruneDeliveredRecords {
+		return 0
+	}
+
+	lastSyncedStateID := k.GetLastSyncedStateID(ctx)
+	if lastSyncedStateID == 0 {
+		return 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cutoff := ctx.BlockTime().Add(-params.RecordRetentionPeriod)
+
+	// iterate records older than cutoff, ordered by time
+	iterator := store.Iterator(StateRecordPrefixKeyWithTime, GetEventRecordKeyWithTimePrefix(cutoff))
+	defer iterator.Close()
+
+	var records []types.EventRecord
+	for ; iterator.Valid() && len(records) < MaxRecordsPrunedPerBlock; iterator.Next() {
+		var stateID uint64
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &stateID); err != nil {
+			continue
+		}
+
+		// bor syncs records in order, later records are not confirmed either
+		if stateID > lastSyncedStateID {
+			break
+		}
+
+		record, err := k.GetEventRecord(ctx, stateID)
+		if err != nil {
+			k.Logger(ctx).Error("PruneEventRecords | GetEventRecord", "error", err)
+			continue
+		}
+		records = append(records, *record)
+	}
+
+	// delete after iteration
+	for _, record := range records {
+		k.DeleteEventRecord(ctx, record)
+	}
+
+	return len(records)
+}
+
+//
+// Params
+//
+
+// SetParams sets the clerk module's parameters.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the clerk module's parameters.
+func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
 //
 // GetEventRecordKey returns key for state record
 //
@@ -248,6 +413,16 @@ func GetEventRecordKeyWithTimePrefix(recordTime time.Time) []byte {
 	return append(StateRecordPrefixKeyWithTime, recordTimeBytes...)
 }
 
+// GetEventRecordKeyWithContract appends prefix to receiver contract and state id
+func GetEventRecordKeyWithContract(contract hmTypes.HeimdallAddress, stateID uint64) []byte {
+	return append(GetEventRecordKeyWithContractPrefix(contract), sdk.Uint64ToBigEndian(stateID)...)
+}
+
+// GetEventRecordKeyWithContractPrefix gives prefix for receiver contract key
+func GetEventRecordKeyWithContractPrefix(contract hmTypes.HeimdallAddress) []byte {
+	return append(StateRecordPrefixKeyWithContract, contract.Bytes()...)
+}
+
 // GetRecordSequenceKey returns record sequence key
 func GetRecordSequenceKey(sequence string) []byte {
 	return append(RecordSequencePrefixKey, []byte(sequence)...)
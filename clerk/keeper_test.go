@@ -154,3 +154,92 @@ func (suite *KeeperTestSuite) TestSetHasGetRecordSequence() {
 	recordSequences := ck.GetRecordSequences(ctx)
 	require.Len(t, recordSequences, 1)
 }
+
+func (suite *KeeperTestSuite) TestGetEventRecordListWithContract() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	ck := app.ClerkKeeper
+	hHash := hmTypes.BytesToHeimdallHash([]byte("some-hash"))
+	contract1 := hmTypes.BytesToHeimdallAddress([]byte("contract-1"))
+	contract2 := hmTypes.BytesToHeimdallAddress([]byte("contract-2"))
+
+	var i uint64
+	for i = 1; i <= 6; i++ {
+		contract := contract1
+		if i%3 == 0 {
+			contract = contract2
+		}
+		testRecord := types.NewEventRecord(hHash, i, i, contract, make([]byte, 0), "1", time.Now())
+		err := ck.SetEventRecord(ctx, testRecord)
+		require.NoError(t, err)
+	}
+
+	recordList, err := ck.GetEventRecordListWithContract(ctx, contract1, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, recordList, 4)
+	for _, record := range recordList {
+		require.Equal(t, contract1, record.Contract)
+	}
+
+	recordList, err = ck.GetEventRecordListWithContract(ctx, contract2, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, recordList, 2)
+
+	recordList, err = ck.GetEventRecordListWithContract(ctx, contract1, 2, 3)
+	require.NoError(t, err)
+	require.Len(t, recordList, 1)
+}
+
+func (suite *KeeperTestSuite) TestPruneEventRecords() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	ck := app.ClerkKeeper
+	params := types.DefaultParams()
+	ck.SetParams(ctx, params)
+
+	hAddr := hmTypes.BytesToHeimdallAddress([]byte("some-address"))
+	hHash := hmTypes.BytesToHeimdallHash([]byte("some-hash"))
+	recordTime := time.Now().UTC()
+
+	var i uint64
+	for i = 1; i <= 4; i++ {
+		testRecord := types.NewEventRecord(hHash, i, i, hAddr, make([]byte, 0), "1", recordTime)
+		err := ck.SetEventRecord(ctx, testRecord)
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithBlockTime(recordTime.Add(params.RecordRetentionPeriod + time.Second))
+	ck.SetLastSyncedStateID(ctx, 2)
+
+	// pruning is disabled by default
+	require.Equal(t, 0, ck.PruneEventRecords(ctx))
+
+	params.PruneDeliveredRecords = true
+	ck.SetParams(ctx, params)
+
+	// only records delivered to bor are pruned
+	require.Equal(t, 2, ck.PruneEventRecords(ctx))
+	require.False(t, ck.HasEventRecord(ctx, 1))
+	require.False(t, ck.HasEventRecord(ctx, 2))
+	require.True(t, ck.HasEventRecord(ctx, 3))
+	require.True(t, ck.HasEventRecord(ctx, 4))
+
+	recordList, err := ck.GetEventRecordListWithContract(ctx, hAddr, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, recordList, 2)
+
+	// records within retention period are kept
+	ck.SetLastSyncedStateID(ctx, 4)
+	require.Equal(t, 0, ck.PruneEventRecords(ctx.WithBlockTime(recordTime)))
+	require.True(t, ck.HasEventRecord(ctx, 3))
+
+	// pruning stops at the first record not delivered to bor
+	testRecord := types.NewEventRecord(hHash, 5, 5, hAddr, make([]byte, 0), "1", recordTime.Add(-time.Second))
+	require.NoError(t, ck.SetEventRecord(ctx, testRecord))
+	require.Equal(t, 0, ck.PruneEventRecords(ctx))
+	require.True(t, ck.HasEventRecord(ctx, 3))
+
+	ck.SetLastSyncedStateID(ctx, 5)
+	require.Equal(t, 3, ck.PruneEventRecords(ctx))
+	require.False(t, ck.HasEventRecord(ctx, 5))
+}
@@ -0,0 +1,39 @@
+package v0_3
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/maticnetwork/heimdall/migrate"
+)
+
+// Params of the clerk module added in v0.3
+type Params struct {
+	PruneDeliveredRecords bool          `json:"prune_delivered_records"`
+	RecordRetentionPeriod time.Duration `json:"record_retention_period"`
+}
+
+// DefaultParams keeps all records, as before
+var DefaultParams = Params{
+	PruneDeliveredRecords: false,
+	RecordRetentionPeriod: 30 * 24 * time.Hour,
+}
+
+// Migrate adds the record pruning params and the last state id synced to bor.
+// The state id bor has synced is not known from the genesis, it is left at 0
+// so no record is treated as delivered until bor's state receiver confirms it.
+func Migrate(oldGenState json.RawMessage) (json.RawMessage, error) {
+	genState, err := migrate.DecodeObject(oldGenState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := genState.SetDefault("params", DefaultParams); err != nil {
+		return nil, err
+	}
+	if err := genState.SetDefault("last_synced_state_id", uint64(0)); err != nil {
+		return nil, err
+	}
+
+	return genState.Marshal()
+}
@@ -2,6 +2,8 @@ package clerk
 
 import (
 	"encoding/json"
+	"math/rand"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,10 +15,12 @@ import (
 
 	clerkCli "github.com/maticnetwork/heimdall/clerk/client/cli"
 	clerkRest "github.com/maticnetwork/heimdall/clerk/client/rest"
+	"github.com/maticnetwork/heimdall/clerk/simulation"
 	"github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmModule "github.com/maticnetwork/heimdall/types/module"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
 )
 
 var (
@@ -143,10 +147,45 @@ func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock returns the end blocker for the auth module. It returns no validator
 // updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	// prune records delivered to bor
+	if pruned := am.keeper.PruneEventRecords(ctx); pruned > 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneRecords,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyPrunedCount, strconv.Itoa(pruned)),
+			),
+		)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
+// GenerateGenesisState creates a randomized GenState of the clerk module
+func (AppModule) GenerateGenesisState(simState *hmModule.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalContents doesn't return any content functions.
+func (AppModule) ProposalContents(simState hmModule.SimulationState) []simTypes.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams creates randomized param changes for the simulator.
+func (AppModule) RandomizedParams(r *rand.Rand) []simTypes.ParamChange {
+	return nil
+}
+
+// RegisterStoreDecoder registers a decoder for clerk module's types
+func (AppModule) RegisterStoreDecoder(sdr hmModule.StoreDecoderRegistry) {
+}
+
+// WeightedOperations returns all the clerk module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState hmModule.SimulationState) []simTypes.WeightedOperation {
+	return WeightedOperations(simState.AppParams, simState.Cdc, am.keeper, simState.SideChannel)
+}
+
 func (am AppModule) NewSideTxHandler() hmTypes.SideTxHandler {
 	return NewSideTxHandler(am.keeper, am.contractCaller)
 }
@@ -0,0 +1,94 @@
+package clerk
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/simulation"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgEventRecord = "op_weight_msg_event_record"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simTypes.AppParams, cdc *codec.Codec, k Keeper, sideChannel *simTypes.SideChannel) simulation.WeightedOperations {
+	var weightMsgEventRecord int
+	appParams.GetOrGenerate(cdc, OpWeightMsgEventRecord, &weightMsgEventRecord, nil,
+		func(_ *rand.Rand) {
+			weightMsgEventRecord = 100
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgEventRecord, SimulateMsgEventRecord(k, sideChannel)),
+	}
+}
+
+// SimulateMsgEventRecord generates a MsgEventRecord for a new state sync,
+// occasionally with an invalid chain id or not matching the state synced event
+// on the main chain.
+func SimulateMsgEventRecord(k Keeper, sideChannel *simTypes.SideChannel) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+
+		from, _ := simTypes.RandomAcc(r, accs)
+		contract, _ := simTypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgEventRecord(
+			from.Address,
+			hmTypes.BytesToHeimdallHash(simTypes.RandBytes(r, 32)),
+			uint64(r.Intn(100)),
+			uint64(simTypes.RandIntBetween(r, 1, 1000000)),
+			k.GetLastSyncedStateID(ctx)+1+uint64(r.Intn(100000)),
+			contract.Address,
+			simTypes.RandBytes(r, simTypes.RandIntBetween(r, 1, 256)),
+			k.chainKeeper.GetParams(ctx).ChainParams.BorChainID,
+		)
+
+		// state synced event as emitted on the main chain
+		receipt := &ethTypes.Receipt{BlockNumber: new(big.Int).SetUint64(msg.BlockNumber)}
+		event := &statesender.StatesenderStateSynced{
+			Id:              new(big.Int).SetUint64(msg.ID),
+			ContractAddress: msg.ContractAddress.EthAddress(),
+			Data:            msg.Data,
+		}
+
+		switch r.Intn(10) {
+		case 0:
+			msg.ChainID = simTypes.RandStringOfLength(r, 5)
+		case 1:
+			receipt.BlockNumber = new(big.Int).SetUint64(msg.BlockNumber + 1)
+		case 2:
+			event.Id = new(big.Int).SetUint64(msg.ID + 1)
+		case 3:
+			event.ContractAddress = common.BytesToAddress(simTypes.RandBytes(r, 20))
+		case 4:
+			event.Data = simTypes.RandBytes(r, 32)
+		}
+
+		contractCaller := &mocks.IContractCaller{}
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything).Return(receipt, nil)
+		contractCaller.On("DecodeStateSyncedEvent", mock.Anything, receipt, msg.LogIndex).Return(event, nil)
+
+		opMsg := simulation.DeliverSideTx(r, sideChannel, ctx, msg,
+			NewHandler(k, contractCaller),
+			NewSideTxHandler(k, contractCaller),
+		)
+
+		return opMsg, nil, nil
+	}
+}
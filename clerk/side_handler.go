@@ -23,6 +23,8 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 		switch msg := msg.(type) {
 		case types.MsgEventRecord:
 			return SideHandleMsgEventRecord(ctx, k, msg, contractCaller)
+		case types.MsgConfirmStateSync:
+			return SideHandleMsgConfirmStateSync(ctx, k, msg, contractCaller)
 		default:
 			return abci.ResponseDeliverSideTx{
 				Code: uint32(sdk.CodeUnknownRequest),
@@ -38,6 +40,8 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 		switch msg := msg.(type) {
 		case types.MsgEventRecord:
 			return PostHandleMsgEventRecord(ctx, k, msg, sideTxResult)
+		case types.MsgConfirmStateSync:
+			return PostHandleMsgConfirmStateSync(ctx, k, msg, sideTxResult)
 		default:
 			return sdk.ErrUnknownRequest("Unknown msg type").Result()
 		}
@@ -111,7 +115,7 @@ func PostHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 	}
 
 	// check for replay
-	if k.HasEventRecord(ctx, msg.ID) {
+	if k.HasEventRecord(ctx, msg.ID) || msg.ID <= k.GetLastSyncedStateID(ctx) {
 		k.Logger(ctx).Debug("Skipping new clerk record as it's already processed")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -165,3 +169,65 @@ func PostHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// SideHandleMsgConfirmStateSync validates state id is committed by bor's state receiver
+func SideHandleMsgConfirmStateSync(ctx sdk.Context, k Keeper, msg types.MsgConfirmStateSync, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for confirm state sync msg",
+		"stateId", msg.StateID,
+	)
+
+	// chainManager params
+	params := k.chainKeeper.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	synced, err := contractCaller.IsStateSynced(chainParams.StateReceiverAddress.EthAddress(), new(big.Int).SetUint64(msg.StateID))
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching state from state receiver", "stateId", msg.StateID, "error", err)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if !synced {
+		k.Logger(ctx).Error("State is not synced on bor yet", "stateId", msg.StateID)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// PostHandleMsgConfirmStateSync records last state id delivered to bor
+func PostHandleMsgConfirmStateSync(ctx sdk.Context, k Keeper, msg types.MsgConfirmStateSync, sideTxResult abci.SideTxResultType) sdk.Result {
+	// Skip handler if confirmation is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping state sync confirmation since side-tx didn't get yes votes")
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// check for replay
+	if msg.StateID <= k.GetLastSyncedStateID(ctx) {
+		k.Logger(ctx).Debug("Skipping state sync confirmation as it's already processed")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	k.SetLastSyncedStateID(ctx, msg.StateID)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConfirmStateSync,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+			sdk.NewAttribute(types.AttributeKeyStateID, strconv.FormatUint(msg.StateID, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -267,3 +267,52 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgEventRecord() {
 		require.Equal(t, common.CodeOldTx, result.Code)
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgConfirmStateSync() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	chainParams := app.ChainKeeper.GetParams(suite.ctx)
+
+	_, _, addr1 := sdkAuth.KeyTestPubAddr()
+
+	msg := types.NewMsgConfirmStateSync(hmTypes.BytesToHeimdallAddress(addr1.Bytes()), 10, suite.chainID)
+
+	t.Run("Success", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("IsStateSynced", chainParams.ChainParams.StateReceiverAddress.EthAddress(), big.NewInt(10)).Return(true, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should succeed")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	t.Run("NotSynced", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("IsStateSynced", chainParams.ChainParams.StateReceiverAddress.EthAddress(), big.NewInt(10)).Return(false, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgConfirmStateSync() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	_, _, addr1 := sdkAuth.KeyTestPubAddr()
+
+	msg := types.NewMsgConfirmStateSync(hmTypes.BytesToHeimdallAddress(addr1.Bytes()), 10, suite.chainID)
+
+	t.Run("NoResult", func(t *testing.T) {
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_No)
+		require.False(t, result.IsOK(), "Post handler should fail")
+		require.Equal(t, common.CodeSideTxValidationFailed, result.Code)
+		require.Equal(t, uint64(0), app.ClerkKeeper.GetLastSyncedStateID(ctx))
+	})
+
+	t.Run("YesResult", func(t *testing.T) {
+		result := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "Post handler should succeed")
+		require.Greater(t, len(result.Events), 0, "Events should be emitted for successful post-tx")
+		require.Equal(t, uint64(10), app.ClerkKeeper.GetLastSyncedStateID(ctx))
+	})
+}
@@ -0,0 +1,20 @@
+package simulation
+
+import (
+	"github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// RandomizedGenState return dummy genesis
+func RandomizedGenState(simState *module.SimulationState) {
+	lastSyncedStateID := uint64(simulation.RandIntBetween(simState.Rand, 0, 1000))
+
+	genesisState := types.NewGenesisState(
+		types.DefaultParams(),
+		make([]*types.EventRecord, 0),
+		nil,
+		lastSyncedStateID,
+	)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
+}
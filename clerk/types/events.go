@@ -1,13 +1,17 @@
 package types
 
 var (
-	EventTypeRecord = "record"
+	EventTypeRecord           = "record"
+	EventTypeConfirmStateSync = "confirm-state-sync"
+	EventTypePruneRecords     = "prune-records"
 
 	AttributeKeyRecordTxHash     = "record-tx-hash"
 	AttributeKeyRecordTxLogIndex = "record-tx-log-index"
 	AttributeKeyRecordID         = "record-id"
 	AttributeKeyRecordContract   = "record-contract"
 	AttributeKeyCreatedAt        = "created-at"
+	AttributeKeyStateID          = "state-id"
+	AttributeKeyPrunedCount      = "pruned-count"
 
 	AttributeValueCategory = ModuleName
 )
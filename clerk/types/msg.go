@@ -86,3 +86,59 @@ func (msg MsgEventRecord) GetLogIndex() uint64 {
 func (msg MsgEventRecord) GetSideSignBytes() []byte {
 	return nil
 }
+
+// MsgConfirmStateSync - confirms state ids delivered to bor's state receiver
+type MsgConfirmStateSync struct {
+	From    types.HeimdallAddress `json:"from"`
+	StateID uint64                `json:"state_id"`
+	ChainID string                `json:"bor_chain_id"`
+}
+
+var _ sdk.Msg = MsgConfirmStateSync{}
+
+// NewMsgConfirmStateSync - construct confirm state sync msg
+func NewMsgConfirmStateSync(from types.HeimdallAddress, stateID uint64, chainID string) MsgConfirmStateSync {
+	return MsgConfirmStateSync{
+		From:    from,
+		StateID: stateID,
+		ChainID: chainID,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgConfirmStateSync) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgConfirmStateSync) Type() string { return "confirm-state-sync" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgConfirmStateSync) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+
+	if msg.StateID == 0 {
+		return sdk.ErrUnknownRequest("missing state id")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgConfirmStateSync) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgConfirmStateSync) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgConfirmStateSync) GetSideSignBytes() []byte {
+	return nil
+}
+
+// RequiredUpgrade returns the software upgrade adding state sync confirmations
+func (msg MsgConfirmStateSync) RequiredUpgrade() string {
+	return types.UpgradeV03
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+)
+
+// Default parameter values
+const (
+	DefaultPruneDeliveredRecords               = false
+	DefaultRecordRetentionPeriod time.Duration = 30 * 24 * time.Hour // 30 days
+)
+
+// Parameter keys
+var (
+	KeyPruneDeliveredRecords = []byte("PruneDeliveredRecords")
+	KeyRecordRetentionPeriod = []byte("RecordRetentionPeriod")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the clerk module.
+type Params struct {
+	PruneDeliveredRecords bool          `json:"prune_delivered_records" yaml:"prune_delivered_records"` // prune records confirmed by bor's state receiver
+	RecordRetentionPeriod time.Duration `json:"record_retention_period" yaml:"record_retention_period"` // time records are kept before pruning
+}
+
+// NewParams creates a new Params object
+func NewParams(pruneDeliveredRecords bool, recordRetentionPeriod time.Duration) Params {
+	return Params{
+		PruneDeliveredRecords: pruneDeliveredRecords,
+		RecordRetentionPeriod: recordRetentionPeriod,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of clerk module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyPruneDeliveredRecords, &p.PruneDeliveredRecords},
+		{KeyRecordRetentionPeriod, &p.RecordRetentionPeriod},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("PruneDeliveredRecords: %t\n", p.PruneDeliveredRecords))
+	sb.WriteString(fmt.Sprintf("RecordRetentionPeriod: %s\n", p.RecordRetentionPeriod))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateRecordRetentionPeriod(p.RecordRetentionPeriod); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//
+// Extra functions
+//
+
+// ParamKeyTable for clerk module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		PruneDeliveredRecords: DefaultPruneDeliveredRecords,
+		RecordRetentionPeriod: DefaultRecordRetentionPeriod,
+	}
+}
+
+func validateRecordRetentionPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("invalid record retention period: %s", v)
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"time"
+
+	"github.com/maticnetwork/heimdall/types"
 )
 
 // query endpoints supported by the auth Querier
@@ -10,6 +12,9 @@ const (
 	QueryRecordList         = "record-list"
 	QueryRecordListWithTime = "record-list-time"
 	QueryRecordSequence     = "record-sequence"
+	QueryRecordListContract = "record-list-contract"
+	QueryLastSyncedStateID  = "last-synced-state-id"
+	QueryParams             = "params"
 )
 
 // QueryRecordParams defines the params for querying accounts.
@@ -45,3 +50,15 @@ func NewQueryRecordSequenceParams(txHash string, logIndex uint64) QueryRecordSeq
 func NewQueryTimeRangePaginationParams(fromTime, toTime time.Time, page, limit uint64) QueryRecordTimePaginationParams {
 	return QueryRecordTimePaginationParams{FromTime: fromTime, ToTime: toTime, Page: page, Limit: limit}
 }
+
+// QueryRecordContractPaginationParams defines the params for querying records by receiver contract.
+type QueryRecordContractPaginationParams struct {
+	Contract types.HeimdallAddress
+	Page     uint64
+	Limit    uint64
+}
+
+// NewQueryRecordContractPaginationParams creates a new instance of QueryRecordContractPaginationParams.
+func NewQueryRecordContractPaginationParams(contract types.HeimdallAddress, page, limit uint64) QueryRecordContractPaginationParams {
+	return QueryRecordContractPaginationParams{Contract: contract, Page: page, Limit: limit}
+}
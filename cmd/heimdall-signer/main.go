@@ -0,0 +1,116 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/signer"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	flagListenAddr       = "laddr"
+	flagTLSCert          = "tls-cert"
+	flagTLSKey           = "tls-key"
+	flagTLSCA            = "tls-ca"
+	flagAllowedMsgs      = "allowed-msgs"
+	flagAllowedContracts = "allowed-contracts"
+	flagRateLimit        = "rate-limit"
+)
+
+var logger = helper.Logger.With("module", "cmd/heimdall-signer")
+
+// rootCmd represents the signer command
+var rootCmd = &cobra.Command{
+	Use:   "heimdall-signer",
+	Short: "Heimdall remote signer holding validator key",
+	Long: `Heimdall remote signer holds the validator key and signs heimdall and mainchain txs
+for heimdallcli and the bridge, as allowed by its policy.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		home := viper.GetString(helper.HomeFlag)
+
+		// load validator key
+		localSigner, err := helper.NewLocalSignerFromFile(filepath.Join(home, "config", "priv_validator_key.json"))
+		if err != nil {
+			return err
+		}
+
+		allowedContracts, err := signer.ParseAllowedContracts(viper.GetStringSlice(flagAllowedContracts))
+		if err != nil {
+			return err
+		}
+
+		policy := signer.Policy{
+			AllowedMsgs:      viper.GetStringSlice(flagAllowedMsgs),
+			AllowedContracts: allowedContracts,
+			RateLimit:        viper.GetInt(flagRateLimit),
+		}
+
+		var tlsConfig *tls.Config
+		if viper.GetString(flagTLSCert) != "" {
+			tlsConfig, err = helper.NewSignerTLSConfig(viper.GetString(flagTLSCert), viper.GetString(flagTLSKey), viper.GetString(flagTLSCA), true)
+			if err != nil {
+				return err
+			}
+		}
+
+		laddr := viper.GetString(flagListenAddr)
+		if laddr == "" {
+			laddr = "unix://" + filepath.Join(home, "signer.sock")
+		}
+
+		listener, err := signer.Listen(laddr, tlsConfig)
+		if err != nil {
+			return err
+		}
+
+		// close listener on exit
+		go func() {
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			<-sigs
+			listener.Close()
+		}()
+
+		logger.Info("Starting signer", "address", laddr, "signer", hmTypes.BytesToHeimdallAddress(localSigner.PubKey().Address().Bytes()).String())
+		service := signer.NewService(app.MakeCodec(), localSigner, policy, logger)
+		if err := signer.Serve(listener, service); err != nil {
+			logger.Info("Stopped signer", "reason", err)
+		}
+
+		return nil
+	},
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.Flags().String(helper.HomeFlag, helper.DefaultNodeHome, "directory for config and data")
+	rootCmd.Flags().String(flagListenAddr, "", "Listen address, unix://<path> or tcp://<host:port> (default unix://<home>/signer.sock)")
+	rootCmd.Flags().String(flagTLSCert, "", "TLS certificate, required for tcp listener")
+	rootCmd.Flags().String(flagTLSKey, "", "TLS certificate key")
+	rootCmd.Flags().String(flagTLSCA, "", "CA certificate to verify clients")
+	rootCmd.Flags().StringSlice(flagAllowedMsgs, nil, "Allowed heimdall msgs as route or route/type (default all)")
+	rootCmd.Flags().StringSlice(flagAllowedContracts, nil, "Allowed mainchain contracts (default all)")
+	rootCmd.Flags().Int(flagRateLimit, 0, "Max signatures per minute (default no limit)")
+
+	// bind all flags with viper
+	if err := viper.BindPFlags(rootCmd.Flags()); err != nil {
+		logger.Error("init | BindPFlag | rootCmd.Flags", "Error", err)
+	}
+}
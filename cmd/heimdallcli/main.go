@@ -37,6 +37,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/app"
 	authCli "github.com/maticnetwork/heimdall/auth/client/cli"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	hmTxCli "github.com/maticnetwork/heimdall/client/tx"
 	"github.com/maticnetwork/heimdall/helper"
 )
@@ -89,6 +90,9 @@ func main() {
 	// chain id
 	rootCmd.PersistentFlags().String(client.FlagChainID, "", "Chain ID of tendermint node")
 
+	// fee granter paying tx fees from its fee allowance
+	rootCmd.PersistentFlags().String(authTypes.FlagFeeGranter, "", "Address of account paying tx fees from its fee allowance")
+
 	// add query/post commands (custom to binary)
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
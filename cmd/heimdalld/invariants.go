@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/app"
+)
+
+const flagDataDir = "data-dir"
+
+// checkInvariantsCmd runs all module invariants against the state held in a data dir
+func checkInvariantsCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Check module invariants against the last committed state",
+		Long: `Check all registered module invariants against the last committed application state.
+The node must be stopped as the application database is opened directly.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dataDir := appDataDir(viper.GetString(flagDataDir))
+
+			// do not create an empty application db on wrong data dir
+			if _, err := os.Stat(filepath.Join(dataDir, "application.db")); err != nil {
+				return fmt.Errorf("application db not found in %s: %v", dataDir, err)
+			}
+
+			db, err := sdk.NewLevelDB("application", dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			hApp := app.NewHeimdallApp(ctx.Logger, db)
+			broken := hApp.CheckInvariantsAtLatestHeight()
+			if len(broken) > 0 {
+				return fmt.Errorf("%d invariant(s) broken at height %d:\n%s",
+					len(broken), hApp.LastBlockHeight(), strings.Join(broken, "\n"))
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "All invariants hold at height %d\n", hApp.LastBlockHeight())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDataDir, "", "Data directory holding application db (default <home>/data)")
+	if err := viper.BindPFlag(flagDataDir, cmd.Flags().Lookup(flagDataDir)); err != nil {
+		logger.Error("checkInvariantsCmd | BindPFlag | flagDataDir", "Error", err)
+	}
+
+	return cmd
+}
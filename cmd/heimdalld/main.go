@@ -104,6 +104,10 @@ func main() {
 	rootCmd.AddCommand(VerifyGenesis(ctx, cdc))
 	rootCmd.AddCommand(initCmd(ctx, cdc))
 	rootCmd.AddCommand(testnetCmd(ctx, cdc))
+	rootCmd.AddCommand(checkInvariantsCmd(ctx))
+	rootCmd.AddCommand(snapshotCmd(ctx))
+	rootCmd.AddCommand(rollbackCmd(ctx))
+	rootCmd.AddCommand(migrateGenesisCmd(ctx, cdc))
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "HD", os.ExpandEnv("$HOME/.heimdalld"))
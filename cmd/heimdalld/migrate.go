@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/migrate"
+	v03 "github.com/maticnetwork/heimdall/migrate/v0_3"
+)
+
+const flagGenesisTime = "genesis-time"
+
+// migrationMap holds the genesis migration of each target version
+var migrationMap = migrate.MigrationMap{
+	"v0.3": v03.Migrate,
+}
+
+// migrateGenesisCmd converts an exported genesis to the schema of a later version
+func migrateGenesisCmd(_ *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [target-version] [genesis-file]",
+		Short: "Migrate genesis to a specified target version",
+		Long: fmt.Sprintf(`Migrate the source genesis into the target version and print to STDOUT.
+Supported target versions: %s
+
+Example:
+$ heimdalld migrate v0.3 /path/to/genesis.json --chain-id=heimdall-15001 --genesis-time=2020-05-01T00:00:00Z
+`, strings.Join(migrationVersions(), ", ")),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target := args[0]
+			migration := migrationMap[target]
+			if migration == nil {
+				return fmt.Errorf("unknown migration target version %s, supported: %s",
+					target, strings.Join(migrationVersions(), ", "))
+			}
+
+			genDoc, err := tmTypes.GenesisDocFromFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read genesis file %s: %v", args[1], err)
+			}
+
+			var appState migrate.AppMap
+			if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to decode app state: %v", err)
+			}
+
+			newAppState, err := migration(appState)
+			if err != nil {
+				return fmt.Errorf("failed to migrate app state to %s: %v", target, err)
+			}
+
+			if genDoc.AppState, err = json.Marshal(newAppState); err != nil {
+				return err
+			}
+
+			genesisTime, _ := cmd.Flags().GetString(flagGenesisTime)
+			if genesisTime != "" {
+				t, err := time.Parse(time.RFC3339, genesisTime)
+				if err != nil {
+					return fmt.Errorf("invalid genesis time %s: %v", genesisTime, err)
+				}
+				genDoc.GenesisTime = t
+			}
+
+			chainID, _ := cmd.Flags().GetString(client.FlagChainID)
+			if chainID != "" {
+				genDoc.ChainID = chainID
+			}
+
+			bz, err := cdc.MarshalJSONIndent(genDoc, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			sortedBz, err := sdk.SortJSON(bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(sortedBz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGenesisTime, "", "Override genesis_time with this flag, RFC3339 format")
+	cmd.Flags().String(client.FlagChainID, "", "Override chain_id with this flag")
+
+	return cmd
+}
+
+// migrationVersions returns the supported target versions in order
+func migrationVersions() []string {
+	versions := make([]string, 0, len(migrationMap))
+	for version := range migrationMap {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	return versions
+}
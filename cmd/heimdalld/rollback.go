@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/snapshot"
+)
+
+const flagRollbackHeight = "height"
+
+// rollbackCmd reverts the application and Tendermint state to an earlier height
+func rollbackCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back the application and Tendermint state to an earlier height",
+		Long: `Revert the application multistore and the Tendermint state and block stores to an earlier committed height.
+Blocks after the height are removed and fetched again from peers on the next start.
+The height must not have been pruned from the application state, see the pruning option of the node.
+The node must be stopped as the databases are opened directly.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			height, _ := cmd.Flags().GetInt64(flagRollbackHeight)
+			if height <= 0 {
+				return errors.New("rollback height must be positive")
+			}
+
+			dataDir := ctx.Config.DBDir()
+			backend := dbm.DBBackendType(ctx.Config.DBBackend)
+
+			appDB, err := sdk.NewLevelDB("application", filepath.Join(ctx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			stateDB := dbm.NewDB("state", backend, dataDir)
+			defer stateDB.Close()
+
+			blockDB := dbm.NewDB("blockstore", backend, dataDir)
+			defer blockDB.Close()
+
+			state := sm.LoadState(stateDB)
+			blockStore := store.NewBlockStore(blockDB)
+			blockHeight := blockStore.Height()
+
+			if height >= state.LastBlockHeight {
+				return fmt.Errorf("rollback height %d must be lower than the last block height %d", height, state.LastBlockHeight)
+			}
+
+			// check everything before changing anything
+			newState, err := rollbackState(state, stateDB, blockStore, height)
+			if err != nil {
+				return err
+			}
+
+			appHash, err := snapshot.AppHash(appDB, height)
+			if err != nil {
+				return err
+			}
+
+			if !bytes.Equal(appHash, newState.AppHash) {
+				return fmt.Errorf("application app hash %X at height %d does not match block app hash %X",
+					appHash, height, newState.AppHash)
+			}
+
+			appHeight, err := snapshot.Rollback(appDB, height)
+			if err != nil {
+				return err
+			}
+
+			sm.SaveState(stateDB, newState)
+			if err := truncateBlockStore(blockDB, blockStore, height); err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Rolled back application state from height %d to %d, app hash %X\n", appHeight, height, appHash)
+			fmt.Fprintf(out, "Rolled back Tendermint state from height %d to %d\n", state.LastBlockHeight, height)
+			fmt.Fprintf(out, "Removed blocks %d to %d from the block store\n", height+1, blockHeight)
+			fmt.Fprintf(out, "Side-tx votes for block %d are dropped, and the validator will not sign heights it has already signed\n", height)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagRollbackHeight, 0, "Height to roll back to")
+
+	return cmd
+}
+
+// rollbackState rebuilds the Tendermint state as it was after committing the
+// block at height
+func rollbackState(state sm.State, stateDB dbm.DB, blockStore *store.BlockStore, height int64) (sm.State, error) {
+	blockMeta := blockStore.LoadBlockMeta(height)
+	nextBlockMeta := blockStore.LoadBlockMeta(height + 1)
+	if blockMeta == nil || nextBlockMeta == nil {
+		return state, fmt.Errorf("blocks %d and %d must be in the block store", height, height+1)
+	}
+
+	lastValidators, err := sm.LoadValidators(stateDB, height)
+	if err != nil {
+		return state, err
+	}
+
+	validators, err := sm.LoadValidators(stateDB, height+1)
+	if err != nil {
+		return state, err
+	}
+
+	nextValidators, err := sm.LoadValidators(stateDB, height+2)
+	if err != nil {
+		return state, err
+	}
+
+	consensusParams, err := sm.LoadConsensusParams(stateDB, height+1)
+	if err != nil {
+		return state, err
+	}
+
+	newState := state.Copy()
+	newState.Version.Consensus = nextBlockMeta.Header.Version
+	newState.LastBlockHeight = height
+	newState.LastBlockTotalTx = blockMeta.Header.TotalTxs
+	newState.LastBlockID = blockMeta.BlockID
+	newState.LastBlockTime = blockMeta.Header.Time
+	newState.NextValidators = nextValidators
+	newState.Validators = validators
+	newState.LastValidators = lastValidators
+	newState.ConsensusParams = consensusParams
+	newState.LastResultsHash = nextBlockMeta.Header.LastResultsHash
+	newState.AppHash = nextBlockMeta.Header.AppHash
+
+	// side-tx results are not persisted per height
+	newState.SideTxResponses = nil
+
+	// have the validator set and params stored in full at the heights saved
+	// with the state, later heights refer to them
+	newState.LastHeightValidatorsChanged = height + 2
+	newState.LastHeightConsensusParamsChanged = height + 1
+
+	return newState, nil
+}
+
+// truncateBlockStore removes the blocks after height from the block store
+func truncateBlockStore(blockDB dbm.DB, blockStore *store.BlockStore, height int64) error {
+	batch := blockDB.NewBatch()
+	defer batch.Close()
+
+	for h := height + 1; h <= blockStore.Height(); h++ {
+		blockMeta := blockStore.LoadBlockMeta(h)
+		if blockMeta == nil {
+			return fmt.Errorf("block %d not found in the block store", h)
+		}
+
+		for i := 0; i < blockMeta.BlockID.PartsHeader.Total; i++ {
+			batch.Delete([]byte(fmt.Sprintf("P:%v:%v", h, i)))
+		}
+		batch.Delete([]byte(fmt.Sprintf("H:%v", h)))
+		batch.Delete([]byte(fmt.Sprintf("SC:%v", h)))
+		// commit of the previous block stored along with the block
+		batch.Delete([]byte(fmt.Sprintf("C:%v", h-1)))
+	}
+
+	batch.WriteSync()
+	store.BlockStoreStateJSON{Height: height}.Save(blockDB)
+
+	return nil
+}
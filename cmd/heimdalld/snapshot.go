@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/snapshot"
+)
+
+const (
+	flagSnapshotHeight    = "height"
+	flagSnapshotChunkSize = "chunk-size"
+)
+
+// snapshotCmd groups the snapshot create and restore commands
+func snapshotCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Create and restore application state snapshots",
+	}
+
+	cmd.AddCommand(
+		createSnapshotCmd(ctx),
+		restoreSnapshotCmd(ctx),
+	)
+
+	return cmd
+}
+
+// createSnapshotCmd writes a snapshot of the application state held in a data dir
+func createSnapshotCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [snapshot-dir]",
+		Short: "Create a snapshot of the application state at a height",
+		Long: `Write the application state at a height into snapshot-dir as hashed chunks with a manifest.
+The height must have been flushed to disk, see the pruning option of the node.
+The node must be stopped as the application database is opened directly.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dataDir, _ := cmd.Flags().GetString(flagDataDir)
+			height, _ := cmd.Flags().GetInt64(flagSnapshotHeight)
+			chunkSize, _ := cmd.Flags().GetInt(flagSnapshotChunkSize)
+
+			dataDir = appDataDir(dataDir)
+			if _, err := os.Stat(filepath.Join(dataDir, "application.db")); err != nil {
+				return fmt.Errorf("application db not found in %s: %v", dataDir, err)
+			}
+
+			db, err := sdk.NewLevelDB("application", dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			manifest, err := snapshot.Create(db, height, args[0], chunkSize)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Created snapshot at height %d with %d chunks, app hash %X\n",
+				manifest.Height, len(manifest.Chunks), manifest.AppHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDataDir, "", "Data directory holding application db (default <home>/data)")
+	cmd.Flags().Int64(flagSnapshotHeight, 0, "Height to snapshot (default latest)")
+	cmd.Flags().Int(flagSnapshotChunkSize, snapshot.DefaultChunkSize, "Uncompressed chunk size in bytes")
+
+	return cmd
+}
+
+// restoreSnapshotCmd bootstraps a new node from a snapshot
+func restoreSnapshotCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [snapshot-dir]",
+		Short: "Restore the application and Tendermint state from a snapshot",
+		Long: `Restore the application state from snapshot-dir into an empty data dir and bootstrap the Tendermint
+state and block stores at the snapshot height, so that the node starts syncing from the following block.
+The block at the snapshot height, its commit and the validator sets are fetched from a trusted Tendermint RPC node,
+which must have committed the block following the snapshot height. The snapshot app hash is checked against
+that block header before anything is written. Blocks before the snapshot height are not held by the node.
+A validator node restored from a snapshot starts with an empty sign state, stop any other node signing with its key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dataDir, _ := cmd.Flags().GetString(flagDataDir)
+			node, _ := cmd.Flags().GetString(helper.NodeFlag)
+
+			manifest, err := snapshot.LoadManifest(args[0])
+			if err != nil {
+				return err
+			}
+
+			trusted, err := snapshot.LoadTrustedState(node, manifest.Height)
+			if err != nil {
+				return fmt.Errorf("fetching trusted state from %s: %v", node, err)
+			}
+
+			if err := trusted.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// app hash of a height is committed in the next header
+			if !bytes.Equal(trusted.AppHash(), manifest.AppHash) {
+				return fmt.Errorf("snapshot app hash %X does not match trusted app hash %X at height %d",
+					manifest.AppHash, trusted.AppHash(), manifest.Height+1)
+			}
+
+			dataDir = appDataDir(dataDir)
+			tmDataDir := ctx.Config.DBDir()
+			for _, dir := range []string{
+				filepath.Join(dataDir, "application.db"),
+				filepath.Join(tmDataDir, "state.db"),
+				filepath.Join(tmDataDir, "blockstore.db"),
+			} {
+				if _, err := os.Stat(dir); err == nil {
+					return fmt.Errorf("%s already exists", dir)
+				}
+			}
+
+			if err := os.MkdirAll(dataDir, 0755); err != nil {
+				return err
+			}
+
+			db, err := sdk.NewLevelDB("application", dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if _, err := snapshot.Restore(args[0], db); err != nil {
+				return err
+			}
+
+			backend := dbm.DBBackendType(ctx.Config.DBBackend)
+
+			stateDB := dbm.NewDB("state", backend, tmDataDir)
+			defer stateDB.Close()
+
+			blockDB := dbm.NewDB("blockstore", backend, tmDataDir)
+			defer blockDB.Close()
+
+			if _, err := snapshot.RestoreTendermint(stateDB, blockDB, trusted); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Restored application and Tendermint state at height %d, app hash %X\n",
+				manifest.Height, manifest.AppHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDataDir, "", "Data directory to restore application db into (default <home>/data)")
+	cmd.Flags().String(helper.NodeFlag, helper.DefaultTendermintNodeURL, "Trusted Tendermint RPC node to fetch the snapshot block and validators from")
+
+	return cmd
+}
+
+// appDataDir returns dataDir or the default data dir under home
+func appDataDir(dataDir string) string {
+	if dataDir == "" {
+		return filepath.Join(viper.GetString(cli.HomeFlag), "data")
+	}
+	return dataDir
+}
@@ -54,6 +54,8 @@ const (
 	CodeProducerMisMatch    CodeType = 3505
 	CodeInvalidBorChainID   CodeType = 3506
 	CodeInvalidSpanDuration CodeType = 3507
+	CodeInvalidSeedCommit   CodeType = 3508
+	CodeInvalidSeedReveal   CodeType = 3509
 
 	CodeFetchCheckpointSigners       CodeType = 4501
 	CodeErrComputeGenesisAccountRoot CodeType = 4503
@@ -71,6 +73,23 @@ const (
 	CodeSlashInfoDetails       CodeType = 6503
 	CodeTickNotInContinuity    CodeType = 6504
 	CodeTickAckNotInContinuity CodeType = 6505
+	CodeInvalidEvidence        CodeType = 6506
+	CodeEvidenceAlreadyHandled CodeType = 6507
+
+	CodeInvalidContractMigration  CodeType = 7501
+	CodeContractMigrationNotFound CodeType = 7502
+
+	CodeInvalidTreasurySpend      CodeType = 8501
+	CodeInsufficientTreasuryFunds CodeType = 8502
+
+	CodeNoFeeReward CodeType = 9501
+
+	CodeInvalidFeeAllowance  CodeType = 10501
+	CodeFeeAllowanceNotFound CodeType = 10502
+	CodeFeeAllowanceExceeded CodeType = 10503
+
+	CodeSideTxRetryNotFound CodeType = 11501
+	CodeSideTxRetryNotDue   CodeType = 11502
 )
 
 // -------- Invalid msg
@@ -217,6 +236,14 @@ func ErrSpanNotFound(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeSpanNotFound, "Span not found")
 }
 
+func ErrInvalidSeedCommit(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidSeedCommit, "Invalid span seed commit")
+}
+
+func ErrInvalidSeedReveal(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidSeedReveal, "Invalid span seed reveal")
+}
+
 func ErrUnableToFreezeValSet(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeUnableToFreezeSet, "Unable to freeze validator set for next span")
 }
@@ -318,6 +345,34 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "Producer set mismatch"
 	case CodeInvalidBorChainID:
 		return "Invalid Bor chain id"
+	case CodeInvalidSeedCommit:
+		return "Invalid span seed commit"
+	case CodeInvalidSeedReveal:
+		return "Invalid span seed reveal"
+	case CodeInvalidEvidence:
+		return "Invalid evidence"
+	case CodeEvidenceAlreadyHandled:
+		return "Evidence already handled"
+	case CodeInvalidContractMigration:
+		return "Invalid contract migration"
+	case CodeContractMigrationNotFound:
+		return "Contract migration not found"
+	case CodeInvalidTreasurySpend:
+		return "Invalid treasury spend"
+	case CodeInsufficientTreasuryFunds:
+		return "Insufficient treasury funds"
+	case CodeNoFeeReward:
+		return "No fee reward to withdraw"
+	case CodeInvalidFeeAllowance:
+		return "Invalid fee allowance"
+	case CodeFeeAllowanceNotFound:
+		return "Fee allowance not found"
+	case CodeFeeAllowanceExceeded:
+		return "Fee allowance exceeded or expired"
+	case CodeSideTxRetryNotFound:
+		return "Side-tx retry not found"
+	case CodeSideTxRetryNotDue:
+		return "Side-tx retry not due"
 	default:
 		return sdk.CodeToDefaultMsg(code)
 	}
@@ -355,3 +410,56 @@ func ErrTickNotInContinuity(codespace sdk.CodespaceType) sdk.Error {
 func ErrTickAckNotInContinuity(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeTickAckNotInContinuity, "Tick-ack not in countinuity")
 }
+
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidEvidence, msg)
+}
+
+func ErrEvidenceAlreadyHandled(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeEvidenceAlreadyHandled, "Evidence already handled")
+}
+
+// Chainmanager errors
+func ErrInvalidContractMigration(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidContractMigration, "Invalid contract migration")
+}
+
+func ErrContractMigrationNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeContractMigrationNotFound, "Contract migration not found")
+}
+
+// Treasury errors
+func ErrInvalidTreasurySpend(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidTreasurySpend, "Invalid treasury spend")
+}
+
+func ErrInsufficientTreasuryFunds(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInsufficientTreasuryFunds, "Insufficient treasury funds")
+}
+
+// Fee reward errors
+func ErrNoFeeReward(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeNoFeeReward, "No fee reward to withdraw")
+}
+
+// Fee grant errors
+func ErrInvalidFeeAllowance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidFeeAllowance, msg)
+}
+
+func ErrFeeAllowanceNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeFeeAllowanceNotFound, "Fee allowance not found")
+}
+
+func ErrFeeAllowanceExceeded(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeFeeAllowanceExceeded, "Fee allowance exceeded or expired")
+}
+
+// Sidechannel errors
+func ErrSideTxRetryNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeSideTxRetryNotFound, "Side-tx retry not found")
+}
+
+func ErrSideTxRetryNotDue(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeSideTxRetryNotDue, "Side-tx retry not due")
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/feegrant/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feegrant module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetQueryAllowance(cdc),
+			GetQueryAllowances(cdc),
+		)...,
+	)
+	return queryCmd
+}
+
+// GetQueryAllowance implements the fee allowance query command.
+func GetQueryAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowance [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "show fee allowance granted by granter to grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query active fee allowance of granter to grantee.
+
+Example:
+$ %s query feegrant allowance <granter> <grantee>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter := hmTypes.HexToHeimdallAddress(args[0])
+			grantee := hmTypes.HexToHeimdallAddress(args[1])
+			if granter.Empty() || grantee.Empty() {
+				return errors.New("Invalid granter or grantee address")
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAllowanceParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllowance)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var allowance types.FeeAllowance
+			if err = json.Unmarshal(res, &allowance); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(allowance)
+		},
+	}
+}
+
+// GetQueryAllowances implements the active fee allowances query command.
+func GetQueryAllowances(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowances [grantee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show active fee allowances granted to grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all active fee allowances granted to grantee.
+
+Example:
+$ %s query feegrant allowances <grantee>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee := hmTypes.HexToHeimdallAddress(args[0])
+			if grantee.Empty() {
+				return errors.New("Invalid grantee address")
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAllowancesParams(grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllowances)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
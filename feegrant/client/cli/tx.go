@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/feegrant/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Feegrant transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		client.PostCommands(
+			GrantFeeAllowanceTxCmd(cdc),
+			RevokeFeeAllowanceTxCmd(cdc),
+		)...,
+	)
+	return txCmd
+}
+
+// GrantFeeAllowanceTxCmd grants fee allowance to grantee
+func GrantFeeAllowanceTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [spend-limit]",
+		Short: "Grant fee allowance to grantee, paid from sender's account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee := hmTypes.HexToHeimdallAddress(args[0])
+			if grantee.Empty() {
+				return errors.New("Invalid grantee address")
+			}
+
+			spendLimit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			// optional expiration
+			var expiration time.Time
+			if expirationStr := viper.GetString(FlagExpiration); expirationStr != "" {
+				expiration, err = time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(
+				helper.GetFromAddress(cliCtx),
+				grantee,
+				spendLimit,
+				expiration,
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagExpiration, "", "--expiration=<RFC3339 time after which allowance can't be used>")
+
+	return cmd
+}
+
+// RevokeFeeAllowanceTxCmd revokes fee allowance of grantee
+func RevokeFeeAllowanceTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke fee allowance granted to grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee := hmTypes.HexToHeimdallAddress(args[0])
+			if grantee.Empty() {
+				return errors.New("Invalid grantee address")
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(
+				helper.GetFromAddress(cliCtx),
+				grantee,
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	"github.com/maticnetwork/heimdall/feegrant/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/feegrant/grant", newGrantFeeAllowanceHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/feegrant/revoke", newRevokeFeeAllowanceHandler(cliCtx)).Methods("POST")
+}
+
+type (
+	// GrantFeeAllowanceReq struct for granting fee allowance
+	GrantFeeAllowanceReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		Grantee    hmTypes.HeimdallAddress `json:"grantee"`
+		SpendLimit sdk.Coins               `json:"spend_limit"`
+		Expiration time.Time               `json:"expiration"`
+	}
+
+	// RevokeFeeAllowanceReq struct for revoking fee allowance
+	RevokeFeeAllowanceReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		Grantee hmTypes.HeimdallAddress `json:"grantee"`
+	}
+)
+
+func newGrantFeeAllowanceHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req GrantFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// draft a message and send response
+		msg := types.NewMsgGrantFeeAllowance(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.Grantee,
+			req.SpendLimit,
+			req.Expiration,
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func newRevokeFeeAllowanceHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RevokeFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// draft a message and send response
+		msg := types.NewMsgRevokeFeeAllowance(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.Grantee,
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
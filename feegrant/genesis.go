@@ -0,0 +1,21 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/feegrant/types"
+)
+
+// InitGenesis sets feegrant information for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	for _, allowance := range data.Allowances {
+		keeper.GrantAllowance(ctx, allowance)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	return types.NewGenesisState(
+		keeper.GetAllAllowances(ctx),
+	)
+}
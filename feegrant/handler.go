@@ -0,0 +1,71 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/feegrant/types"
+)
+
+// NewHandler creates new handler for handling messages for feegrant module
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case types.MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, msg, k)
+		case types.MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in feegrant module").Result()
+		}
+	}
+}
+
+// handleMsgGrantFeeAllowance grants fee allowance from granter to grantee
+func handleMsgGrantFeeAllowance(ctx sdk.Context, msg types.MsgGrantFeeAllowance, k Keeper) sdk.Result {
+	allowance := types.NewFeeAllowance(msg.Granter, msg.Grantee, msg.SpendLimit, msg.Expiration)
+	if allowance.IsExpired(ctx.BlockTime()) {
+		return common.ErrInvalidFeeAllowance(k.Codespace(), "Fee allowance is already expired").Result()
+	}
+
+	k.GrantAllowance(ctx, allowance)
+
+	k.Logger(ctx).Debug("Fee allowance granted", "granter", msg.Granter.String(), "grantee", msg.Grantee.String(), "spendLimit", msg.SpendLimit.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantFeeAllowance,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeySpendLimit, msg.SpendLimit.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgRevokeFeeAllowance revokes fee allowance from granter to grantee
+func handleMsgRevokeFeeAllowance(ctx sdk.Context, msg types.MsgRevokeFeeAllowance, k Keeper) sdk.Result {
+	if err := k.RevokeAllowance(ctx, msg.Granter, msg.Grantee); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeAllowance,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
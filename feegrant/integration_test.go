@@ -0,0 +1,19 @@
+package feegrant_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+)
+
+//
+// Create test app
+//
+
+// returns context and app
+func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context) {
+	app := app.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+	return app, ctx
+}
@@ -0,0 +1,147 @@
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/feegrant/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	codespace sdk.CodespaceType,
+) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		codespace: codespace,
+	}
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// -----------------------------------------------------------------------------
+// Allowances
+
+// GrantAllowance stores fee allowance, replacing existing one of same granter and grantee
+func (k Keeper) GrantAllowance(ctx sdk.Context, allowance types.FeeAllowance) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAllowanceKey(allowance.Granter, allowance.Grantee), k.cdc.MustMarshalBinaryBare(allowance))
+}
+
+// RevokeAllowance removes fee allowance of granter to grantee
+func (k Keeper) RevokeAllowance(ctx sdk.Context, granter hmTypes.HeimdallAddress, grantee hmTypes.HeimdallAddress) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAllowanceKey(granter, grantee)
+	if !store.Has(key) {
+		return common.ErrFeeAllowanceNotFound(k.codespace)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// GetAllowance returns fee allowance of granter to grantee
+func (k Keeper) GetAllowance(ctx sdk.Context, granter hmTypes.HeimdallAddress, grantee hmTypes.HeimdallAddress) (allowance types.FeeAllowance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAllowanceKey(granter, grantee))
+	if bz == nil {
+		return allowance, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &allowance)
+	return allowance, true
+}
+
+// IterateAllowances iterates over all fee allowances and calls handler until it returns true
+func (k Keeper) IterateAllowances(ctx sdk.Context, handler func(allowance types.FeeAllowance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AllowanceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.FeeAllowance
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &allowance)
+		if handler(allowance) {
+			break
+		}
+	}
+}
+
+// GetAllAllowances returns all fee allowances
+func (k Keeper) GetAllAllowances(ctx sdk.Context) (allowances []types.FeeAllowance) {
+	k.IterateAllowances(ctx, func(allowance types.FeeAllowance) bool {
+		allowances = append(allowances, allowance)
+		return false
+	})
+	return
+}
+
+// GetActiveAllowances returns unexpired fee allowances granted to grantee
+func (k Keeper) GetActiveAllowances(ctx sdk.Context, grantee hmTypes.HeimdallAddress) (allowances []types.FeeAllowance) {
+	k.IterateAllowances(ctx, func(allowance types.FeeAllowance) bool {
+		if allowance.Grantee.Equals(grantee) && !allowance.IsExpired(ctx.BlockTime()) {
+			allowances = append(allowances, allowance)
+		}
+		return false
+	})
+	return
+}
+
+// UseGrantedFees deducts fee from allowance of granter to grantee. Allowance is
+// removed once it is exhausted or expired.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter hmTypes.HeimdallAddress, grantee hmTypes.HeimdallAddress, fee sdk.Coins) sdk.Error {
+	allowance, found := k.GetAllowance(ctx, granter, grantee)
+	if !found {
+		return common.ErrFeeAllowanceNotFound(k.codespace)
+	}
+
+	updated, ok := allowance.Accept(fee, ctx.BlockTime())
+	if !ok {
+		if allowance.IsExpired(ctx.BlockTime()) {
+			// error is ignored as allowance exists
+			_ = k.RevokeAllowance(ctx, granter, grantee)
+		}
+		return common.ErrFeeAllowanceExceeded(k.codespace)
+	}
+
+	if updated.SpendLimit.IsZero() {
+		_ = k.RevokeAllowance(ctx, granter, grantee)
+	} else {
+		k.GrantAllowance(ctx, updated)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeAllowance,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return nil
+}
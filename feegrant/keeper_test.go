@@ -0,0 +1,119 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/feegrant"
+	"github.com/maticnetwork/heimdall/feegrant/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(1000, 0))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+var (
+	granter = hmTypes.HexToHeimdallAddress("0x000000000000000000000000000000000000000a")
+	grantee = hmTypes.HexToHeimdallAddress("0x000000000000000000000000000000000000000b")
+)
+
+func feeCoins(amount int64) sdk.Coins {
+	return sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(amount))}
+}
+
+// Tests
+
+func (suite *KeeperTestSuite) TestUseGrantedFees() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.FeeGrantKeeper
+
+	// no allowance
+	require.NotNil(t, keeper.UseGrantedFees(ctx, granter, grantee, feeCoins(10)))
+
+	keeper.GrantAllowance(ctx, types.NewFeeAllowance(granter, grantee, feeCoins(25), time.Time{}))
+
+	// fees are deducted from spend limit
+	require.Nil(t, keeper.UseGrantedFees(ctx, granter, grantee, feeCoins(10)))
+	allowance, found := keeper.GetAllowance(ctx, granter, grantee)
+	require.True(t, found)
+	require.Equal(t, feeCoins(15), allowance.SpendLimit)
+
+	// spend limit can't be exceeded
+	require.NotNil(t, keeper.UseGrantedFees(ctx, granter, grantee, feeCoins(20)))
+
+	// exhausted allowance is removed
+	require.Nil(t, keeper.UseGrantedFees(ctx, granter, grantee, feeCoins(15)))
+	_, found = keeper.GetAllowance(ctx, granter, grantee)
+	require.False(t, found)
+}
+
+func (suite *KeeperTestSuite) TestExpiredAllowance() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.FeeGrantKeeper
+
+	keeper.GrantAllowance(ctx, types.NewFeeAllowance(granter, grantee, feeCoins(25), time.Unix(2000, 0)))
+	require.Len(t, keeper.GetActiveAllowances(ctx, grantee), 1)
+
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.Empty(t, keeper.GetActiveAllowances(ctx, grantee))
+	require.NotNil(t, keeper.UseGrantedFees(ctx, granter, grantee, feeCoins(10)))
+
+	_, found := keeper.GetAllowance(ctx, granter, grantee)
+	require.False(t, found)
+}
+
+func (suite *KeeperTestSuite) TestGrantAndRevoke() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	handler := feegrant.NewHandler(app.FeeGrantKeeper)
+
+	// expired allowance can't be granted
+	msg := types.NewMsgGrantFeeAllowance(granter, grantee, feeCoins(25), time.Unix(500, 0))
+	require.False(t, handler(ctx, msg).IsOK())
+
+	msg = types.NewMsgGrantFeeAllowance(granter, grantee, feeCoins(25), time.Unix(2000, 0))
+	require.Nil(t, msg.ValidateBasic())
+	require.True(t, handler(ctx, msg).IsOK())
+
+	allowances := app.FeeGrantKeeper.GetActiveAllowances(ctx, grantee)
+	require.Len(t, allowances, 1)
+	require.Equal(t, granter, allowances[0].Granter)
+	require.Equal(t, feeCoins(25), allowances[0].SpendLimit)
+
+	// genesis round trip
+	genesis := feegrant.ExportGenesis(ctx, app.FeeGrantKeeper)
+	require.Nil(t, types.ValidateGenesis(genesis))
+	require.Equal(t, allowances, genesis.Allowances)
+
+	revoke := types.NewMsgRevokeFeeAllowance(granter, grantee)
+	require.True(t, handler(ctx, revoke).IsOK())
+	require.Empty(t, app.FeeGrantKeeper.GetActiveAllowances(ctx, grantee))
+
+	// nothing to revoke
+	require.False(t, handler(ctx, revoke).IsOK())
+}
+
+func TestMsgGrantFeeAllowanceValidation(t *testing.T) {
+	require.NotNil(t, types.NewMsgGrantFeeAllowance(granter, granter, feeCoins(25), time.Time{}).ValidateBasic())
+	require.NotNil(t, types.NewMsgGrantFeeAllowance(granter, grantee, sdk.Coins{}, time.Time{}).ValidateBasic())
+	require.NotNil(t, types.NewMsgGrantFeeAllowance(hmTypes.ZeroHeimdallAddress, grantee, feeCoins(25), time.Time{}).ValidateBasic())
+	require.Nil(t, types.NewMsgGrantFeeAllowance(granter, grantee, feeCoins(25), time.Time{}).ValidateBasic())
+}
@@ -0,0 +1,61 @@
+package feegrant
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/feegrant/types"
+)
+
+// NewQuerier creates a querier for feegrant REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryAllowance:
+			return queryAllowance(ctx, req, keeper)
+		case types.QueryAllowances:
+			return queryAllowances(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown feegrant query endpoint")
+		}
+	}
+}
+
+func queryAllowance(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllowanceParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	allowance, found := keeper.GetAllowance(ctx, params.Granter, params.Grantee)
+	if !found || allowance.IsExpired(ctx.BlockTime()) {
+		return nil, common.ErrFeeAllowanceNotFound(keeper.Codespace())
+	}
+
+	bz, err := json.Marshal(allowance)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryAllowances(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllowancesParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	allowances := keeper.GetActiveAllowances(ctx, params.Grantee)
+	if allowances == nil {
+		allowances = []types.FeeAllowance{}
+	}
+
+	bz, err := json.Marshal(allowances)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// FeeAllowance allows grantee to pay tx fees from granter's account
+// up to spend limit until expiration. Zero expiration never expires.
+type FeeAllowance struct {
+	Granter    hmTypes.HeimdallAddress `json:"granter" yaml:"granter"`
+	Grantee    hmTypes.HeimdallAddress `json:"grantee" yaml:"grantee"`
+	SpendLimit sdk.Coins               `json:"spend_limit" yaml:"spend_limit"`
+	Expiration time.Time               `json:"expiration" yaml:"expiration"`
+}
+
+// NewFeeAllowance creates new fee allowance
+func NewFeeAllowance(granter hmTypes.HeimdallAddress, grantee hmTypes.HeimdallAddress, spendLimit sdk.Coins, expiration time.Time) FeeAllowance {
+	return FeeAllowance{
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// IsExpired returns true if allowance is expired at block time
+func (a FeeAllowance) IsExpired(blockTime time.Time) bool {
+	return !a.Expiration.IsZero() && !blockTime.Before(a.Expiration)
+}
+
+// Accept returns allowance with fee deducted from spend limit, and false if
+// fee exceeds remaining limit or allowance is expired
+func (a FeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (FeeAllowance, bool) {
+	if a.IsExpired(blockTime) {
+		return a, false
+	}
+
+	remaining, hasNeg := a.SpendLimit.SafeSub(fee)
+	if hasNeg {
+		return a, false
+	}
+
+	a.SpendLimit = remaining
+	return a, true
+}
+
+// Validate performs basic validation of fee allowance
+func (a FeeAllowance) Validate() error {
+	if a.Granter.Empty() || a.Grantee.Empty() {
+		return errors.New("Invalid granter or grantee")
+	}
+
+	if a.Granter.Equals(a.Grantee) {
+		return errors.New("Granter and grantee must be different")
+	}
+
+	if !a.SpendLimit.IsValid() || a.SpendLimit.Empty() {
+		return fmt.Errorf("Invalid spend limit %s", a.SpendLimit)
+	}
+
+	return nil
+}
+
+// String returns the string representation of fee allowance
+func (a FeeAllowance) String() string {
+	return fmt.Sprintf(`FeeAllowance:
+  Granter:    %s
+  Grantee:    %s
+  SpendLimit: %s
+  Expiration: %s`, a.Granter, a.Grantee, a.SpendLimit, a.Expiration)
+}
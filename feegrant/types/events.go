@@ -0,0 +1,15 @@
+package types
+
+// feegrant module event types
+const (
+	EventTypeGrantFeeAllowance  = "grant-fee-allowance"
+	EventTypeRevokeFeeAllowance = "revoke-fee-allowance"
+	EventTypeUseFeeAllowance    = "use-fee-allowance"
+
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeySpendLimit = "spend-limit"
+	AttributeKeyFee        = "fee"
+
+	AttributeValueCategory = ModuleName
+)
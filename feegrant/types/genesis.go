@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState is the feegrant state that must be provided at genesis.
+type GenesisState struct {
+	Allowances []FeeAllowance `json:"allowances" yaml:"allowances"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(allowances []FeeAllowance) GenesisState {
+	return GenesisState{
+		Allowances: allowances,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(nil)
+}
+
+// ValidateGenesis performs basic validation of feegrant genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool)
+	for _, allowance := range data.Allowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetAllowanceKey(allowance.Granter, allowance.Grantee))
+		if seen[key] {
+			return fmt.Errorf("Duplicate fee allowance from %s to %s", allowance.Granter, allowance.Grantee)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns feegrant GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		ModuleCdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+	return genesisState
+}
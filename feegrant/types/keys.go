@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "feegrant"
+
+	// StoreKey is the store key string for feegrant
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for feegrant
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for feegrant
+	QuerierRoute = ModuleName
+
+	// DefaultCodespace default code space
+	DefaultCodespace sdk.CodespaceType = ModuleName
+)
+
+var (
+	AllowanceKeyPrefix = []byte{0x11} // prefix for each key to a fee allowance
+)
+
+// GetAllowancesKey returns prefix key for all allowances of granter
+func GetAllowancesKey(granter hmTypes.HeimdallAddress) []byte {
+	return append(AllowanceKeyPrefix, granter.Bytes()...)
+}
+
+// GetAllowanceKey returns key for fee allowance of granter to grantee
+func GetAllowanceKey(granter hmTypes.HeimdallAddress, grantee hmTypes.HeimdallAddress) []byte {
+	return append(GetAllowancesKey(granter), grantee.Bytes()...)
+}
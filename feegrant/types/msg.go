@@ -0,0 +1,104 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+var _ sdk.Msg = &MsgGrantFeeAllowance{}
+
+// MsgGrantFeeAllowance grants fee allowance to grantee, replacing existing one
+type MsgGrantFeeAllowance struct {
+	Granter    types.HeimdallAddress `json:"granter"`
+	Grantee    types.HeimdallAddress `json:"grantee"`
+	SpendLimit sdk.Coins             `json:"spend_limit"`
+	Expiration time.Time             `json:"expiration"`
+}
+
+func NewMsgGrantFeeAllowance(granter types.HeimdallAddress, grantee types.HeimdallAddress, spendLimit sdk.Coins, expiration time.Time) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+func (msg MsgGrantFeeAllowance) Type() string {
+	return "grant-fee-allowance"
+}
+
+func (msg MsgGrantFeeAllowance) Route() string {
+	return RouterKey
+}
+
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.Granter)}
+}
+
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	if err := NewFeeAllowance(msg.Granter, msg.Grantee, msg.SpendLimit, msg.Expiration).Validate(); err != nil {
+		return hmCommon.ErrInvalidFeeAllowance(DefaultCodespace, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRevokeFeeAllowance{}
+
+// MsgRevokeFeeAllowance revokes fee allowance of grantee
+type MsgRevokeFeeAllowance struct {
+	Granter types.HeimdallAddress `json:"granter"`
+	Grantee types.HeimdallAddress `json:"grantee"`
+}
+
+func NewMsgRevokeFeeAllowance(granter types.HeimdallAddress, grantee types.HeimdallAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+func (msg MsgRevokeFeeAllowance) Type() string {
+	return "revoke-fee-allowance"
+}
+
+func (msg MsgRevokeFeeAllowance) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.Granter)}
+}
+
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return hmCommon.ErrInvalidMsg(DefaultCodespace, "Invalid granter %v", msg.Granter.String())
+	}
+
+	if msg.Grantee.Empty() {
+		return hmCommon.ErrInvalidMsg(DefaultCodespace, "Invalid grantee %v", msg.Grantee.String())
+	}
+
+	return nil
+}
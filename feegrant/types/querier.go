@@ -0,0 +1,32 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the feegrant Querier
+const (
+	QueryAllowance  = "allowance"
+	QueryAllowances = "allowances"
+)
+
+// QueryAllowanceParams defines the params for querying fee allowance of grantee
+type QueryAllowanceParams struct {
+	Granter hmTypes.HeimdallAddress `json:"granter"`
+	Grantee hmTypes.HeimdallAddress `json:"grantee"`
+}
+
+// NewQueryAllowanceParams creates a new instance of QueryAllowanceParams.
+func NewQueryAllowanceParams(granter hmTypes.HeimdallAddress, grantee hmTypes.HeimdallAddress) QueryAllowanceParams {
+	return QueryAllowanceParams{Granter: granter, Grantee: grantee}
+}
+
+// QueryAllowancesParams defines the params for querying active fee allowances of grantee
+type QueryAllowancesParams struct {
+	Grantee hmTypes.HeimdallAddress `json:"grantee"`
+}
+
+// NewQueryAllowancesParams creates a new instance of QueryAllowancesParams.
+func NewQueryAllowancesParams(grantee hmTypes.HeimdallAddress) QueryAllowancesParams {
+	return QueryAllowancesParams{Grantee: grantee}
+}
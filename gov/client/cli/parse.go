@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/viper"
 
 	govutils "github.com/maticnetwork/heimdall/gov/client/utils"
+	"github.com/maticnetwork/heimdall/gov/types"
 )
 
 func parseSubmitProposalFlags() (*proposal, error) {
@@ -40,3 +41,14 @@ func parseSubmitProposalFlags() (*proposal, error) {
 
 	return proposal, nil
 }
+
+// proposalContent returns the proposal content, normalizing the proposal type
+// the same way as the REST endpoint does
+func proposalContent(proposal *proposal) (types.Content, error) {
+	content := types.ContentFromProposalType(proposal.Title, proposal.Description, govutils.NormalizeProposalType(proposal.Type))
+	if content == nil {
+		return nil, fmt.Errorf("unknown proposal type %s", proposal.Type)
+	}
+
+	return content, nil
+}
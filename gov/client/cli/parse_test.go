@@ -6,6 +6,8 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/gov/types"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -69,3 +71,14 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestProposalContent(t *testing.T) {
+	for _, proposalType := range []string{"Text", "text"} {
+		content, err := proposalContent(&proposal{Title: "title", Description: "description", Type: proposalType})
+		require.NoError(t, err)
+		require.Equal(t, types.NewTextProposal("title", "description"), content)
+	}
+
+	_, err := proposalContent(&proposal{Title: "title", Description: "description", Type: "unknown"})
+	require.Error(t, err)
+}
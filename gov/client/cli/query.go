@@ -39,7 +39,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProposer(queryRoute, cdc),
 		GetCmdQueryDeposit(queryRoute, cdc),
 		GetCmdQueryDeposits(queryRoute, cdc),
-		GetCmdQueryTally(queryRoute, cdc))...)
+		GetCmdQueryTally(queryRoute, cdc),
+		GetCmdQueryUpgradePlan(queryRoute, cdc))...)
 
 	return govQueryCmd
 }
@@ -585,3 +586,36 @@ $ %s query gov proposer 1
 }
 
 // DONTCOVER
+
+// GetCmdQueryUpgradePlan implements the query upgrade plan command.
+func GetCmdQueryUpgradePlan(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "upgrade-plan",
+		Short: "Query the software upgrade plan scheduled by governance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the currently scheduled software upgrade plan, if any.
+
+Example:
+$ %s query gov upgrade-plan
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryUpgradePlan), nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var plan types.Plan
+			cdc.MustUnmarshalJSON(res, &plan)
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
@@ -34,6 +34,10 @@ const (
 	flagNumLimit     = "limit"
 	FlagProposal     = "proposal"
 	FlagValidatorID  = "validator-id"
+
+	FlagUpgradeName   = "name"
+	FlagUpgradeHeight = "upgrade-height"
+	FlagUpgradeInfo   = "info"
 )
 
 type proposal struct {
@@ -68,6 +72,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	}
 
 	cmdSubmitProp := GetCmdSubmitProposal(cdc)
+	cmdSubmitProp.AddCommand(client.PostCommands(GetCmdSubmitUpgradeProposal(cdc))[0])
 	for _, pcmd := range pcmds {
 		cmdSubmitProp.AddCommand(client.PostCommands(pcmd)[0])
 	}
@@ -127,7 +132,11 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return fmt.Errorf("Valid validator ID required")
 			}
 
-			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
+			content, err := proposalContent(proposal)
+			if err != nil {
+				return err
+			}
+
 			from := helper.GetFromAddress(cliCtx)
 
 			msg := types.NewMsgSubmitProposal(content, amount, from, hmTypes.ValidatorID(validatorID))
@@ -269,3 +278,68 @@ $ %s tx gov vote 1 yes --from mykey
 }
 
 // DONTCOVER
+
+// GetCmdSubmitUpgradeProposal implements submitting a software upgrade proposal transaction command.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade",
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade proposal along with an initial deposit.
+Once passed, chain halts at upgrade height until the binary with upgrade handler for the name is started.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade --name="v0.2.0" --upgrade-height=100000 --info="commit hash" --title="Upgrade" --description="Upgrade to v0.2.0" --deposit="10matic" --validator-id=1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoins(viper.GetString(FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			validatorID := viper.GetInt64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			plan := types.NewPlan(
+				viper.GetString(FlagUpgradeName),
+				viper.GetInt64(FlagUpgradeHeight),
+				viper.GetString(FlagUpgradeInfo),
+			)
+			content := types.NewSoftwareUpgradeProposal(viper.GetString(FlagTitle), viper.GetString(FlagDescription), plan)
+			from := helper.GetFromAddress(cliCtx)
+
+			msg := types.NewMsgSubmitProposal(content, amount, from, hmTypes.ValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagTitle, "", "title of proposal")
+	cmd.Flags().String(FlagDescription, "", "description of proposal")
+	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(FlagUpgradeName, "", "name of the upgrade, must match upgrade handler in new binary")
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "height at which the upgrade must happen")
+	cmd.Flags().String(FlagUpgradeInfo, "", "info for the upgrade plan, eg. commit hash of new binary")
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("GetCmdSubmitUpgradeProposal | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+	if err := cmd.MarkFlagRequired(FlagUpgradeName); err != nil {
+		logger.Error("GetCmdSubmitUpgradeProposal | MarkFlagRequired | FlagUpgradeName", "Error", err)
+	}
+	if err := cmd.MarkFlagRequired(FlagUpgradeHeight); err != nil {
+		logger.Error("GetCmdSubmitUpgradeProposal | MarkFlagRequired | FlagUpgradeHeight", "Error", err)
+	}
+
+	return cmd
+}
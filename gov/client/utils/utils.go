@@ -25,6 +25,9 @@ func NormalizeVoteOption(option string) string {
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
+	case "Text", "text":
+		return types.ProposalTypeText
+
 	default:
 		return ""
 	}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,6 +28,8 @@ type GenesisState struct {
 	DepositParams      types.DepositParams `json:"deposit_params" yaml:"deposit_params"`
 	VotingParams       types.VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams        types.TallyParams   `json:"tally_params" yaml:"tally_params"`
+	UpgradePlan        *types.Plan         `json:"upgrade_plan" yaml:"upgrade_plan"`
+	DoneUpgrades       []types.DoneUpgrade `json:"done_upgrades" yaml:"done_upgrades"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -91,6 +94,28 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	if data.UpgradePlan != nil {
+		if err := data.UpgradePlan.ValidateBasic(types.DefaultCodespace); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, done := range data.DoneUpgrades {
+		if strings.TrimSpace(done.Name) == "" || done.Height < 0 {
+			return fmt.Errorf("Invalid done upgrade %s at height %d", done.Name, done.Height)
+		}
+
+		if seen[done.Name] {
+			return fmt.Errorf("Duplicate done upgrade %s", done.Name)
+		}
+		seen[done.Name] = true
+
+		if data.UpgradePlan != nil && data.UpgradePlan.Name == done.Name {
+			return fmt.Errorf("Upgrade %s is both scheduled and done", done.Name)
+		}
+	}
+
 	return nil
 }
 
@@ -128,6 +153,15 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper SupplyKeeper, data Gene
 		k.SetProposal(ctx, proposal)
 	}
 
+	// pending upgrade keeps halting the chain at its height after import
+	if data.UpgradePlan != nil {
+		k.setUpgradePlan(ctx, *data.UpgradePlan)
+	}
+
+	for _, done := range data.DoneUpgrades {
+		k.setDoneHeight(ctx, done.Name, done.Height)
+	}
+
 	// add coins if not provided on genesis
 	if moduleAcc.GetCoins().IsZero() {
 		if err := moduleAcc.SetCoins(totalDeposits); err != nil {
@@ -156,6 +190,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		proposalsVotes = append(proposalsVotes, votes...)
 	}
 
+	var upgradePlan *types.Plan
+	if plan, ok := k.GetUpgradePlan(ctx); ok {
+		upgradePlan = &plan
+	}
+
 	return GenesisState{
 		StartingProposalID: startingProposalID,
 		Deposits:           proposalsDeposits,
@@ -164,5 +203,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		UpgradePlan:        upgradePlan,
+		DoneUpgrades:       k.GetDoneUpgrades(ctx),
 	}
 }
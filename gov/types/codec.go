@@ -15,6 +15,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "gov/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "gov/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "gov/MsgVote", nil)
+
+	cdc.RegisterConcrete(TextProposal{}, "gov/TextProposal", nil)
+	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "gov/SoftwareUpgradeProposal", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
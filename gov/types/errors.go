@@ -21,6 +21,7 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 9
 	CodeInvalidProposalStatus    sdk.CodeType = 10
 	CodeProposalHandlerNotExists sdk.CodeType = 11
+	CodeInvalidUpgradePlan       sdk.CodeType = 12
 )
 
 func ErrUnknownProposal(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
@@ -62,3 +63,7 @@ func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content interface{}) sdk.Error {
 	return sdk.NewError(codespace, CodeProposalHandlerNotExists, fmt.Sprintf("'%T' does not have a corresponding handler", content))
 }
+
+func ErrInvalidUpgradePlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidUpgradePlan, fmt.Sprintf("invalid upgrade plan: %s", msg))
+}
@@ -41,6 +41,10 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x30: Upgrade plan
+//
+// - 0x31<upgradeName_Bytes>: Done upgrade height
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -50,6 +54,9 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	UpgradePlanKey       = []byte{0x30}
+	DoneUpgradeKeyPrefix = []byte{0x31}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -111,6 +118,11 @@ func VoteKey(proposalID uint64, validator hmTypes.ValidatorID) []byte {
 	return append(VotesKey(proposalID), validator.Bytes()...)
 }
 
+// DoneUpgradeKey key of a applied upgrade from the store
+func DoneUpgradeKey(name string) []byte {
+	return append(DoneUpgradeKeyPrefix, []byte(name)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -247,73 +247,83 @@ func (tr TallyResult) String() string {
   NoWithVeto: %s`, tr.Yes, tr.Abstain, tr.No, tr.NoWithVeto)
 }
 
-// // Proposal types
-// const (
-// 	ProposalTypeText            string = "Text"
-// 	ProposalTypeSoftwareUpgrade string = "SoftwareUpgrade"
-// )
-
-// // Text Proposal
-// type TextProposal struct {
-// 	Title       string `json:"title" yaml:"title"`
-// 	Description string `json:"description" yaml:"description"`
-// }
-
-// func NewTextProposal(title, description string) Content {
-// 	return TextProposal{title, description}
-// }
-
-// // Implements Proposal Interface
-// var _ Content = TextProposal{}
-
-// // nolint
-// func (tp TextProposal) GetTitle() string         { return tp.Title }
-// func (tp TextProposal) GetDescription() string   { return tp.Description }
-// func (tp TextProposal) ProposalRoute() string    { return RouterKey }
-// func (tp TextProposal) ProposalType() string     { return ProposalTypeText }
-// func (tp TextProposal) ValidateBasic() sdk.Error { return ValidateAbstract(DefaultCodespace, tp) }
-
-// func (tp TextProposal) String() string {
-// 	return fmt.Sprintf(`Text Proposal:
-//   Title:       %s
-//   Description: %s
-// `, tp.Title, tp.Description)
-// }
-
-// // Software Upgrade Proposals
-// // TODO: We have to add fields for SUP specific arguments e.g. commit hash,
-// // upgrade date, etc.
-// type SoftwareUpgradeProposal struct {
-// 	Title       string `json:"title" yaml:"title"`
-// 	Description string `json:"description" yaml:"description"`
-// }
-
-// func NewSoftwareUpgradeProposal(title, description string) Content {
-// 	return SoftwareUpgradeProposal{title, description}
-// }
-
-// // Implements Proposal Interface
-// var _ Content = SoftwareUpgradeProposal{}
-
-// // nolint
-// func (sup SoftwareUpgradeProposal) GetTitle() string       { return sup.Title }
-// func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
-// func (sup SoftwareUpgradeProposal) ProposalRoute() string  { return RouterKey }
-// func (sup SoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeSoftwareUpgrade }
-// func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
-// 	return ValidateAbstract(DefaultCodespace, sup)
-// }
-
-// func (sup SoftwareUpgradeProposal) String() string {
-// 	return fmt.Sprintf(`Software Upgrade Proposal:
-//   Title:       %s
-//   Description: %s
-// `, sup.Title, sup.Description)
-// }
+// Proposal types
+const (
+	ProposalTypeText            string = "Text"
+	ProposalTypeSoftwareUpgrade string = "SoftwareUpgrade"
+)
+
+// TextProposal defines a standard text proposal whose changes need to be
+// manually updated in case of approval
+type TextProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// NewTextProposal creates a text proposal Content
+func NewTextProposal(title, description string) Content {
+	return TextProposal{title, description}
+}
+
+// Implements Proposal Interface
+var _ Content = TextProposal{}
+
+// nolint
+func (tp TextProposal) GetTitle() string         { return tp.Title }
+func (tp TextProposal) GetDescription() string   { return tp.Description }
+func (tp TextProposal) ProposalRoute() string    { return RouterKey }
+func (tp TextProposal) ProposalType() string     { return ProposalTypeText }
+func (tp TextProposal) ValidateBasic() sdk.Error { return ValidateAbstract(DefaultCodespace, tp) }
+
+func (tp TextProposal) String() string {
+	return fmt.Sprintf(`Text Proposal:
+  Title:       %s
+  Description: %s
+`, tp.Title, tp.Description)
+}
+
+// SoftwareUpgradeProposal is a gov Content type for initiating a software upgrade.
+// Once passed, the chain halts at plan height until binary with matching upgrade handler is started.
+type SoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Plan        Plan   `json:"plan" yaml:"plan"`
+}
+
+// NewSoftwareUpgradeProposal creates a new software upgrade proposal
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) Content {
+	return SoftwareUpgradeProposal{title, description, plan}
+}
+
+// Implements Proposal Interface
+var _ Content = SoftwareUpgradeProposal{}
+
+// nolint
+func (sup SoftwareUpgradeProposal) GetTitle() string       { return sup.Title }
+func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
+func (sup SoftwareUpgradeProposal) ProposalRoute() string  { return RouterKey }
+func (sup SoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeSoftwareUpgrade }
+func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	if err := sup.Plan.ValidateBasic(DefaultCodespace); err != nil {
+		return err
+	}
+
+	return ValidateAbstract(DefaultCodespace, sup)
+}
+
+func (sup SoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+  Name:        %s
+  Height:      %d
+  Info:        %s
+`, sup.Title, sup.Description, sup.Plan.Name, sup.Plan.Height, sup.Plan.Info)
+}
 
 var validProposalTypes = map[string]struct{}{
-	// ProposalTypeText:            {},
-	// ProposalTypeSoftwareUpgrade: {},
+	ProposalTypeText:            {},
+	ProposalTypeSoftwareUpgrade: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -329,11 +339,11 @@ func RegisterProposalType(ty string) {
 // ContentFromProposalType returns a Content object based on the proposal type.
 func ContentFromProposalType(title, desc, ty string) Content {
 	switch ty {
-	// case ProposalTypeText:
-	// 	return NewTextProposal(title, desc)
+	case ProposalTypeText:
+		return NewTextProposal(title, desc)
 
-	// case ProposalTypeSoftwareUpgrade:
-	// 	return NewSoftwareUpgradeProposal(title, desc)
+	case ProposalTypeSoftwareUpgrade:
+		return NewSoftwareUpgradeProposal(title, desc, Plan{})
 
 	default:
 		return nil
@@ -350,14 +360,13 @@ func IsValidProposalType(ty string) bool {
 }
 
 // ProposalHandler implements the Handler interface for governance module-based
-// proposals (ie. TextProposal and SoftwareUpgradeProposal). Since these are
-// merely signaling mechanisms at the moment and do not affect state, it
-// performs a no-op.
+// proposals which are merely signaling mechanisms and do not affect state.
+// SoftwareUpgradeProposal schedules an upgrade plan and is handled by gov keeper.
 func ProposalHandler(_ sdk.Context, c Content) sdk.Error {
 	switch c.ProposalType() {
-	// case ProposalTypeText, ProposalTypeSoftwareUpgrade:
-	// 	// both proposal types do not change state so this performs a no-op
-	// 	return nil
+	case ProposalTypeText:
+		// text proposal is a signaling mechanism and does not change state so this performs a no-op
+		return nil
 
 	default:
 		errMsg := fmt.Sprintf("unrecognized gov proposal type: %s", c.ProposalType())
@@ -15,6 +15,8 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
+	QueryUpgradePlan = "upgrade-plan"
+
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeHandler specifies the type of function that is called when an upgrade is applied.
+// It runs the store migrations required by the new binary.
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
+
+// Plan specifies information about a planned software upgrade and at which height it should occur
+type Plan struct {
+	// Name of the upgrade, new binary must register an upgrade handler with same name
+	Name string `json:"name" yaml:"name"`
+
+	// Height at which the upgrade must be performed
+	Height int64 `json:"height" yaml:"height"`
+
+	// Info is any application specific upgrade info to be included on-chain
+	// such as a git commit that validators could automatically upgrade to
+	Info string `json:"info,omitempty" yaml:"info,omitempty"`
+}
+
+// DoneUpgrade is an upgrade applied on chain with the height it was applied at
+type DoneUpgrade struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+}
+
+// NewPlan creates a new upgrade plan
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan
+  Name:   %s
+  Height: %d
+  Info:   %s`, p.Name, p.Height, p.Info)
+}
+
+// ValidateBasic does basic validation of a Plan
+func (p Plan) ValidateBasic(codespace sdk.CodespaceType) sdk.Error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return ErrInvalidUpgradePlan(codespace, "name cannot be empty")
+	}
+
+	if p.Height <= 0 {
+		return ErrInvalidUpgradePlan(codespace, "height must be greater than 0")
+	}
+
+	return nil
+}
+
+// ShouldExecute returns true if the Plan is ready to execute given the current context
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height > 0 && p.Height <= ctx.BlockHeight()
+}
+
+// DueAt is a string representation of when this plan is due to be executed
+func (p Plan) DueAt() string {
+	return fmt.Sprintf("height: %d", p.Height)
+}
@@ -0,0 +1,143 @@
+package gov
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/gov/types"
+)
+
+// NewGovProposalHandler creates a handler for governance module-based proposals.
+// Keeper is passed as reference since router must be sealed before keeper is created.
+func NewGovProposalHandler(k *Keeper) types.Handler {
+	return func(ctx sdk.Context, content types.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.SoftwareUpgradeProposal:
+			return k.ScheduleUpgrade(ctx, c.Plan)
+
+		default:
+			return types.ProposalHandler(ctx, content)
+		}
+	}
+}
+
+// ScheduleUpgrade schedules an upgrade based on the specified plan.
+// If there is another plan already scheduled, it will overwrite it.
+func (keeper Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.ValidateBasic(keeper.codespace); err != nil {
+		return err
+	}
+
+	if plan.Height <= ctx.BlockHeight() {
+		return types.ErrInvalidUpgradePlan(keeper.codespace, "upgrade cannot be scheduled in the past")
+	}
+
+	if keeper.IsUpgradeDone(ctx, plan.Name) {
+		return types.ErrInvalidUpgradePlan(keeper.codespace, fmt.Sprintf("upgrade with name %s has already been completed", plan.Name))
+	}
+
+	keeper.setUpgradePlan(ctx, plan)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleUpgrade,
+			sdk.NewAttribute(types.AttributeKeyUpgradeName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatInt(plan.Height, 10)),
+		),
+	)
+
+	return nil
+}
+
+func (keeper Keeper) setUpgradePlan(ctx sdk.Context, plan types.Plan) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.UpgradePlanKey, keeper.cdc.MustMarshalBinaryBare(plan))
+}
+
+// GetUpgradePlan returns the currently scheduled upgrade plan, if any
+func (keeper Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, ok bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.UpgradePlanKey)
+	if bz == nil {
+		return plan, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &plan)
+	return plan, true
+}
+
+// ClearUpgradePlan clears any schedule upgrade
+func (keeper Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.UpgradePlanKey)
+}
+
+// GetDoneHeight returns the height at which the given upgrade was executed, 0 if it was never executed
+func (keeper Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.DoneUpgradeKey(name))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// IsUpgradeDone returns true if the given upgrade was executed, or marked done at genesis
+func (keeper Keeper) IsUpgradeDone(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(types.DoneUpgradeKey(name))
+}
+
+// GetDoneUpgrades returns all executed upgrades
+func (keeper Keeper) GetDoneUpgrades(ctx sdk.Context) (doneUpgrades []types.DoneUpgrade) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DoneUpgradeKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		doneUpgrades = append(doneUpgrades, types.DoneUpgrade{
+			Name:   string(iterator.Key()[len(types.DoneUpgradeKeyPrefix):]),
+			Height: int64(binary.BigEndian.Uint64(iterator.Value())),
+		})
+	}
+
+	return doneUpgrades
+}
+
+// ApplyUpgrade runs upgrade handler for the plan, marks the upgrade as done and clears the plan
+func (keeper Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan, handler types.UpgradeHandler) {
+	handler(ctx, plan)
+
+	keeper.ClearUpgradePlan(ctx)
+	keeper.setDone(ctx, plan.Name)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpgrade,
+			sdk.NewAttribute(types.AttributeKeyUpgradeName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+}
+
+// SetUpgradeDone marks the upgrade as done at the current height without running its handler.
+// Used at genesis for the upgrades the genesis state already includes.
+func (keeper Keeper) SetUpgradeDone(ctx sdk.Context, name string) {
+	keeper.setDone(ctx, name)
+}
+
+// setDone marks this upgrade name as being done so the name can't be reused accidentally
+func (keeper Keeper) setDone(ctx sdk.Context, name string) {
+	keeper.setDoneHeight(ctx, name, ctx.BlockHeight())
+}
+
+func (keeper Keeper) setDoneHeight(ctx sdk.Context, name string, height int64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	store.Set(types.DoneUpgradeKey(name), bz)
+}
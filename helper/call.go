@@ -68,8 +68,13 @@ type IContractCaller interface {
 	CurrentSpanNumber(validatorset *validatorset.Validatorset) (Number *big.Int)
 	GetSpanDetails(id *big.Int, validatorset *validatorset.Validatorset) (*big.Int, *big.Int, *big.Int, error)
 	CurrentStateCounter(stateSenderInstance *statesender.Statesender) (Number *big.Int)
+	IsStateSynced(stateReceiverAddress common.Address, stateID *big.Int) (bool, error)
 	CheckIfBlocksExist(end uint64) bool
 
+	// contract code
+	HasMainChainCode(address common.Address) (bool, error)
+	HasMaticChainCode(address common.Address) (bool, error)
+
 	GetRootChainInstance(rootchainAddress common.Address) (*rootchain.Rootchain, error)
 	GetStakingInfoInstance(stakingInfoAddress common.Address) (*stakinginfo.Stakinginfo, error)
 	GetValidatorSetInstance(validatorSetAddress common.Address) (*validatorset.Validatorset, error)
@@ -709,6 +714,36 @@ func (c *ContractCaller) CurrentStateCounter(stateSenderInstance *statesender.St
 	return result
 }
 
+// IsStateSynced checks if state is committed on bor by state receiver
+func (c *ContractCaller) IsStateSynced(stateReceiverAddress common.Address, stateID *big.Int) (bool, error) {
+	stateReceiverInstance, err := statereceiver.NewStatereceiverCaller(stateReceiverAddress, c.MaticChainClient)
+	if err != nil {
+		return false, err
+	}
+
+	return stateReceiverInstance.States(nil, stateID)
+}
+
+// HasMainChainCode checks if contract bytecode is deployed at address on main chain
+func (c *ContractCaller) HasMainChainCode(address common.Address) (bool, error) {
+	code, err := c.MainChainClient.CodeAt(context.Background(), address, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return len(code) > 0, nil
+}
+
+// HasMaticChainCode checks if contract bytecode is deployed at address on matic chain
+func (c *ContractCaller) HasMaticChainCode(address common.Address) (bool, error) {
+	code, err := c.MaticChainClient.CodeAt(context.Background(), address, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return len(code) > 0, nil
+}
+
 // CheckIfBlocksExist - check if latest block number is greater than end block
 func (c *ContractCaller) CheckIfBlocksExist(end uint64) bool {
 	// Get Latest block number.
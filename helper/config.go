@@ -2,6 +2,7 @@ package helper
 
 import (
 	"crypto/ecdsa"
+	"crypto/tls"
 	"log"
 	"math/big"
 	"os"
@@ -103,6 +104,15 @@ type Configuration struct {
 
 	// wait time related options
 	NoACKWaitTime time.Duration `mapstructure:"no_ack_wait_time"` // Time ack service waits to clear buffer and elect new proposer
+
+	// fee allowance granter paying fees of bridge txs
+	FeeGranter string `mapstructure:"fee_granter"`
+
+	// remote signer holding validator key
+	SignerAddress string `mapstructure:"signer_address"`  // unix://<path> or tcp://<host:port>, empty to use local key file
+	SignerTLSCert string `mapstructure:"signer_tls_cert"` // client certificate for tcp signer
+	SignerTLSKey  string `mapstructure:"signer_tls_key"`  // client certificate key for tcp signer
+	SignerTLSCA   string `mapstructure:"signer_tls_ca"`   // CA certificate of tcp signer
 }
 
 var conf Configuration
@@ -122,6 +132,9 @@ var privObject secp256k1.PrivKeySecp256k1
 
 var pubObject secp256k1.PubKeySecp256k1
 
+// signer object
+var signerObject Signer
+
 // Logger stores global logger object
 var Logger logger.Logger
 
@@ -192,6 +205,25 @@ func InitHeimdallConfigWith(homeDir string, heimdallConfigFilePath string) {
 	}
 	GenesisDoc = *genDoc
 
+	// use remote signer if configured, validator key stays with signer
+	if conf.SignerAddress != "" {
+		var tlsConfig *tls.Config
+		if conf.SignerTLSCert != "" {
+			if tlsConfig, err = NewSignerTLSConfig(conf.SignerTLSCert, conf.SignerTLSKey, conf.SignerTLSCA, false); err != nil {
+				log.Fatalln("Unable to load signer TLS config", "Error", err)
+			}
+		}
+
+		remoteSigner, err := NewRemoteSigner(conf.SignerAddress, tlsConfig)
+		if err != nil {
+			log.Fatalln("Unable to connect remote signer", "address", conf.SignerAddress, "Error", err)
+		}
+
+		signerObject = remoteSigner
+		pubObject = remoteSigner.PubKey()
+		return
+	}
+
 	// load pv file, unmarshall and set to privObject
 	err = file.PermCheck(file.Rootify("priv_validator_key.json", configDir), secretFilePerm)
 	if err != nil {
@@ -200,6 +232,7 @@ func InitHeimdallConfigWith(homeDir string, heimdallConfigFilePath string) {
 	privVal := privval.LoadFilePV(filepath.Join(configDir, "priv_validator_key.json"), filepath.Join(configDir, "priv_validator_key.json"))
 	cdc.MustUnmarshalBinaryBare(privVal.Key.PrivKey.Bytes(), &privObject)
 	cdc.MustUnmarshalBinaryBare(privObject.PubKey().Bytes(), &pubObject)
+	signerObject = NewLocalSigner(privObject)
 }
 
 // GetDefaultHeimdallConfig returns configration with default params
@@ -283,6 +316,14 @@ func GetECDSAPrivKey() *ecdsa.PrivateKey {
 	return ecdsaPrivateKey
 }
 
+// GetSigner returns signer of heimdall and mainchain txs
+func GetSigner() Signer {
+	if signerObject == nil {
+		return NewLocalSigner(GetPrivKey())
+	}
+	return signerObject
+}
+
 // GetPubKey returns pub key object
 func GetPubKey() secp256k1.PubKeySecp256k1 {
 	return pubObject
@@ -890,6 +890,69 @@ func (_m *IContractCaller) GetValidatorSetInstance(validatorSetAddress common.Ad
 	return r0, r1
 }
 
+// HasMainChainCode provides a mock function with given fields: address
+func (_m *IContractCaller) HasMainChainCode(address common.Address) (bool, error) {
+	ret := _m.Called(address)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Address) bool); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasMaticChainCode provides a mock function with given fields: address
+func (_m *IContractCaller) HasMaticChainCode(address common.Address) (bool, error) {
+	ret := _m.Called(address)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Address) bool); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsStateSynced provides a mock function with given fields: stateReceiverAddress, stateID
+func (_m *IContractCaller) IsStateSynced(stateReceiverAddress common.Address, stateID *big.Int) (bool, error) {
+	ret := _m.Called(stateReceiverAddress, stateID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int) bool); ok {
+		r0 = rf(stateReceiverAddress, stateID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int) error); ok {
+		r1 = rf(stateReceiverAddress, stateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsTxConfirmed provides a mock function with given fields: _a0, _a1
 func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash, _a1 uint64) bool {
 	ret := _m.Called(_a0, _a1)
@@ -0,0 +1,301 @@
+package helper
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	ethCrypto "github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/rlp"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/privval"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+)
+
+// SignerServiceName is the rpc service name of remote signer
+const SignerServiceName = "Signer"
+
+// SignerDialTimeout is the timeout to connect remote signer
+const SignerDialTimeout = 10 * time.Second
+
+// Signer signs heimdall and mainchain txs without exposing the private key.
+type Signer interface {
+	// PubKey returns the public key of signer
+	PubKey() secp256k1.PubKeySecp256k1
+
+	// SignStdSignMsg signs heimdall tx
+	SignStdSignMsg(msg authTypes.StdSignMsg) (authTypes.StdSignature, error)
+
+	// SignEthTx signs mainchain tx with homestead signer, as used by abi bindings
+	SignEthTx(tx *ethTypes.Transaction) (*ethTypes.Transaction, error)
+}
+
+// NewTransactOpts returns mainchain transact options signed by signer
+func NewTransactOpts(signer Signer) *bind.TransactOpts {
+	from := common.BytesToAddress(signer.PubKey().Address().Bytes())
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(_ ethTypes.Signer, address common.Address, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+			if address != from {
+				return nil, errors.New("not authorized to sign this account")
+			}
+			return signer.SignEthTx(tx)
+		},
+	}
+}
+
+//
+// Local signer
+//
+
+// LocalSigner signs with private key held in process
+type LocalSigner struct {
+	privKey secp256k1.PrivKeySecp256k1
+}
+
+var _ Signer = (*LocalSigner)(nil)
+
+// NewLocalSigner creates signer for private key
+func NewLocalSigner(privKey secp256k1.PrivKeySecp256k1) *LocalSigner {
+	return &LocalSigner{privKey: privKey}
+}
+
+// NewLocalSignerFromFile creates signer for private key in priv_validator_key.json
+func NewLocalSignerFromFile(keyFilePath string) (*LocalSigner, error) {
+	var privKey secp256k1.PrivKeySecp256k1
+
+	privVal := privval.LoadFilePV(keyFilePath, keyFilePath)
+	if err := cdc.UnmarshalBinaryBare(privVal.Key.PrivKey.Bytes(), &privKey); err != nil {
+		return nil, err
+	}
+
+	return NewLocalSigner(privKey), nil
+}
+
+// PubKey returns the public key of signer
+func (s *LocalSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.privKey.PubKey().(secp256k1.PubKeySecp256k1)
+}
+
+// SignStdSignMsg signs heimdall tx
+func (s *LocalSigner) SignStdSignMsg(msg authTypes.StdSignMsg) (authTypes.StdSignature, error) {
+	return authTypes.MakeSignature(s.privKey, msg)
+}
+
+// SignEthTx signs mainchain tx with homestead signer
+func (s *LocalSigner) SignEthTx(tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	ecdsaPrivateKey, err := ethCrypto.ToECDSA(s.privKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return ethTypes.SignTx(tx, ethTypes.HomesteadSigner{}, ecdsaPrivateKey)
+}
+
+//
+// Remote signer
+//
+
+// SignerPubKeyRequest requests public key of remote signer
+type SignerPubKeyRequest struct{}
+
+// SignerPubKeyResponse returns public key of remote signer
+type SignerPubKeyResponse struct {
+	PubKey []byte `json:"pub_key"`
+}
+
+// SignerTxRequest requests signature of heimdall tx sign bytes. Sign bytes carry
+// amino JSON encoded msg, which signer decodes to apply its policy.
+type SignerTxRequest struct {
+	SignBytes []byte `json:"sign_bytes"`
+}
+
+// SignerEthTxRequest requests signature of RLP encoded mainchain tx
+type SignerEthTxRequest struct {
+	Tx []byte `json:"tx"`
+}
+
+// SignerSignatureResponse returns signature
+type SignerSignatureResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// RemoteSigner signs with key held by remote heimdall-signer process
+type RemoteSigner struct {
+	address   string
+	tlsConfig *tls.Config
+	pubKey    secp256k1.PubKeySecp256k1
+
+	mu     sync.Mutex
+	client *rpc.Client
+}
+
+var _ Signer = (*RemoteSigner)(nil)
+
+// NewRemoteSigner connects remote signer at unix://<path> or tcp://<host:port>.
+// TCP connections require TLS config with client certificate for mutual auth.
+func NewRemoteSigner(address string, tlsConfig *tls.Config) (*RemoteSigner, error) {
+	s := &RemoteSigner{
+		address:   address,
+		tlsConfig: tlsConfig,
+	}
+
+	var res SignerPubKeyResponse
+	if err := s.call("PubKey", SignerPubKeyRequest{}, &res); err != nil {
+		return nil, err
+	}
+
+	if len(res.PubKey) != len(s.pubKey) {
+		return nil, errors.New("invalid public key from remote signer")
+	}
+	copy(s.pubKey[:], res.PubKey)
+
+	return s, nil
+}
+
+// PubKey returns the public key of signer
+func (s *RemoteSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+// SignStdSignMsg signs heimdall tx
+func (s *RemoteSigner) SignStdSignMsg(msg authTypes.StdSignMsg) (authTypes.StdSignature, error) {
+	signBytes := msg.Bytes()
+
+	var res SignerSignatureResponse
+	if err := s.call("SignTx", SignerTxRequest{SignBytes: signBytes}, &res); err != nil {
+		return nil, err
+	}
+
+	// verify that signature is made by signer over the msg
+	p, err := authTypes.RecoverPubkey(signBytes, res.Signature)
+	if err != nil || !bytes.Equal(ethCrypto.Keccak256(p[1:])[12:], s.pubKey.Address().Bytes()) {
+		return nil, errors.New("invalid signature from remote signer")
+	}
+
+	return res.Signature, nil
+}
+
+// SignEthTx signs mainchain tx with homestead signer
+func (s *RemoteSigner) SignEthTx(tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	bz, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res SignerSignatureResponse
+	if err := s.call("SignEthTx", SignerEthTxRequest{Tx: bz}, &res); err != nil {
+		return nil, err
+	}
+
+	signedTx, err := tx.WithSignature(ethTypes.HomesteadSigner{}, res.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify that tx is signed by signer
+	from, err := ethTypes.Sender(ethTypes.HomesteadSigner{}, signedTx)
+	if err != nil || from != common.BytesToAddress(s.pubKey.Address().Bytes()) {
+		return nil, errors.New("invalid signature from remote signer")
+	}
+
+	return signedTx, nil
+}
+
+// call calls remote signer method, reconnecting if connection was closed
+func (s *RemoteSigner) call(method string, args interface{}, reply interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		conn, err := DialSigner(s.address, s.tlsConfig)
+		if err != nil {
+			return err
+		}
+		s.client = jsonrpc.NewClient(conn)
+	}
+
+	err := s.client.Call(SignerServiceName+"."+method, args, reply)
+	if err == rpc.ErrShutdown {
+		s.client.Close()
+		s.client = nil
+	}
+
+	return err
+}
+
+// ParseSignerAddress splits signer address into network and address
+func ParseSignerAddress(address string) (network string, addr string, err error) {
+	switch {
+	case strings.HasPrefix(address, "unix://"):
+		return "unix", strings.TrimPrefix(address, "unix://"), nil
+	case strings.HasPrefix(address, "tcp://"):
+		return "tcp", strings.TrimPrefix(address, "tcp://"), nil
+	default:
+		return "", "", fmt.Errorf("invalid signer address %s, expected unix://<path> or tcp://<host:port>", address)
+	}
+}
+
+// DialSigner connects to remote signer
+func DialSigner(address string, tlsConfig *tls.Config) (net.Conn, error) {
+	network, addr, err := ParseSignerAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if network == "unix" {
+		return net.DialTimeout(network, addr, SignerDialTimeout)
+	}
+
+	if tlsConfig == nil {
+		return nil, errors.New("TLS config is required for tcp signer")
+	}
+
+	return tls.DialWithDialer(&net.Dialer{Timeout: SignerDialTimeout}, network, addr, tlsConfig)
+}
+
+// NewSignerTLSConfig creates TLS config for mutual auth between signer and its clients,
+// both sides present certificate signed by given CA
+func NewSignerTLSConfig(certFile string, keyFile string, caFile string, server bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	caPEM, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("unable to parse signer CA certificate")
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if server {
+		tlsConfig.ClientCAs = caPool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		tlsConfig.RootCAs = caPool
+	}
+
+	return tlsConfig, nil
+}
@@ -45,6 +45,20 @@ main_chain_gas_limit = "{{ .MainchainGasLimit }}"
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
+##### Fee grant #####
+# Account paying fees of bridge txs from its fee allowance, empty to pay from signer
+fee_granter = "{{ .FeeGranter }}"
+
+##### Remote signer #####
+# heimdall-signer holding the validator key, unix://<path> or tcp://<host:port>.
+# Empty to sign with priv_validator_key.json
+signer_address = "{{ .SignerAddress }}"
+
+# TLS client certificate, key and CA for tcp signer
+signer_tls_cert = "{{ .SignerTLSCert }}"
+signer_tls_key = "{{ .SignerTLSKey }}"
+signer_tls_ca = "{{ .SignerTLSCA }}"
+
 `
 
 var configTemplate *template.Template
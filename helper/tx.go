@@ -8,7 +8,6 @@ import (
 	ethereum "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/accounts/abi/bind"
 	"github.com/maticnetwork/bor/common"
-	"github.com/maticnetwork/bor/crypto"
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/heimdall/contracts/erc20"
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
@@ -23,17 +22,11 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 		Data: data,
 	}
 
-	// get priv key
-	pkObject := GetPrivKey()
-
-	// create ecdsa private key
-	ecdsaPrivateKey, err := crypto.ToECDSA(pkObject[:])
-	if err != nil {
-		return
-	}
+	// get signer
+	signer := GetSigner()
 
 	// from address
-	fromAddress := common.BytesToAddress(pkObject.PubKey().Address().Bytes())
+	fromAddress := common.BytesToAddress(signer.PubKey().Address().Bytes())
 	// fetch gas price
 	gasprice, err := client.SuggestGasPrice(context.Background())
 	if err != nil {
@@ -50,7 +43,7 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	gasLimit, err := client.EstimateGas(context.Background(), callMsg)
 
 	// create auth
-	auth = bind.NewKeyedTransactor(ecdsaPrivateKey)
+	auth = NewTransactOpts(signer)
 	auth.GasPrice = gasprice
 	auth.Nonce = big.NewInt(int64(nonce))
 	auth.GasLimit = uint64(gasLimit) // uint64(gasLimit)
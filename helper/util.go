@@ -31,6 +31,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmTypes "github.com/tendermint/tendermint/types"
@@ -89,12 +90,20 @@ func Paginate(numObjs, page, limit, defLimit int) (start, end int) {
 	return start, end
 }
 
-// GetUpdatedValidators updates validators in validator set
+// GetUpdatedValidators updates validators in validator set.
+// Only the top maxValidators current validators (by power) are admitted to the set.
 func GetUpdatedValidators(
 	currentSet *hmTypes.ValidatorSet,
 	validators []*hmTypes.Validator,
 	ackCount uint64,
+	maxValidators uint64,
 ) []*hmTypes.Validator {
+	// validators which should be part of the set
+	active := make(map[hmTypes.ValidatorID]bool)
+	for _, v := range GetActiveValidators(validators, ackCount, maxValidators) {
+		active[v.ID] = true
+	}
+
 	updates := make([]*hmTypes.Validator, 0)
 	for _, v := range validators {
 		// create copy of validator
@@ -102,11 +111,11 @@ func GetUpdatedValidators(
 
 		address := validator.Signer.Bytes()
 		_, val := currentSet.GetByAddress(address)
-		if val != nil && !validator.IsCurrentValidator(ackCount) {
+		if val != nil && !active[validator.ID] {
 			// remove validator
 			validator.VotingPower = 0
 			updates = append(updates, validator)
-		} else if val == nil && validator.IsCurrentValidator(ackCount) {
+		} else if val == nil && active[validator.ID] {
 			// add validator
 			updates = append(updates, validator)
 		} else if val != nil && validator.VotingPower != val.VotingPower {
@@ -117,6 +126,28 @@ func GetUpdatedValidators(
 	return updates
 }
 
+// GetActiveValidators returns the top maxValidators current validators ranked by voting power.
+// Ties are broken by lower validator ID.
+func GetActiveValidators(
+	validators []*hmTypes.Validator,
+	ackCount uint64,
+	maxValidators uint64,
+) []*hmTypes.Validator {
+	candidates := make([]*hmTypes.Validator, 0, len(validators))
+	for _, v := range validators {
+		if v.IsCurrentValidator(ackCount) {
+			candidates = append(candidates, v)
+		}
+	}
+
+	hmTypes.SortValidatorByPower(candidates)
+	if uint64(len(candidates)) > maxValidators {
+		candidates = candidates[:maxValidators]
+	}
+
+	return candidates
+}
+
 // GetPkObjects from crypto priv key
 func GetPkObjects(privKey crypto.PrivKey) (secp256k1.PrivKeySecp256k1, secp256k1.PubKeySecp256k1) {
 	var privObject secp256k1.PrivKeySecp256k1
@@ -348,7 +379,7 @@ func GetSignedTxBytes(cliCtx context.CLIContext, txBldr authTypes.TxBuilder, msg
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return buildAndSignWithSigner(txBldr, GetSigner(), msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -398,7 +429,7 @@ func GetSignedTxBytesWithCLI(cliCtx context.CLIContext, txBldr authTypes.TxBuild
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return buildAndSignWithSigner(txBldr, GetSigner(), msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -495,6 +526,60 @@ func PrintUnsignedStdTx(cliCtx context.CLIContext, txBldr authTypes.TxBuilder, m
 // Don't perform online validation or lookups if offline is true.
 func SignStdTx(
 	cliCtx context.CLIContext, stdTx authTypes.StdTx, appendSig bool, offline bool,
+) (authTypes.StdTx, error) {
+	return signStdTx(cliCtx, stdTx, appendSig, offline, nil)
+}
+
+// SignStdTxForMultisig signs a StdTx with the local key on behalf of the given
+// multisig account and returns the member signature. Account number and sequence
+// are looked up for the multisig account unless offline is true.
+func SignStdTxForMultisig(
+	cliCtx context.CLIContext, stdTx authTypes.StdTx, multisigAddr []byte, offline bool,
+) (authTypes.StdSignature, error) {
+	signedStdTx, err := signStdTx(cliCtx, stdTx, false, offline, multisigAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return signedStdTx.Signature, nil
+}
+
+// MultiSignStdTx combines member signatures into a StdTx signed by the multisig account.
+// Don't perform online lookups if offline is true.
+func MultiSignStdTx(
+	cliCtx context.CLIContext,
+	stdTx authTypes.StdTx,
+	multisigPubKey multisig.PubKeyMultisigThreshold,
+	sigs []authTypes.StdSignature,
+	offline bool,
+) (authTypes.StdTx, error) {
+	txBldr := authTypes.NewTxBuilderFromCLI().WithTxEncoder(GetTxEncoder(cliCtx.Codec))
+
+	if !offline {
+		var err error
+		txBldr, err = populateAccountFromState(txBldr, cliCtx, multisigPubKey.Address().Bytes())
+		if err != nil {
+			return authTypes.StdTx{}, err
+		}
+	}
+
+	signBytes := authTypes.StdSignBytesWithFeeGranter(txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(), stdTx.Msg, stdTx.Memo, stdTx.FeeGranter)
+	multiSig := authTypes.NewMultiSignature(multisigPubKey)
+	for _, sig := range sigs {
+		if err := multiSig.AddSignature(sig, signBytes); err != nil {
+			return authTypes.StdTx{}, err
+		}
+	}
+
+	if err := multiSig.Verify(signBytes); err != nil {
+		return authTypes.StdTx{}, err
+	}
+
+	return authTypes.NewStdTx(stdTx.Msg, multiSig.Bytes(), stdTx.Memo).WithFeeGranter(stdTx.FeeGranter), nil
+}
+
+func signStdTx(
+	cliCtx context.CLIContext, stdTx authTypes.StdTx, appendSig bool, offline bool, accAddr []byte,
 ) (authTypes.StdTx, error) {
 	txBldr := authTypes.NewTxBuilderFromCLI().WithTxEncoder(GetTxEncoder(cliCtx.Codec))
 
@@ -513,6 +598,11 @@ func SignStdTx(
 		addr = info.GetPubKey().Address().Bytes()
 	}
 
+	// sign on behalf of other (multisig) account
+	if len(accAddr) > 0 {
+		addr = accAddr
+	}
+
 	if !offline {
 		var err error
 		txBldr, err = populateAccountFromState(txBldr, cliCtx, addr)
@@ -531,7 +621,44 @@ func SignStdTx(
 		return txBldr.SignStdTxWithPassphrase(fromName, passphrase, stdTx, appendSig)
 	}
 
-	return txBldr.SignStdTx(GetPrivKey(), stdTx, appendSig)
+	return signStdTxWithSigner(txBldr, GetSigner(), stdTx)
+}
+
+// buildAndSignWithSigner builds a single message to be signed, signs it with signer
+// and returns encoded tx
+func buildAndSignWithSigner(txBldr authTypes.TxBuilder, signer Signer, msgs []sdk.Msg) ([]byte, error) {
+	stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := signer.SignStdSignMsg(stdSignMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return txBldr.GetStdTxBytes(authTypes.NewStdTx(stdSignMsg.Msg, sig, stdSignMsg.Memo).WithFeeGranter(stdSignMsg.FeeGranter))
+}
+
+// signStdTxWithSigner signs StdTx with signer, replacing the signature attached
+func signStdTxWithSigner(txBldr authTypes.TxBuilder, signer Signer, stdTx authTypes.StdTx) (signedStdTx authTypes.StdTx, err error) {
+	if txBldr.ChainID() == "" {
+		return signedStdTx, fmt.Errorf("chain ID required but not specified")
+	}
+
+	sig, err := signer.SignStdSignMsg(authTypes.StdSignMsg{
+		ChainID:       txBldr.ChainID(),
+		AccountNumber: txBldr.AccountNumber(),
+		Sequence:      txBldr.Sequence(),
+		Memo:          stdTx.Memo,
+		Msg:           stdTx.Msg,
+		FeeGranter:    stdTx.FeeGranter,
+	})
+	if err != nil {
+		return signedStdTx, err
+	}
+
+	return authTypes.NewStdTx(stdTx.Msg, sig, stdTx.Memo).WithFeeGranter(stdTx.FeeGranter), nil
 }
 
 // ReadStdTxFromFile and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
@@ -658,7 +785,7 @@ func buildUnsignedStdTxOffline(txBldr authTypes.TxBuilder, cliCtx context.CLICon
 		return stdTx, err
 	}
 
-	return authTypes.NewStdTx(stdSignMsg.Msg, nil, stdSignMsg.Memo), nil
+	return authTypes.NewStdTx(stdSignMsg.Msg, nil, stdSignMsg.Memo).WithFeeGranter(stdSignMsg.FeeGranter), nil
 }
 
 // getSplitPoint returns the largest power of 2 less than length
@@ -0,0 +1,42 @@
+// Package golden checks genesis migrations against the files in the
+// testdata dir of the migration package.
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files of genesis migrations")
+
+// Check migrates testdata/<from>.json and compares the result with
+// testdata/<to>.json, which is rewritten when running with -update.
+// It returns the migrated genesis state.
+func Check(t *testing.T, from, to string, migrate func(json.RawMessage) (json.RawMessage, error)) json.RawMessage {
+	input, err := ioutil.ReadFile(filepath.Join("testdata", from+".json"))
+	require.NoError(t, err)
+
+	migrated, err := migrate(input)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, json.Indent(&out, migrated, "", "  "))
+	out.WriteByte('\n')
+
+	goldenFile := filepath.Join("testdata", to+".json")
+	if *update {
+		require.NoError(t, ioutil.WriteFile(goldenFile, out.Bytes(), 0644))
+	}
+
+	expected, err := ioutil.ReadFile(goldenFile)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), out.String(), "run with -update to regenerate %s", goldenFile)
+
+	return migrated
+}
@@ -0,0 +1,137 @@
+// Package migrate holds the types shared by the genesis migrations between
+// Heimdall versions. Each module converts its own genesis state in its
+// legacy/v<version> package, the app level migration of a version calls them.
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+type (
+	// AppMap is the app state of a genesis file keyed by module name
+	AppMap map[string]json.RawMessage
+
+	// MigrationCallback converts the app state of a genesis file to the
+	// version it is registered for
+	MigrationCallback func(AppMap) (AppMap, error)
+
+	// MigrationMap maps a target version to its migration
+	MigrationMap map[string]MigrationCallback
+)
+
+var (
+	// EmptyList is the JSON encoding of an empty list
+	EmptyList = json.RawMessage("[]")
+
+	// EmptyObject is the JSON encoding of an empty object
+	EmptyObject = json.RawMessage("{}")
+)
+
+// amino encodes 64 bit integers as strings
+var cdc = codec.New()
+
+// Object is a JSON object with its fields left undecoded, so a migration
+// only touches the fields which changed
+type Object map[string]json.RawMessage
+
+// DecodeObject decodes a JSON object, null decodes to an empty object
+func DecodeObject(bz json.RawMessage) (Object, error) {
+	o := Object{}
+	if err := json.Unmarshal(bz, &o); err != nil {
+		return nil, err
+	}
+
+	if o == nil {
+		o = Object{}
+	}
+
+	return o, nil
+}
+
+// Has returns true if the field is present and not null
+func (o Object) Has(key string) bool {
+	value, ok := o[key]
+	return ok && string(value) != "null"
+}
+
+// Get decodes the field into ptr, leaving ptr untouched if the field is not set
+func (o Object) Get(key string, ptr interface{}) error {
+	if !o.Has(key) {
+		return nil
+	}
+
+	if err := cdc.UnmarshalJSON(o[key], ptr); err != nil {
+		return fmt.Errorf("%s: %v", key, err)
+	}
+
+	return nil
+}
+
+// List returns the elements of a list field
+func (o Object) List(key string) ([]json.RawMessage, error) {
+	var list []json.RawMessage
+	if !o.Has(key) {
+		return list, nil
+	}
+
+	if err := json.Unmarshal(o[key], &list); err != nil {
+		return nil, fmt.Errorf("%s: %v", key, err)
+	}
+
+	return list, nil
+}
+
+// Object returns the field as an object
+func (o Object) Object(key string) (Object, error) {
+	if !o.Has(key) {
+		return Object{}, nil
+	}
+
+	obj, err := DecodeObject(o[key])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", key, err)
+	}
+
+	return obj, nil
+}
+
+// Set encodes value into the field
+func (o Object) Set(key string, value interface{}) error {
+	var bz []byte
+	var err error
+
+	switch v := value.(type) {
+	case Object:
+		bz, err = v.Marshal()
+	case json.RawMessage:
+		bz = v
+	case []json.RawMessage:
+		bz, err = json.Marshal(v)
+	default:
+		bz, err = cdc.MarshalJSON(value)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %v", key, err)
+	}
+
+	o[key] = bz
+	return nil
+}
+
+// SetDefault encodes value into the field if it is not set
+func (o Object) SetDefault(key string, value interface{}) error {
+	if o.Has(key) {
+		return nil
+	}
+
+	return o.Set(key, value)
+}
+
+// Marshal encodes the object with its fields sorted
+func (o Object) Marshal() (json.RawMessage, error) {
+	return json.Marshal(map[string]json.RawMessage(o))
+}
@@ -0,0 +1,100 @@
+package v0_3
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authV03 "github.com/maticnetwork/heimdall/auth/legacy/v0_3"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	borV03 "github.com/maticnetwork/heimdall/bor/legacy/v0_3"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	checkpointV03 "github.com/maticnetwork/heimdall/checkpoint/legacy/v0_3"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkV03 "github.com/maticnetwork/heimdall/clerk/legacy/v0_3"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	feegrantTypes "github.com/maticnetwork/heimdall/feegrant/types"
+	"github.com/maticnetwork/heimdall/migrate"
+	sidechannelV03 "github.com/maticnetwork/heimdall/sidechannel/legacy/v0_3"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	slashingV03 "github.com/maticnetwork/heimdall/slashing/legacy/v0_3"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingV03 "github.com/maticnetwork/heimdall/staking/legacy/v0_3"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	supplyV03 "github.com/maticnetwork/heimdall/supply/legacy/v0_3"
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+	treasuryTypes "github.com/maticnetwork/heimdall/treasury/types"
+)
+
+// module migrations from v0.2 to v0.3
+var migrations = []struct {
+	module  string
+	migrate func(json.RawMessage) (json.RawMessage, error)
+}{
+	{authTypes.ModuleName, authV03.Migrate},
+	{borTypes.ModuleName, borV03.Migrate},
+	{checkpointTypes.ModuleName, checkpointV03.Migrate},
+	{clerkTypes.ModuleName, clerkV03.Migrate},
+	{sidechannelTypes.ModuleName, sidechannelV03.Migrate},
+	{slashingTypes.ModuleName, slashingV03.Migrate},
+	{stakingTypes.ModuleName, stakingV03.Migrate},
+	{supplyTypes.ModuleName, supplyV03.Migrate},
+}
+
+// Migrate converts a v0.2 app state to v0.3. Modules missing from the app
+// state are left out, the treasury and fee grant modules added in v0.3 start
+// empty.
+func Migrate(appState migrate.AppMap) (migrate.AppMap, error) {
+	newAppState := make(migrate.AppMap, len(appState)+2)
+	for module, genState := range appState {
+		newAppState[module] = genState
+	}
+
+	for _, m := range migrations {
+		if appState[m.module] == nil {
+			continue
+		}
+
+		genState, err := m.migrate(appState[m.module])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", m.module, err)
+		}
+		newAppState[m.module] = genState
+	}
+
+	if newAppState[treasuryTypes.ModuleName] == nil {
+		treasury := migrate.Object{}
+		params := migrate.Object{}
+		if err := params.Set("fee_fraction", sdk.ZeroDec()); err != nil {
+			return nil, err
+		}
+		if err := treasury.Set("params", params); err != nil {
+			return nil, err
+		}
+		if err := treasury.Set("spends", migrate.EmptyList); err != nil {
+			return nil, err
+		}
+
+		genState, err := treasury.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		newAppState[treasuryTypes.ModuleName] = genState
+	}
+
+	if newAppState[feegrantTypes.ModuleName] == nil {
+		feegrant := migrate.Object{}
+		if err := feegrant.Set("allowances", migrate.EmptyList); err != nil {
+			return nil, err
+		}
+
+		genState, err := feegrant.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		newAppState[feegrantTypes.ModuleName] = genState
+	}
+
+	return newAppState, nil
+}
@@ -0,0 +1,80 @@
+package v0_3_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/app"
+	authV03 "github.com/maticnetwork/heimdall/auth/legacy/v0_3"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	borV03 "github.com/maticnetwork/heimdall/bor/legacy/v0_3"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	checkpointV03 "github.com/maticnetwork/heimdall/checkpoint/legacy/v0_3"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkV03 "github.com/maticnetwork/heimdall/clerk/legacy/v0_3"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/migrate"
+	"github.com/maticnetwork/heimdall/migrate/golden"
+	"github.com/maticnetwork/heimdall/migrate/v0_3"
+	sidechannelV03 "github.com/maticnetwork/heimdall/sidechannel/legacy/v0_3"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	slashingV03 "github.com/maticnetwork/heimdall/slashing/legacy/v0_3"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingV03 "github.com/maticnetwork/heimdall/staking/legacy/v0_3"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	supplyV03 "github.com/maticnetwork/heimdall/supply/legacy/v0_3"
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+)
+
+func migrateAppState(bz json.RawMessage) (json.RawMessage, error) {
+	var appState migrate.AppMap
+	if err := json.Unmarshal(bz, &appState); err != nil {
+		return nil, err
+	}
+
+	newAppState, err := v0_3.Migrate(appState)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(newAppState)
+}
+
+func TestMigrate(t *testing.T) {
+	migrated := golden.Check(t, "v0_2", "v0_3", migrateAppState)
+
+	// migrated app state is valid for every module
+	var genesisState app.GenesisState
+	require.NoError(t, json.Unmarshal(migrated, &genesisState))
+	require.NoError(t, app.ModuleBasics.ValidateGenesis(genesisState))
+}
+
+func TestMigrateModules(t *testing.T) {
+	// goldens of module migrations are in testdata/<module>
+	testCases := []struct {
+		module  string
+		migrate func(json.RawMessage) (json.RawMessage, error)
+	}{
+		{authTypes.ModuleName, authV03.Migrate},
+		{borTypes.ModuleName, borV03.Migrate},
+		{checkpointTypes.ModuleName, checkpointV03.Migrate},
+		{clerkTypes.ModuleName, clerkV03.Migrate},
+		{sidechannelTypes.ModuleName, sidechannelV03.Migrate},
+		{slashingTypes.ModuleName, slashingV03.Migrate},
+		{stakingTypes.ModuleName, stakingV03.Migrate},
+		{supplyTypes.ModuleName, supplyV03.Migrate},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.module, func(t *testing.T) {
+			migrated := golden.Check(t, filepath.Join(tc.module, "v0_2"), filepath.Join(tc.module, "v0_3"), tc.migrate)
+
+			// migrated genesis state is valid for the module
+			require.NoError(t, app.ModuleBasics[tc.module].ValidateGenesis(migrated))
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/maticnetwork/bor/common"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+const (
+	flagPage  = "page"
+	flagLimit = "limit"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the sidechannel module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetQueryVoteRecord(cdc),
+			GetQueryValidatorVotes(cdc),
+		)...,
+	)
+	return queryCmd
+}
+
+// GetQueryVoteRecord implements the side-tx vote record query command.
+func GetQueryVoteRecord(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "votes [tx-hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show validator votes on side-tx",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query outcome and validator votes of side-tx.
+
+Example:
+$ %s query sidechannel votes <tx-hash>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txHash := common.FromHex(args[0])
+			if len(txHash) == 0 {
+				return errors.New("Invalid tx hash")
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryVoteRecordParams(txHash))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryVoteRecord)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetQueryValidatorVotes implements the validator side-tx votes query command.
+func GetQueryValidatorVotes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-votes [validator-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show side-tx votes of validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query recent side-tx votes of validator, latest first.
+
+Example:
+$ %s query sidechannel validator-votes 1 --page 1 --limit 20
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorVotesParams(
+				hmTypes.NewValidatorID(validatorID),
+				viper.GetInt(flagPage),
+				viper.GetInt(flagLimit),
+			))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorVotes)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagPage, 1, "Query a specific page of votes")
+	cmd.Flags().Int(flagLimit, 30, "Query number of votes per page")
+	return cmd
+}
@@ -11,6 +11,8 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
 	for _, pastCommit := range data.PastCommits {
 		// set all txs
 		if len(pastCommit.Txs) > 0 {
@@ -19,6 +21,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 			}
 		}
 	}
+
+	// set staged side-tx retries
+	for _, retry := range data.SideTxRetries {
+		if err := keeper.SetSideTxRetry(ctx, retry); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -47,5 +56,10 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		return result[i].Height < result[j].Height
 	})
 
-	return types.NewGenesisState(result)
+	retries := keeper.GetSideTxRetries(ctx)
+	if retries == nil {
+		retries = make([]types.SideTxRetry, 0)
+	}
+
+	return types.NewGenesisState(keeper.GetParams(ctx), result, retries)
 }
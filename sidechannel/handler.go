@@ -0,0 +1,60 @@
+package sidechannel
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+)
+
+// NewHandler returns a handler for "sidechannel" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgRetrySideTx:
+			return HandleMsgRetrySideTx(ctx, k, msg)
+		default:
+			return sdk.ErrUnknownRequest("Unrecognized sidechannel msg type").Result()
+		}
+	}
+}
+
+// HandleMsgRetrySideTx puts staged side-tx into vote round of current block
+func HandleMsgRetrySideTx(ctx sdk.Context, k Keeper, msg types.MsgRetrySideTx) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating side-tx retry msg",
+		"proposer", msg.Proposer.String(),
+		"txHash", msg.TxHash.String(),
+	)
+
+	retry, found := k.GetSideTxRetry(ctx, msg.TxHash)
+	if !found {
+		return hmCommon.ErrSideTxRetryNotFound(k.Codespace()).Result()
+	}
+
+	// only one vote round at a time, and not before backoff is over
+	if retry.InFlight || retry.NextHeight > ctx.BlockHeight() {
+		return hmCommon.ErrSideTxRetryNotDue(k.Codespace()).Result()
+	}
+
+	retry.InFlight = true
+	if err := k.SetSideTxRetry(ctx, retry); err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTxHash, retry.TxHash.String()),
+			sdk.NewAttribute(types.AttributeKeyRetry, strconv.FormatUint(retry.Retries, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,79 @@
+package sidechannel_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/sidechannel"
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// HandlerTestSuite integrate test suite context object
+type HandlerTestSuite struct {
+	suite.Suite
+
+	app     *app.HeimdallApp
+	ctx     sdk.Context
+	handler sdk.Handler
+}
+
+// SetupTest setup all necessary things for handler tesing
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+	suite.handler = sidechannel.NewHandler(suite.app.SidechannelKeeper)
+}
+
+// TestHandlerTestSuite
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgUnknown() {
+	t, ctx := suite.T(), suite.ctx
+
+	result := suite.handler(ctx, nil)
+	require.False(t, result.IsOK())
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgRetrySideTx() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.SidechannelKeeper
+
+	tx := tmTypes.Tx([]byte("transaction-1"))
+	proposer := hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000001")
+	msg := types.NewMsgRetrySideTx(proposer, tx.Hash())
+
+	t.Run("NotFound", func(t *testing.T) {
+		result := suite.handler(ctx.WithBlockHeight(110), msg)
+		require.Equal(t, common.CodeSideTxRetryNotFound, result.Code)
+	})
+
+	err := keeper.SetSideTxRetry(ctx, types.NewSideTxRetry(tx, 1, 110))
+	require.NoError(t, err)
+
+	t.Run("NotDue", func(t *testing.T) {
+		result := suite.handler(ctx.WithBlockHeight(109), msg)
+		require.Equal(t, common.CodeSideTxRetryNotDue, result.Code)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		result := suite.handler(ctx.WithBlockHeight(110), msg)
+		require.True(t, result.IsOK(), result.Log)
+
+		retry, found := keeper.GetSideTxRetry(ctx, tx.Hash())
+		require.True(t, found)
+		require.True(t, retry.InFlight)
+	})
+
+	t.Run("InFlight", func(t *testing.T) {
+		result := suite.handler(ctx.WithBlockHeight(111), msg)
+		require.Equal(t, common.CodeSideTxRetryNotDue, result.Code)
+	})
+}
@@ -3,6 +3,8 @@ package sidechannel
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -36,7 +39,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:        cdc,
 		key:        storeKey,
-		paramSpace: paramSpace,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:  codespace,
 	}
 }
@@ -51,6 +54,21 @@ func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
+//
+// Params methods
+//
+
+// SetParams sets the sidechannel module's parameters.
+func (keeper Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	keeper.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the sidechannel module's parameters.
+func (keeper Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	keeper.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
 //
 // Txs methods
 //
@@ -132,6 +150,287 @@ func (keeper Keeper) RemoveValidators(ctx sdk.Context, height int64) {
 	store.Delete(types.ValidatorsKey(height))
 }
 
+//
+// Tx voters methods
+//
+
+// SetTxVoters sets validators who voted yes on side-tx
+func (keeper Keeper) SetTxVoters(ctx sdk.Context, hash []byte, validators []abci.Validator) error {
+	store := ctx.KVStore(keeper.key)
+
+	// marshal validators
+	bz, err := keeper.cdc.MarshalBinaryBare(validators)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.TxVotersKey(hash), bz)
+	return nil
+}
+
+// GetTxVoters returns validators who voted yes on side-tx
+func (keeper Keeper) GetTxVoters(ctx sdk.Context, hash []byte) (validators []abci.Validator) {
+	store := ctx.KVStore(keeper.key)
+
+	// unmarshal validators if exists
+	if bz := store.Get(types.TxVotersKey(hash)); bz != nil {
+		keeper.cdc.UnmarshalBinaryBare(bz, &validators)
+	}
+
+	return
+}
+
+// RemoveTxVoters removes yes voters of side-tx
+func (keeper Keeper) RemoveTxVoters(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(keeper.key)
+	store.Delete(types.TxVotersKey(hash))
+}
+
+//
+// Vote records methods
+//
+
+// SetVoteRecord sets vote record of side-tx
+func (keeper Keeper) SetVoteRecord(ctx sdk.Context, record types.SideTxVoteRecord) error {
+	store := ctx.KVStore(keeper.key)
+
+	bz, err := keeper.cdc.MarshalBinaryBare(record)
+	if err != nil {
+		return err
+	}
+
+	// remove previous record of same tx
+	keeper.RemoveVoteRecord(ctx, record.TxHash)
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(record.Height))
+
+	store.Set(types.VoteRecordKey(record.Height, record.TxHash), bz)
+	store.Set(types.VoteRecordHeightKey(record.TxHash), heightBytes)
+	return nil
+}
+
+// GetVoteRecord returns vote record of side-tx
+func (keeper Keeper) GetVoteRecord(ctx sdk.Context, hash []byte) (record types.SideTxVoteRecord, found bool) {
+	store := ctx.KVStore(keeper.key)
+
+	heightBytes := store.Get(types.VoteRecordHeightKey(hash))
+	if heightBytes == nil {
+		return record, false
+	}
+
+	bz := store.Get(types.VoteRecordKey(int64(binary.BigEndian.Uint64(heightBytes)), hash))
+	if bz == nil {
+		return record, false
+	}
+
+	if err := keeper.cdc.UnmarshalBinaryBare(bz, &record); err != nil {
+		return record, false
+	}
+
+	return record, true
+}
+
+// RemoveVoteRecord removes vote record of side-tx
+func (keeper Keeper) RemoveVoteRecord(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(keeper.key)
+
+	heightBytes := store.Get(types.VoteRecordHeightKey(hash))
+	if heightBytes == nil {
+		return
+	}
+
+	store.Delete(types.VoteRecordKey(int64(binary.BigEndian.Uint64(heightBytes)), hash))
+	store.Delete(types.VoteRecordHeightKey(hash))
+}
+
+// PruneVoteRecords removes vote records of side-txs included before retention window
+func (keeper Keeper) PruneVoteRecords(ctx sdk.Context, height int64) {
+	retention := keeper.GetParams(ctx).VoteRecordRetention
+	if retention == 0 {
+		return
+	}
+
+	cutoff := height - retention
+	if cutoff <= 0 {
+		return
+	}
+
+	var hashes [][]byte
+	keeper.IterateVoteRecordsAndApplyFn(ctx, func(record types.SideTxVoteRecord) error {
+		if record.Height >= cutoff {
+			return errors.New("reached retention window")
+		}
+
+		hashes = append(hashes, record.TxHash)
+		return nil
+	})
+
+	for _, hash := range hashes {
+		keeper.RemoveVoteRecord(ctx, hash)
+	}
+}
+
+// GetValidatorVotes returns side-tx votes of validator, latest first
+func (keeper Keeper) GetValidatorVotes(ctx sdk.Context, id hmTypes.ValidatorID, page int, limit int) (votes []types.ValidatorVote) {
+	start := (page - 1) * limit
+	index := 0
+
+	keeper.IterateVoteRecordsReverseAndApplyFn(ctx, func(record types.SideTxVoteRecord) error {
+		if !record.HasValidator(id) {
+			return nil
+		}
+
+		if index >= start {
+			votes = append(votes, types.NewValidatorVote(record, id))
+		}
+
+		index++
+		if len(votes) >= limit {
+			return errors.New("limit reached")
+		}
+
+		return nil
+	})
+
+	return
+}
+
+//
+// Side-tx retry methods
+//
+
+// SetSideTxRetry stages side-tx for retry
+func (keeper Keeper) SetSideTxRetry(ctx sdk.Context, retry types.SideTxRetry) error {
+	store := ctx.KVStore(keeper.key)
+
+	bz, err := keeper.cdc.MarshalBinaryBare(retry)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.SideTxRetryKey(retry.TxHash), bz)
+	return nil
+}
+
+// GetSideTxRetry returns staged side-tx retry by original tx hash
+func (keeper Keeper) GetSideTxRetry(ctx sdk.Context, hash []byte) (retry types.SideTxRetry, found bool) {
+	store := ctx.KVStore(keeper.key)
+
+	bz := store.Get(types.SideTxRetryKey(hash))
+	if bz == nil {
+		return retry, false
+	}
+
+	if err := keeper.cdc.UnmarshalBinaryBare(bz, &retry); err != nil {
+		return retry, false
+	}
+
+	return retry, true
+}
+
+// RemoveSideTxRetry removes staged side-tx retry
+func (keeper Keeper) RemoveSideTxRetry(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(keeper.key)
+	store.Delete(types.SideTxRetryKey(hash))
+}
+
+// GetSideTxRetries returns all staged side-tx retries
+func (keeper Keeper) GetSideTxRetries(ctx sdk.Context) (retries []types.SideTxRetry) {
+	keeper.IterateSideTxRetriesAndApplyFn(ctx, func(retry types.SideTxRetry) error {
+		retries = append(retries, retry)
+		return nil
+	})
+
+	return
+}
+
+// RequeueSideTx stages skipped side-tx for another vote round while retries are left.
+// It returns events marking the retry or the final give-up and if side-tx was requeued.
+func (keeper Keeper) RequeueSideTx(ctx sdk.Context, tx tmTypes.Tx) (sdk.Events, bool) {
+	params := keeper.GetParams(ctx)
+
+	retry, found := keeper.GetSideTxRetry(ctx, tx.Hash())
+	if !found {
+		retry = types.NewSideTxRetry(tx, 0, 0)
+	}
+
+	if retry.Retries >= params.MaxSideTxRetries {
+		keeper.RemoveSideTxRetry(ctx, retry.TxHash)
+
+		// nothing to report if side-tx was never retried
+		if retry.Retries == 0 {
+			return sdk.EmptyEvents(), false
+		}
+
+		keeper.Logger(ctx).Debug("Giving up side-tx retries", "txHash", retry.TxHash.String(), "retries", retry.Retries)
+
+		return sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSideTxGiveUp,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyTxHash, retry.TxHash.String()),
+				sdk.NewAttribute(types.AttributeKeyRetry, strconv.FormatUint(retry.Retries, 10)),
+			),
+		}, false
+	}
+
+	retry.Retries++
+	retry.NextHeight = params.RetryHeight(ctx.BlockHeight(), retry.Retries)
+	retry.InFlight = false
+
+	if err := keeper.SetSideTxRetry(ctx, retry); err != nil {
+		keeper.Logger(ctx).Error("Unable to stage side-tx retry", "error", err)
+		return sdk.EmptyEvents(), false
+	}
+
+	keeper.Logger(ctx).Debug("Requeued side-tx", "txHash", retry.TxHash.String(), "retry", retry.Retries, "nextHeight", retry.NextHeight)
+
+	return sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSideTxRequeue,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTxHash, retry.TxHash.String()),
+			sdk.NewAttribute(types.AttributeKeyRetry, strconv.FormatUint(retry.Retries, 10)),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, strconv.FormatInt(retry.NextHeight, 10)),
+		),
+	}, true
+}
+
+// EmitDueSideTxRetries emits retry event for staged side-txs waiting to be proposed.
+// Event is repeated every backoff blocks until retry is included in a block.
+func (keeper Keeper) EmitDueSideTxRetries(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+
+	// params are read only when there is any staged side-tx
+	backoff := int64(-1)
+
+	keeper.IterateSideTxRetriesAndApplyFn(ctx, func(retry types.SideTxRetry) error {
+		if retry.InFlight || retry.NextHeight > height {
+			return nil
+		}
+
+		if backoff < 0 {
+			backoff = keeper.GetParams(ctx).SideTxRetryBackoff
+		}
+
+		if elapsed := height - retry.NextHeight; elapsed != 0 && (backoff <= 0 || elapsed%backoff != 0) {
+			return nil
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSideTxRetry,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyTxHash, retry.TxHash.String()),
+				sdk.NewAttribute(types.AttributeKeyRetry, strconv.FormatUint(retry.Retries, 10)),
+			),
+		)
+
+		return nil
+	})
+}
+
 //
 // Iterators
 //
@@ -214,3 +513,57 @@ func (keeper Keeper) IterateValidatorsAndApplyFn(ctx sdk.Context, f func(int64,
 
 	return
 }
+
+// IterateVoteRecordsAndApplyFn interate vote records by height and apply the given function.
+func (keeper Keeper) IterateVoteRecordsAndApplyFn(ctx sdk.Context, f func(types.SideTxVoteRecord) error) {
+	store := ctx.KVStore(keeper.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteRecordKeyPrefix)
+	defer iterator.Close()
+
+	keeper.applyVoteRecordsFn(iterator, f)
+}
+
+// IterateVoteRecordsReverseAndApplyFn interate vote records from latest height and apply the given function.
+func (keeper Keeper) IterateVoteRecordsReverseAndApplyFn(ctx sdk.Context, f func(types.SideTxVoteRecord) error) {
+	store := ctx.KVStore(keeper.key)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.VoteRecordKeyPrefix)
+	defer iterator.Close()
+
+	keeper.applyVoteRecordsFn(iterator, f)
+}
+
+func (keeper Keeper) applyVoteRecordsFn(iterator sdk.Iterator, f func(types.SideTxVoteRecord) error) {
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SideTxVoteRecord
+		if err := keeper.cdc.UnmarshalBinaryBare(iterator.Value(), &record); err != nil {
+			return
+		}
+
+		// call function and return if required
+		if err := f(record); err != nil {
+			return
+		}
+	}
+}
+
+// IterateSideTxRetriesAndApplyFn interate staged side-tx retries and apply the given function.
+func (keeper Keeper) IterateSideTxRetriesAndApplyFn(ctx sdk.Context, f func(types.SideTxRetry) error) {
+	store := ctx.KVStore(keeper.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SideTxRetryKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var retry types.SideTxRetry
+		if err := keeper.cdc.UnmarshalBinaryBare(iterator.Value(), &retry); err != nil {
+			return
+		}
+
+		// call function and return if required
+		if err := f(retry); err != nil {
+			return
+		}
+	}
+}
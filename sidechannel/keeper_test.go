@@ -11,6 +11,8 @@ import (
 	tmTypes "github.com/tendermint/tendermint/types"
 
 	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 //
@@ -228,3 +230,135 @@ func (suite *KeeperTestSuite) TestCodespace() {
 	codespace := app.SidechannelKeeper.Codespace()
 	require.NotEmpty(t, codespace)
 }
+
+func (suite *KeeperTestSuite) TestVoteRecords() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	validators := []hmTypes.ValidatorID{1, 2, 3, 4, 5}
+	hash1 := []byte("tx-hash-1")
+	hash2 := []byte("tx-hash-2")
+
+	record1 := types.NewSideTxVoteRecord(hash1, 10, validators)
+	record1.Result = abci.SideTxResultType_Yes
+	record1.SetVote(0, abci.SideTxResultType_Yes)
+	record1.SetVote(2, abci.SideTxResultType_No)
+	record1.SetVote(4, abci.SideTxResultType_Skip)
+	require.NoError(t, app.SidechannelKeeper.SetVoteRecord(ctx, record1))
+
+	record2 := types.NewSideTxVoteRecord(hash2, 20, validators[:2])
+	record2.SetVote(1, abci.SideTxResultType_No)
+	require.NoError(t, app.SidechannelKeeper.SetVoteRecord(ctx, record2))
+
+	t.Run("GetVoteRecord", func(t *testing.T) {
+		record, found := app.SidechannelKeeper.GetVoteRecord(ctx, hash1)
+		require.True(t, found)
+		require.Equal(t, record1, record)
+
+		vote, voted := record.GetValidatorVote(3)
+		require.True(t, voted)
+		require.Equal(t, abci.SideTxResultType_No, vote)
+
+		_, voted = record.GetValidatorVote(2)
+		require.False(t, voted)
+
+		vote, voted = record.GetValidatorVote(5)
+		require.True(t, voted)
+		require.Equal(t, abci.SideTxResultType_Skip, vote)
+	})
+
+	t.Run("GetValidatorVotes", func(t *testing.T) {
+		votes := app.SidechannelKeeper.GetValidatorVotes(ctx, 2, 1, 10)
+		require.Len(t, votes, 2)
+		require.Equal(t, int64(20), votes[0].Height)
+		require.Equal(t, abci.SideTxResultType_No.String(), votes[0].Vote)
+		require.Equal(t, types.VoteAbsent, votes[1].Vote)
+		require.Equal(t, abci.SideTxResultType_Yes.String(), votes[1].Result)
+
+		// pagination
+		votes = app.SidechannelKeeper.GetValidatorVotes(ctx, 2, 2, 1)
+		require.Len(t, votes, 1)
+		require.Equal(t, int64(10), votes[0].Height)
+
+		// validator not in record
+		votes = app.SidechannelKeeper.GetValidatorVotes(ctx, 5, 1, 10)
+		require.Len(t, votes, 1)
+	})
+
+	t.Run("PruneVoteRecords", func(t *testing.T) {
+		// records are kept forever without retention
+		app.SidechannelKeeper.SetParams(ctx, types.NewParams(types.DefaultMaxSideTxRetries, types.DefaultSideTxRetryBackoff, 0))
+		app.SidechannelKeeper.PruneVoteRecords(ctx, 15+types.DefaultVoteRecordRetention)
+		_, found := app.SidechannelKeeper.GetVoteRecord(ctx, hash1)
+		require.True(t, found)
+
+		app.SidechannelKeeper.SetParams(ctx, types.DefaultParams())
+		app.SidechannelKeeper.PruneVoteRecords(ctx, 15+types.DefaultVoteRecordRetention)
+
+		_, found = app.SidechannelKeeper.GetVoteRecord(ctx, hash1)
+		require.False(t, found)
+		_, found = app.SidechannelKeeper.GetVoteRecord(ctx, hash2)
+		require.True(t, found)
+	})
+}
+
+func (suite *KeeperTestSuite) TestSideTxRetry() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.SidechannelKeeper
+
+	tx := tmTypes.Tx([]byte("transaction-1"))
+	ctx = ctx.WithBlockHeight(100)
+
+	t.Run("Disabled", func(t *testing.T) {
+		events, requeued := keeper.RequeueSideTx(ctx, tx)
+		require.False(t, requeued)
+		require.Empty(t, events)
+
+		_, found := keeper.GetSideTxRetry(ctx, tx.Hash())
+		require.False(t, found)
+	})
+
+	keeper.SetParams(ctx, types.NewParams(2, 10, types.DefaultVoteRecordRetention))
+
+	t.Run("Requeue", func(t *testing.T) {
+		events, requeued := keeper.RequeueSideTx(ctx, tx)
+		require.True(t, requeued)
+		require.Len(t, events, 1)
+		require.Equal(t, types.EventTypeSideTxRequeue, events[0].Type)
+
+		retry, found := keeper.GetSideTxRetry(ctx, tx.Hash())
+		require.True(t, found)
+		require.Equal(t, uint64(1), retry.Retries)
+		require.Equal(t, int64(110), retry.NextHeight)
+		require.Equal(t, tx, retry.Tx)
+
+		// backoff doubles on next retry
+		_, requeued = keeper.RequeueSideTx(ctx, tx)
+		require.True(t, requeued)
+
+		retry, _ = keeper.GetSideTxRetry(ctx, tx.Hash())
+		require.Equal(t, uint64(2), retry.Retries)
+		require.Equal(t, int64(120), retry.NextHeight)
+		require.Len(t, keeper.GetSideTxRetries(ctx), 1)
+	})
+
+	t.Run("GiveUp", func(t *testing.T) {
+		events, requeued := keeper.RequeueSideTx(ctx, tx)
+		require.False(t, requeued)
+		require.Len(t, events, 1)
+		require.Equal(t, types.EventTypeSideTxGiveUp, events[0].Type)
+
+		_, found := keeper.GetSideTxRetry(ctx, tx.Hash())
+		require.False(t, found)
+	})
+
+	t.Run("EmitDue", func(t *testing.T) {
+		err := keeper.SetSideTxRetry(ctx, types.NewSideTxRetry(tx, 1, 110))
+		require.NoError(t, err)
+
+		for height, due := range map[int64]bool{109: false, 110: true, 115: false, 120: true} {
+			dueCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+			keeper.EmitDueSideTxRetries(dueCtx)
+			require.Equal(t, due, len(dueCtx.EventManager().Events()) == 1, "height %v", height)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package v0_3
+
+import (
+	"encoding/json"
+
+	"github.com/maticnetwork/heimdall/migrate"
+)
+
+// Params of the sidechannel module added in v0.3
+type Params struct {
+	MaxSideTxRetries    uint64 `json:"max_side_tx_retries"`
+	SideTxRetryBackoff  int64  `json:"side_tx_retry_backoff"`
+	VoteRecordRetention int64  `json:"vote_record_retention"`
+}
+
+// DefaultParams does not retry skipped side-txs, as before
+var DefaultParams = Params{
+	MaxSideTxRetries:    0,
+	SideTxRetryBackoff:  10,
+	VoteRecordRetention: 100000,
+}
+
+// Migrate adds the side-tx retry params and the empty retry queue
+func Migrate(oldGenState json.RawMessage) (json.RawMessage, error) {
+	genState, err := migrate.DecodeObject(oldGenState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := genState.SetDefault("params", DefaultParams); err != nil {
+		return nil, err
+	}
+	if err := genState.SetDefault("side_tx_retries", migrate.EmptyList); err != nil {
+		return nil, err
+	}
+
+	return genState.Marshal()
+}
@@ -13,6 +13,9 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/maticnetwork/heimdall/auth/simulation"
+	"github.com/maticnetwork/heimdall/sidechannel/client/cli"
+	"github.com/maticnetwork/heimdall/sidechannel/client/rest"
+	sidechannelSimulation "github.com/maticnetwork/heimdall/sidechannel/simulation"
 	"github.com/maticnetwork/heimdall/sidechannel/types"
 	hmModule "github.com/maticnetwork/heimdall/types/module"
 	simTypes "github.com/maticnetwork/heimdall/types/simulation"
@@ -35,7 +38,7 @@ func (AppModuleBasic) Name() string {
 
 // RegisterCodec registers the auth module's types for the given codec.
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
-	// types.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the auth
@@ -63,7 +66,7 @@ func (AppModuleBasic) VerifyGenesis(bz map[string]json.RawMessage) error {
 
 // RegisterRESTRoutes registers the REST routes for the auth module.
 func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
-
+	rest.RegisterRoutes(ctx, rtr)
 }
 
 // GetTxCmd returns the root tx command for the auth module.
@@ -73,7 +76,7 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 // GetQueryCmd returns the root query command for the auth module.
 func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	return cli.GetQueryCmd(cdc)
 }
 
 //____________________________________________________________________________
@@ -108,7 +111,7 @@ func (AppModule) Route() string {
 
 // NewHandler returns an sdk.Handler for the module.
 func (am AppModule) NewHandler() sdk.Handler {
-	return nil
+	return NewHandler(am.keeper)
 }
 
 // QuerierRoute returns the auth module's querier route name.
@@ -118,7 +121,7 @@ func (AppModule) QuerierRoute() string {
 
 // NewQuerierHandler returns the auth module sdk.Querier.
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return nil
+	return NewQuerier(am.keeper)
 }
 
 // InitGenesis performs genesis initialization for the auth module. It returns
@@ -150,6 +153,9 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 		// set validators for height
 		am.keeper.SetValidators(ctx, height, validators)
 	}
+
+	// announce staged side-txs which are due for another vote round
+	am.keeper.EmitDueSideTxRetries(ctx)
 }
 
 // EndBlock returns the end blocker for the auth module. It returns no validator updates.
@@ -164,9 +170,9 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the auth module
+// GenerateGenesisState creates a randomized GenState of the sidechannel module
 func (AppModule) GenerateGenesisState(simState *hmModule.SimulationState) {
-	// simulation.RandomizedGenState(simState)
+	sidechannelSimulation.RandomizedGenState(simState)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals.
@@ -184,7 +190,7 @@ func (AppModule) RegisterStoreDecoder(sdr hmModule.StoreDecoderRegistry) {
 	sdr[types.StoreKey] = simulation.DecodeStore
 }
 
-// WeightedOperations doesn't return any auth module operation.
-func (AppModule) WeightedOperations(_ hmModule.SimulationState) []simTypes.WeightedOperation {
-	return nil
+// WeightedOperations returns all the sidechannel module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState hmModule.SimulationState) []simTypes.WeightedOperation {
+	return WeightedOperations(simState.AppParams, simState.Cdc, am.keeper, simState.SideChannel)
 }
@@ -0,0 +1,74 @@
+package sidechannel
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	"github.com/maticnetwork/heimdall/simulation"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgRetrySideTx = "op_weight_msg_retry_side_tx"
+)
+
+// errStopIteration stops iterating staged side-tx retries
+var errStopIteration = errors.New("stop iteration")
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simTypes.AppParams, cdc *codec.Codec, k Keeper, sideChannel *simTypes.SideChannel) simulation.WeightedOperations {
+	var weightMsgRetrySideTx int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRetrySideTx, &weightMsgRetrySideTx, nil,
+		func(_ *rand.Rand) {
+			weightMsgRetrySideTx = 50
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgRetrySideTx, SimulateMsgRetrySideTx(k, sideChannel)),
+	}
+}
+
+// SimulateMsgRetrySideTx generates a MsgRetrySideTx for the first staged side-tx
+// due for another vote round, as the proposer picks it up from the retry event.
+func SimulateMsgRetrySideTx(k Keeper, sideChannel *simTypes.SideChannel) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+
+		var retry *types.SideTxRetry
+		k.IterateSideTxRetriesAndApplyFn(ctx, func(staged types.SideTxRetry) error {
+			if staged.InFlight || staged.NextHeight > ctx.BlockHeight() {
+				return nil
+			}
+
+			retry = &staged
+			return errStopIteration
+		})
+
+		if retry == nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		proposer, _ := simTypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgRetrySideTx(proposer.Address, retry.TxHash)
+
+		// staged side-tx was checked against the main chain by its module when it was
+		// first delivered, validators which caught up with the main chain vote yes
+		opMsg := simulation.DeliverSideTx(r, sideChannel, ctx, msg,
+			NewHandler(k),
+			func(_ sdk.Context, _ sdk.Msg) abci.ResponseDeliverSideTx {
+				return abci.ResponseDeliverSideTx{Result: abci.SideTxResultType_Yes}
+			},
+		)
+
+		return opMsg, nil, nil
+	}
+}
@@ -0,0 +1,64 @@
+package sidechannel
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+)
+
+// NewQuerier creates a querier for sidechannel REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryVoteRecord:
+			return queryVoteRecord(ctx, req, keeper)
+		case types.QueryValidatorVotes:
+			return queryValidatorVotes(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown sidechannel query endpoint")
+		}
+	}
+}
+
+func queryVoteRecord(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVoteRecordParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	record, found := keeper.GetVoteRecord(ctx, params.TxHash)
+	if !found {
+		return nil, sdk.ErrUnknownRequest("no vote record found for side-tx")
+	}
+
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryValidatorVotes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorVotesParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	if params.Page <= 0 || params.Limit <= 0 {
+		return nil, sdk.ErrUnknownRequest("page and limit must be greater than zero")
+	}
+
+	votes := keeper.GetValidatorVotes(ctx, params.ValidatorID, params.Page, params.Limit)
+	if votes == nil {
+		votes = []types.ValidatorVote{}
+	}
+
+	bz, err := json.Marshal(votes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
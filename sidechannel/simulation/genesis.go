@@ -8,8 +8,24 @@ import (
 	tmTypes "github.com/tendermint/tendermint/types"
 
 	"github.com/maticnetwork/heimdall/sidechannel/types"
+	"github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/types/simulation"
 )
 
+// RandomizedGenState generates a random GenesisState for sidechannel, most
+// chains retry skipped side-txs
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+	if simState.Rand.Intn(4) != 0 {
+		params.MaxSideTxRetries = uint64(simulation.RandIntBetween(simState.Rand, 1, 4))
+		params.SideTxRetryBackoff = int64(simulation.RandIntBetween(simState.Rand, 1, 5))
+	}
+	params.VoteRecordRetention = int64(simulation.RandIntBetween(simState.Rand, 10, 100))
+
+	genesisState := types.NewGenesisState(params, make([]types.PastCommit, 0), make([]types.SideTxRetry, 0))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
+}
+
 // RandomPastCommits returns random past commits value
 func RandomPastCommits(r *rand.Rand, n int, txsN int, validatorsN int) []types.PastCommit {
 	result := make([]types.PastCommit, n)
@@ -4,5 +4,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
+// RegisterCodec registers concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgRetrySideTx{}, "sidechannel/MsgRetrySideTx", nil)
+}
+
 // ModuleCdc module codec
 var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
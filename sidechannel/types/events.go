@@ -0,0 +1,15 @@
+//noalias
+package types
+
+// Sidechannel module event types
+const (
+	EventTypeSideTxRequeue = "side-tx-requeue"
+	EventTypeSideTxRetry   = "side-tx-retry"
+	EventTypeSideTxGiveUp  = "side-tx-give-up"
+
+	AttributeKeyTxHash     = "tx-hash"
+	AttributeKeyRetry      = "retry"
+	AttributeKeyNextHeight = "next-height"
+
+	AttributeValueCategory = ModuleName
+)
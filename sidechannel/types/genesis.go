@@ -14,24 +14,32 @@ type PastCommit struct {
 
 // GenesisState is the sidechannel state that must be provided at genesis.
 type GenesisState struct {
-	PastCommits []PastCommit `json:"past_commits" yaml:"past_commits"`
+	Params        Params        `json:"params" yaml:"params"`
+	PastCommits   []PastCommit  `json:"past_commits" yaml:"past_commits"`
+	SideTxRetries []SideTxRetry `json:"side_tx_retries" yaml:"side_tx_retries"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(pastCommits []PastCommit) GenesisState {
+func NewGenesisState(params Params, pastCommits []PastCommit, sideTxRetries []SideTxRetry) GenesisState {
 	return GenesisState{
-		PastCommits: pastCommits,
+		Params:        params,
+		PastCommits:   pastCommits,
+		SideTxRetries: sideTxRetries,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(make([]PastCommit, 0))
+	return NewGenesisState(DefaultParams(), make([]PastCommit, 0), make([]SideTxRetry, 0))
 }
 
 // ValidateGenesis performs basic validation of topup genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, pastCommit := range data.PastCommits {
 		if pastCommit.Height <= 2 {
 			return fmt.Errorf("Past commit height must be greater 2")
@@ -41,5 +49,11 @@ func ValidateGenesis(data GenesisState) error {
 			return fmt.Errorf("Txs must be present")
 		}
 	}
+
+	for _, retry := range data.SideTxRetries {
+		if len(retry.Tx) == 0 || !retry.TxHash.Equals(retry.Tx.Hash()) {
+			return fmt.Errorf("Invalid side-tx retry %v", retry.TxHash.String())
+		}
+	}
 	return nil
 }
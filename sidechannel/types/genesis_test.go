@@ -18,7 +18,7 @@ func TestDefaultGenesisState(t *testing.T) {
 }
 
 func TestNewGenesisState(t *testing.T) {
-	genesis := types.NewGenesisState([]types.PastCommit{{Height: 2}})
+	genesis := types.NewGenesisState(types.DefaultParams(), []types.PastCommit{{Height: 2}}, nil)
 	require.NotNil(t, genesis, "NewGenesisState should not return nil response")
 	require.Equal(t, 1, len(genesis.PastCommits), "NewGenesisState should create proper pastcommits")
 
@@ -30,21 +30,21 @@ func TestValidateGenesis(t *testing.T) {
 	emptyGenesis := types.GenesisState{}
 	require.Nil(t, types.ValidateGenesis(emptyGenesis), "Empty genesis should be valid genesis")
 
-	emptyGenesis = types.NewGenesisState(make([]types.PastCommit, 0))
+	emptyGenesis = types.NewGenesisState(types.DefaultParams(), make([]types.PastCommit, 0), nil)
 	require.Nil(t, types.ValidateGenesis(emptyGenesis), "Empty genesis should be valid genesis (using NewGenesisState)")
 
-	genesis := types.NewGenesisState([]types.PastCommit{{Height: 2}})
+	genesis := types.NewGenesisState(types.DefaultParams(), []types.PastCommit{{Height: 2}}, nil)
 	err := types.ValidateGenesis(genesis)
 	require.Error(t, err, "PastCommit object with height 2 should not be allowed")
 
 	// get random seed from time as source
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
-	genesis = types.NewGenesisState(simulation.RandomPastCommits(r, 10, 0, 0))
+	genesis = types.NewGenesisState(types.DefaultParams(), simulation.RandomPastCommits(r, 10, 0, 0), nil)
 	err = types.ValidateGenesis(genesis)
 	require.Error(t, err, "PastCommit object without should not be allowed")
 
-	genesis = types.NewGenesisState(simulation.RandomPastCommits(r, 10, 5, 0))
+	genesis = types.NewGenesisState(types.DefaultParams(), simulation.RandomPastCommits(r, 10, 5, 0), nil)
 	err = types.ValidateGenesis(genesis)
 	require.Equal(t, 10, len(genesis.PastCommits))
 	require.Equal(t, 5, len(genesis.PastCommits[0].Txs))
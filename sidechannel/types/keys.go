@@ -28,6 +28,18 @@ var (
 
 	// ValidatorsKeyPrefix prefix for validators
 	ValidatorsKeyPrefix = []byte{0x02}
+
+	// TxVotersKeyPrefix prefix for validators who voted yes on side-tx under execution
+	TxVotersKeyPrefix = []byte{0x03}
+
+	// VoteRecordKeyPrefix prefix for side-tx vote records by height
+	VoteRecordKeyPrefix = []byte{0x04}
+
+	// VoteRecordHeightKeyPrefix prefix for height of side-tx vote record by tx hash
+	VoteRecordHeightKeyPrefix = []byte{0x05}
+
+	// SideTxRetryKeyPrefix prefix for skipped side-txs staged for retry by tx hash
+	SideTxRetryKeyPrefix = []byte{0x06}
 )
 
 // TxStoreKey returns key used to get tx from store
@@ -58,3 +70,39 @@ func ValidatorsKey(height int64) []byte {
 	result = append(result, b...)
 	return result
 }
+
+// TxVotersKey returns key used to get yes voters of side-tx from store
+func TxVotersKey(hash []byte) []byte {
+	result := []byte{}
+	result = append(result, TxVotersKeyPrefix...)
+	result = append(result, hash...)
+	return result
+}
+
+// VoteRecordKey returns key used to get vote record of side-tx from store
+func VoteRecordKey(height int64, hash []byte) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+
+	result := []byte{}
+	result = append(result, VoteRecordKeyPrefix...)
+	result = append(result, b...)
+	result = append(result, hash...)
+	return result
+}
+
+// VoteRecordHeightKey returns key used to get height of vote record from store
+func VoteRecordHeightKey(hash []byte) []byte {
+	result := []byte{}
+	result = append(result, VoteRecordHeightKeyPrefix...)
+	result = append(result, hash...)
+	return result
+}
+
+// SideTxRetryKey returns key used to get staged side-tx retry from store
+func SideTxRetryKey(hash []byte) []byte {
+	result := []byte{}
+	result = append(result, SideTxRetryKeyPrefix...)
+	result = append(result, hash...)
+	return result
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Retry side-tx
+//
+
+var _ sdk.Msg = MsgRetrySideTx{}
+var _ hmTypes.SideTxMsg = MsgRetrySideTx{}
+var _ hmTypes.UpgradeMsg = MsgRetrySideTx{}
+
+// MsgRetrySideTx carries staged side-tx into another vote round
+type MsgRetrySideTx struct {
+	Proposer hmTypes.HeimdallAddress `json:"proposer"`
+	TxHash   hmTypes.HexBytes        `json:"tx_hash"`
+}
+
+// NewMsgRetrySideTx creates new retry msg for staged side-tx
+func NewMsgRetrySideTx(proposer hmTypes.HeimdallAddress, txHash []byte) MsgRetrySideTx {
+	return MsgRetrySideTx{
+		Proposer: proposer,
+		TxHash:   txHash,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRetrySideTx) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgRetrySideTx) Type() string {
+	return "retry-side-tx"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgRetrySideTx) ValidateBasic() sdk.Error {
+	if msg.Proposer.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.Proposer.String())
+	}
+
+	if len(msg.TxHash) != 32 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid tx hash %v", msg.TxHash.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRetrySideTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRetrySideTx) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.Proposer)}
+}
+
+// GetSideSignBytes returns side sign bytes, votes are cast on messages of staged side-tx
+func (msg MsgRetrySideTx) GetSideSignBytes() []byte {
+	return nil
+}
+
+// RequiredUpgrade returns the software upgrade adding side-tx retries
+func (msg MsgRetrySideTx) RequiredUpgrade() string {
+	return hmTypes.UpgradeV03
+}
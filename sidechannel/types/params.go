@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+)
+
+// Default parameter values
+const (
+	DefaultMaxSideTxRetries    uint64 = 0      // skipped side-txs are not retried unless enabled by governance
+	DefaultSideTxRetryBackoff  int64  = 10     // blocks
+	DefaultVoteRecordRetention int64  = 100000 // blocks
+)
+
+// Parameter keys
+var (
+	KeyMaxSideTxRetries    = []byte("MaxSideTxRetries")
+	KeySideTxRetryBackoff  = []byte("SideTxRetryBackoff")
+	KeyVoteRecordRetention = []byte("VoteRecordRetention")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the sidechannel module.
+type Params struct {
+	MaxSideTxRetries    uint64 `json:"max_side_tx_retries" yaml:"max_side_tx_retries"`     // max vote rounds a skipped side-tx is re-staged for
+	SideTxRetryBackoff  int64  `json:"side_tx_retry_backoff" yaml:"side_tx_retry_backoff"` // base blocks to wait before retry, doubled on each retry
+	VoteRecordRetention int64  `json:"vote_record_retention" yaml:"vote_record_retention"` // blocks side-tx vote records are kept for, 0 keeps them forever
+}
+
+// NewParams creates a new Params object
+func NewParams(maxSideTxRetries uint64, sideTxRetryBackoff int64, voteRecordRetention int64) Params {
+	return Params{
+		MaxSideTxRetries:    maxSideTxRetries,
+		SideTxRetryBackoff:  sideTxRetryBackoff,
+		VoteRecordRetention: voteRecordRetention,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of sidechannel module's parameters.
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyMaxSideTxRetries, &p.MaxSideTxRetries},
+		{KeySideTxRetryBackoff, &p.SideTxRetryBackoff},
+		{KeyVoteRecordRetention, &p.VoteRecordRetention},
+	}
+}
+
+// String implements the stringer interface for Params
+func (p Params) String() string {
+	return fmt.Sprintf(`Sidechannel Params:
+  MaxSideTxRetries:    %d
+  SideTxRetryBackoff:  %d
+  VoteRecordRetention: %d`, p.MaxSideTxRetries, p.SideTxRetryBackoff, p.VoteRecordRetention)
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.SideTxRetryBackoff < 0 {
+		return fmt.Errorf("side-tx retry backoff should be non-negative, is %d", p.SideTxRetryBackoff)
+	}
+
+	if p.MaxSideTxRetries > 0 && p.SideTxRetryBackoff == 0 {
+		return fmt.Errorf("side-tx retry backoff should be positive when retries are enabled")
+	}
+
+	if p.VoteRecordRetention < 0 {
+		return fmt.Errorf("vote record retention should be non-negative, is %d", p.VoteRecordRetention)
+	}
+
+	return nil
+}
+
+// RetryHeight returns height at which side-tx becomes due for given retry (1-based),
+// backoff doubles on every retry
+func (p Params) RetryHeight(height int64, retry uint64) int64 {
+	shift := retry - 1
+	if shift > 16 {
+		shift = 16
+	}
+
+	return height + p.SideTxRetryBackoff<<shift
+}
+
+//
+// Extra functions
+//
+
+// ParamKeyTable for sidechannel module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default parameters for sidechannel module
+func DefaultParams() Params {
+	return NewParams(DefaultMaxSideTxRetries, DefaultSideTxRetryBackoff, DefaultVoteRecordRetention)
+}
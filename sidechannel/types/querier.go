@@ -0,0 +1,33 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the sidechannel Querier
+const (
+	QueryVoteRecord     = "vote-record"
+	QueryValidatorVotes = "validator-votes"
+)
+
+// QueryVoteRecordParams defines the params for querying vote record of side-tx
+type QueryVoteRecordParams struct {
+	TxHash hmTypes.HexBytes `json:"tx_hash"`
+}
+
+// NewQueryVoteRecordParams creates a new instance of QueryVoteRecordParams.
+func NewQueryVoteRecordParams(txHash []byte) QueryVoteRecordParams {
+	return QueryVoteRecordParams{TxHash: txHash}
+}
+
+// QueryValidatorVotesParams defines the params for querying side-tx votes of validator
+type QueryValidatorVotesParams struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+	Page        int                 `json:"page"`
+	Limit       int                 `json:"limit"`
+}
+
+// NewQueryValidatorVotesParams creates a new instance of QueryValidatorVotesParams.
+func NewQueryValidatorVotesParams(validatorID hmTypes.ValidatorID, page int, limit int) QueryValidatorVotesParams {
+	return QueryValidatorVotesParams{ValidatorID: validatorID, Page: page, Limit: limit}
+}
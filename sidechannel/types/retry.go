@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SideTxRetry is a skipped side-tx staged for another vote round
+type SideTxRetry struct {
+	TxHash     hmTypes.HexBytes `json:"tx_hash" yaml:"tx_hash"`         // hash of original side-tx
+	Tx         tmTypes.Tx       `json:"tx" yaml:"tx"`                   // original side-tx bytes
+	Retries    uint64           `json:"retries" yaml:"retries"`         // vote rounds scheduled so far
+	NextHeight int64            `json:"next_height" yaml:"next_height"` // height from which retry can be proposed
+	InFlight   bool             `json:"in_flight" yaml:"in_flight"`     // retry is included and waiting for votes
+}
+
+// NewSideTxRetry creates new retry entry for side-tx
+func NewSideTxRetry(tx tmTypes.Tx, retries uint64, nextHeight int64) SideTxRetry {
+	return SideTxRetry{
+		TxHash:     tx.Hash(),
+		Tx:         tx,
+		Retries:    retries,
+		NextHeight: nextHeight,
+	}
+}
+
+// String returns the string representation of retry entry
+func (r SideTxRetry) String() string {
+	return fmt.Sprintf(`SideTxRetry:
+  TxHash:     %s
+  Retries:    %d
+  NextHeight: %d
+  InFlight:   %v`, r.TxHash.String(), r.Retries, r.NextHeight, r.InFlight)
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// vote codes in vote bitmap, 2 bits per validator
+const (
+	voteAbsent byte = 0
+	voteBits        = 2
+	voteMask   byte = 0x03
+)
+
+// SideTxVoteRecord is the audit record of side-tx votes. Votes are stored as bitmap
+// with 2 bits per validator in the order of Validators: 0 for absent, otherwise vote result + 1.
+type SideTxVoteRecord struct {
+	TxHash     hmTypes.HexBytes      `json:"tx_hash" yaml:"tx_hash"`
+	Height     int64                 `json:"height" yaml:"height"` // height at which side-tx was included
+	Result     abci.SideTxResultType `json:"result" yaml:"result"` // outcome of side-tx
+	Validators []hmTypes.ValidatorID `json:"validators" yaml:"validators"`
+	Votes      hmTypes.HexBytes      `json:"votes" yaml:"votes"`
+}
+
+// NewSideTxVoteRecord creates new vote record for validators without any votes
+func NewSideTxVoteRecord(txHash []byte, height int64, validators []hmTypes.ValidatorID) SideTxVoteRecord {
+	return SideTxVoteRecord{
+		TxHash:     txHash,
+		Height:     height,
+		Result:     abci.SideTxResultType_Skip,
+		Validators: validators,
+		Votes:      make([]byte, (len(validators)*voteBits+7)/8),
+	}
+}
+
+// SetVote sets vote of validator at index
+func (r *SideTxVoteRecord) SetVote(index int, vote abci.SideTxResultType) {
+	offset := uint(index*voteBits) % 8
+	r.Votes[index*voteBits/8] &^= voteMask << offset
+	r.Votes[index*voteBits/8] |= (byte(vote) + 1) << offset
+}
+
+// GetVote returns vote of validator at index and if validator voted
+func (r SideTxVoteRecord) GetVote(index int) (abci.SideTxResultType, bool) {
+	if index < 0 || index*voteBits/8 >= len(r.Votes) {
+		return abci.SideTxResultType_Skip, false
+	}
+
+	code := (r.Votes[index*voteBits/8] >> (uint(index*voteBits) % 8)) & voteMask
+	if code == voteAbsent {
+		return abci.SideTxResultType_Skip, false
+	}
+
+	return abci.SideTxResultType(code - 1), true
+}
+
+// HasValidator checks if validator was in validator set of side-tx
+func (r SideTxVoteRecord) HasValidator(id hmTypes.ValidatorID) bool {
+	for _, validatorID := range r.Validators {
+		if validatorID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetValidatorVote returns vote of validator and if validator voted
+func (r SideTxVoteRecord) GetValidatorVote(id hmTypes.ValidatorID) (abci.SideTxResultType, bool) {
+	for i, validatorID := range r.Validators {
+		if validatorID == id {
+			return r.GetVote(i)
+		}
+	}
+
+	return abci.SideTxResultType_Skip, false
+}
+
+// String returns the string representation of vote record
+func (r SideTxVoteRecord) String() string {
+	votes := make([]string, 0, len(r.Validators))
+	for i, id := range r.Validators {
+		vote := VoteAbsent
+		if v, ok := r.GetVote(i); ok {
+			vote = v.String()
+		}
+		votes = append(votes, fmt.Sprintf("%v: %s", id, vote))
+	}
+
+	return fmt.Sprintf(`SideTxVoteRecord:
+  TxHash: %s
+  Height: %d
+  Result: %s
+  Votes:  %s`, r.TxHash, r.Height, r.Result, strings.Join(votes, ", "))
+}
+
+// VoteAbsent is the vote of validator which did not vote on side-tx
+const VoteAbsent = "Absent"
+
+// ValidatorVote is the vote of validator on side-tx
+type ValidatorVote struct {
+	TxHash hmTypes.HexBytes `json:"tx_hash" yaml:"tx_hash"`
+	Height int64            `json:"height" yaml:"height"`
+	Result string           `json:"result" yaml:"result"` // outcome of side-tx
+	Vote   string           `json:"vote" yaml:"vote"`     // vote of validator, Absent if validator did not vote
+}
+
+// NewValidatorVote returns vote of validator from vote record
+func NewValidatorVote(r SideTxVoteRecord, id hmTypes.ValidatorID) ValidatorVote {
+	vote := VoteAbsent
+	if v, ok := r.GetValidatorVote(id); ok {
+		vote = v.String()
+	}
+
+	return ValidatorVote{
+		TxHash: r.TxHash,
+		Height: r.Height,
+		Result: r.Result.String(),
+		Vote:   vote,
+	}
+}
@@ -0,0 +1,113 @@
+package signer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+)
+
+// Policy restricts what signer signs
+type Policy struct {
+	AllowedMsgs      []string         // allowed heimdall msgs as "route" or "route/type", empty allows all
+	AllowedContracts []common.Address // allowed mainchain tx recipients, empty allows all
+	RateLimit        int              // max signatures per minute, zero for no limit
+}
+
+// CheckMsg checks if heimdall msg is allowed
+func (p Policy) CheckMsg(msg sdk.Msg) error {
+	if len(p.AllowedMsgs) == 0 {
+		return nil
+	}
+
+	for _, allowed := range p.AllowedMsgs {
+		if allowed == msg.Route() || allowed == msg.Route()+"/"+msg.Type() {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("msg %s/%s is not allowed by signer policy", msg.Route(), msg.Type())
+}
+
+// CheckEthTx checks if mainchain tx is allowed. Contract creation is never
+// allowed, a validator only calls the deployed contracts.
+func (p Policy) CheckEthTx(tx *ethTypes.Transaction) error {
+	if tx.To() == nil {
+		return fmt.Errorf("contract creation is not allowed by signer policy")
+	}
+
+	if len(p.AllowedContracts) == 0 {
+		return nil
+	}
+
+	for _, allowed := range p.AllowedContracts {
+		if allowed == *tx.To() {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("tx to %s is not allowed by signer policy", tx.To().Hex())
+}
+
+// ParseAllowedContracts parses comma separated list of contract addresses
+func ParseAllowedContracts(contracts []string) ([]common.Address, error) {
+	result := make([]common.Address, 0, len(contracts))
+	for _, contract := range contracts {
+		contract = strings.TrimSpace(contract)
+		if !common.IsHexAddress(contract) {
+			return nil, fmt.Errorf("invalid contract address %s", contract)
+		}
+		result = append(result, common.HexToAddress(contract))
+	}
+	return result, nil
+}
+
+//
+// Rate limiter
+//
+
+// rateLimiter allows limited number of signatures in sliding minute window
+type rateLimiter struct {
+	limit int
+	now   func() time.Time
+
+	mu    sync.Mutex
+	times []time.Time
+}
+
+func newRateLimiter(limit int) *rateLimiter {
+	return &rateLimiter{
+		limit: limit,
+		now:   time.Now,
+	}
+}
+
+// allow records signature and returns false if limit is reached
+func (r *rateLimiter) allow() bool {
+	if r.limit <= 0 {
+		return true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+
+	// drop signatures older than a minute
+	i := 0
+	for i < len(r.times) && now.Sub(r.times[i]) >= time.Minute {
+		i++
+	}
+	r.times = r.times[i:]
+
+	if len(r.times) >= r.limit {
+		return false
+	}
+
+	r.times = append(r.times, now)
+	return true
+}
@@ -0,0 +1,177 @@
+package signer
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/rlp"
+	"github.com/tendermint/tendermint/libs/log"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Service signs heimdall and mainchain txs allowed by policy with local key
+type Service struct {
+	cdc     *codec.Codec
+	signer  *helper.LocalSigner
+	policy  Policy
+	limiter *rateLimiter
+	logger  log.Logger
+}
+
+// NewService creates signer service
+func NewService(cdc *codec.Codec, signer *helper.LocalSigner, policy Policy, logger log.Logger) *Service {
+	return &Service{
+		cdc:     cdc,
+		signer:  signer,
+		policy:  policy,
+		limiter: newRateLimiter(policy.RateLimit),
+		logger:  logger,
+	}
+}
+
+// PubKey returns public key of signer
+func (s *Service) PubKey(_ helper.SignerPubKeyRequest, res *helper.SignerPubKeyResponse) error {
+	pubKey := s.signer.PubKey()
+	res.PubKey = pubKey[:]
+	return nil
+}
+
+// SignTx signs heimdall tx. Sign bytes are decoded and signature is made over
+// canonical sign bytes of decoded msg, so policy applies to what is signed.
+func (s *Service) SignTx(req helper.SignerTxRequest, res *helper.SignerSignatureResponse) error {
+	var doc authTypes.StdSignDoc
+	if err := s.cdc.UnmarshalJSON(req.SignBytes, &doc); err != nil {
+		return err
+	}
+
+	var msg sdk.Msg
+	if err := s.cdc.UnmarshalJSON(doc.Msg, &msg); err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return errors.New(err.Error())
+	}
+
+	if err := s.policy.CheckMsg(msg); err != nil {
+		s.logger.Info("Rejected tx", "route", msg.Route(), "type", msg.Type(), "error", err)
+		return err
+	}
+
+	if !s.limiter.allow() {
+		s.logger.Info("Rejected tx, rate limit reached", "route", msg.Route(), "type", msg.Type())
+		return errors.New("signer rate limit reached")
+	}
+
+	sig, err := s.signer.SignStdSignMsg(authTypes.StdSignMsg{
+		ChainID:       doc.ChainID,
+		AccountNumber: doc.AccountNumber,
+		Sequence:      doc.Sequence,
+		Msg:           msg,
+		Memo:          doc.Memo,
+		FeeGranter:    hmTypes.HexToHeimdallAddress(doc.FeeGranter),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Signed tx", "route", msg.Route(), "type", msg.Type(), "chainID", doc.ChainID, "sequence", doc.Sequence)
+	res.Signature = sig
+	return nil
+}
+
+// SignEthTx signs mainchain tx
+func (s *Service) SignEthTx(req helper.SignerEthTxRequest, res *helper.SignerSignatureResponse) error {
+	var tx ethTypes.Transaction
+	if err := rlp.DecodeBytes(req.Tx, &tx); err != nil {
+		return err
+	}
+
+	if err := s.policy.CheckEthTx(&tx); err != nil {
+		s.logger.Info("Rejected mainchain tx", "error", err)
+		return err
+	}
+
+	if !s.limiter.allow() {
+		s.logger.Info("Rejected mainchain tx, rate limit reached", "to", tx.To().Hex())
+		return errors.New("signer rate limit reached")
+	}
+
+	signedTx, err := s.signer.SignEthTx(&tx)
+	if err != nil {
+		return err
+	}
+
+	// homestead signature values as [R || S || V] where V is 0 or 1
+	v, r, ss := signedTx.RawSignatureValues()
+	sig := make([]byte, 65)
+	copy(sig[32-len(r.Bytes()):32], r.Bytes())
+	copy(sig[64-len(ss.Bytes()):64], ss.Bytes())
+	sig[64] = byte(v.Uint64() - 27)
+
+	s.logger.Info("Signed mainchain tx", "to", tx.To().Hex(), "nonce", tx.Nonce())
+	res.Signature = sig
+	return nil
+}
+
+// Listen listens on unix://<path> or tcp://<host:port>. TCP listener requires
+// TLS config with client certificate verification for mutual auth.
+func Listen(address string, tlsConfig *tls.Config) (net.Listener, error) {
+	network, addr, err := helper.ParseSignerAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if network == "unix" {
+		// remove stale socket
+		if err := os.RemoveAll(addr); err != nil {
+			return nil, err
+		}
+
+		listener, err := net.Listen(network, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		// only owner can connect
+		if err := os.Chmod(addr, 0600); err != nil {
+			listener.Close()
+			return nil, err
+		}
+
+		return listener, nil
+	}
+
+	if tlsConfig == nil || tlsConfig.ClientAuth != tls.RequireAndVerifyClientCert {
+		return nil, errors.New("TLS config with client certificate verification is required for tcp signer")
+	}
+
+	return tls.Listen(network, addr, tlsConfig)
+}
+
+// Serve serves signer service on listener until listener is closed
+func Serve(listener net.Listener, service *Service) error {
+	server := rpc.NewServer()
+	if err := server.RegisterName(helper.SignerServiceName, service); err != nil {
+		return err
+	}
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go server.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
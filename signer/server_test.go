@@ -0,0 +1,123 @@
+package signer
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	bankTypes "github.com/maticnetwork/heimdall/bank/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func startSigner(t *testing.T, localSigner *helper.LocalSigner, policy Policy) *helper.RemoteSigner {
+	dir, err := ioutil.TempDir("", "heimdall-signer")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	address := "unix://" + filepath.Join(dir, "signer.sock")
+	listener, err := Listen(address, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go Serve(listener, NewService(app.MakeCodec(), localSigner, policy, log.NewNopLogger()))
+
+	remoteSigner, err := helper.NewRemoteSigner(address, nil)
+	require.NoError(t, err)
+	return remoteSigner
+}
+
+func sendSignMsg(from hmTypes.HeimdallAddress) authTypes.StdSignMsg {
+	return authTypes.StdSignMsg{
+		ChainID:       "heimdall-test",
+		AccountNumber: 1,
+		Sequence:      2,
+		Msg:           bankTypes.NewMsgSend(from, hmTypes.HexToHeimdallAddress("0x1"), sdk.NewCoins(sdk.NewInt64Coin(authTypes.FeeToken, 1))),
+		Memo:          "memo",
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	localSigner := helper.NewLocalSigner(secp256k1.GenPrivKey())
+	remoteSigner := startSigner(t, localSigner, Policy{})
+	require.Equal(t, localSigner.PubKey(), remoteSigner.PubKey())
+
+	// heimdall tx
+	msg := sendSignMsg(hmTypes.BytesToHeimdallAddress(localSigner.PubKey().Address().Bytes()))
+	expected, err := localSigner.SignStdSignMsg(msg)
+	require.NoError(t, err)
+	sig, err := remoteSigner.SignStdSignMsg(msg)
+	require.NoError(t, err)
+	require.Equal(t, expected, sig)
+
+	// mainchain tx
+	tx := ethTypes.NewTransaction(1, common.HexToAddress("0x2"), big.NewInt(0), 100000, big.NewInt(1), []byte{1})
+	signedTx, err := remoteSigner.SignEthTx(tx)
+	require.NoError(t, err)
+	from, err := ethTypes.Sender(ethTypes.HomesteadSigner{}, signedTx)
+	require.NoError(t, err)
+	require.Equal(t, common.BytesToAddress(localSigner.PubKey().Address().Bytes()), from)
+
+	// transact opts
+	opts := helper.NewTransactOpts(remoteSigner)
+	require.Equal(t, from, opts.From)
+	_, err = opts.Signer(ethTypes.HomesteadSigner{}, common.HexToAddress("0x3"), tx)
+	require.Error(t, err)
+}
+
+func TestSignerPolicy(t *testing.T) {
+	localSigner := helper.NewLocalSigner(secp256k1.GenPrivKey())
+	allowed := common.HexToAddress("0x2")
+	remoteSigner := startSigner(t, localSigner, Policy{
+		AllowedMsgs:      []string{"checkpoint", "bank/multisend"},
+		AllowedContracts: []common.Address{allowed},
+	})
+
+	// msg not allowed
+	msg := sendSignMsg(hmTypes.BytesToHeimdallAddress(localSigner.PubKey().Address().Bytes()))
+	_, err := remoteSigner.SignStdSignMsg(msg)
+	require.Error(t, err)
+
+	// contract not allowed
+	_, err = remoteSigner.SignEthTx(ethTypes.NewTransaction(1, common.HexToAddress("0x3"), big.NewInt(0), 100000, big.NewInt(1), nil))
+	require.Error(t, err)
+	_, err = remoteSigner.SignEthTx(ethTypes.NewContractCreation(1, big.NewInt(0), 100000, big.NewInt(1), nil))
+	require.Error(t, err)
+	_, err = remoteSigner.SignEthTx(ethTypes.NewTransaction(1, allowed, big.NewInt(0), 100000, big.NewInt(1), nil))
+	require.NoError(t, err)
+
+	// contract creation is rejected without contract policy too, and the
+	// signer keeps serving
+	openSigner := startSigner(t, localSigner, Policy{})
+	_, err = openSigner.SignEthTx(ethTypes.NewContractCreation(1, big.NewInt(0), 100000, big.NewInt(1), nil))
+	require.Error(t, err)
+	_, err = openSigner.SignEthTx(ethTypes.NewTransaction(1, common.HexToAddress("0x3"), big.NewInt(0), 100000, big.NewInt(1), nil))
+	require.NoError(t, err)
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(2)
+	limiter.now = func() time.Time { return now }
+
+	require.True(t, limiter.allow())
+	require.True(t, limiter.allow())
+	require.False(t, limiter.allow())
+
+	now = now.Add(time.Minute)
+	require.True(t, limiter.allow())
+
+	require.True(t, newRateLimiter(0).allow())
+}
@@ -0,0 +1,74 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// RandomSideTxResult returns the result the simulated validator set reaches for
+// a side-tx, given the vote of an honest validator. Most of the time the honest
+// vote wins, but votes are split (no majority) or a dishonest majority votes no
+// once in a while.
+func RandomSideTxResult(r *rand.Rand, vote abci.ResponseDeliverSideTx) abci.SideTxResultType {
+	switch x := r.Intn(100); {
+	case x < 10:
+		return abci.SideTxResultType_Skip
+	case x < 15:
+		return abci.SideTxResultType_No
+	default:
+		return vote.Result
+	}
+}
+
+// DeliverSideTx delivers msg in a tx through the handler of a module, writing to
+// ctx only if the handler succeeds. Side-tx of the tx is stored by the side-tx
+// processor of the app, which processes the simulated vote of validators on it
+// in the side block two blocks later.
+func DeliverSideTx(
+	r *rand.Rand,
+	sideChannel *simulation.SideChannel,
+	ctx sdk.Context,
+	msg sdk.Msg,
+	handler sdk.Handler,
+	sideTxHandler hmTypes.SideTxHandler,
+) simulation.OperationMsg {
+	if err := msg.ValidateBasic(); err != nil {
+		return simulation.NewOperationMsg(msg, false, err.Error())
+	}
+
+	// msg is validated and included in block
+	tx, res := sideChannel.DeliverTx(ctx, msg, handler)
+	if !res.IsOK() {
+		return simulation.NewOperationMsg(msg, false, res.Log)
+	}
+
+	// validators vote on the side-tx in the next block
+	voteCtx, _ := ctx.WithTxBytes(tx).CacheContext()
+	vote := sideTxHandler(voteCtx, msg)
+
+	sideTxResult := RandomSideTxResult(r, vote)
+	sideChannel.Vote(ctx.BlockHeight(), tx, sideTxResult)
+
+	return simulation.NewOperationMsg(msg, true, sideTxResult.String())
+}
+
+// DeliverMsg runs msg through the handler of a module, writing to ctx only if
+// the handler succeeds.
+func DeliverMsg(ctx sdk.Context, msg sdk.Msg, handler sdk.Handler) simulation.OperationMsg {
+	if err := msg.ValidateBasic(); err != nil {
+		return simulation.NewOperationMsg(msg, false, err.Error())
+	}
+
+	cacheCtx, write := ctx.WithTxBytes(msg.GetSignBytes()).CacheContext()
+	res := handler(cacheCtx, msg)
+	if res.IsOK() {
+		write()
+	}
+
+	return simulation.NewOperationMsg(msg, res.IsOK(), res.Log)
+}
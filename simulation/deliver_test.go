@@ -0,0 +1,124 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/types/simulation"
+)
+
+var (
+	testStoreKey = sdk.NewKVStoreKey("test")
+	handledKey   = []byte("handled")
+)
+
+type testMsg struct{}
+
+func (testMsg) Route() string                { return "test" }
+func (testMsg) Type() string                 { return "test" }
+func (testMsg) ValidateBasic() sdk.Error     { return nil }
+func (testMsg) GetSignBytes() []byte         { return []byte("test") }
+func (testMsg) GetSigners() []sdk.AccAddress { return nil }
+
+func newTestContext(t *testing.T) sdk.Context {
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(testStoreKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	return sdk.NewContext(cms, abci.Header{}, false, log.NewNopLogger())
+}
+
+func testHandler(ok bool) sdk.Handler {
+	return func(ctx sdk.Context, _ sdk.Msg) sdk.Result {
+		ctx.KVStore(testStoreKey).Set(handledKey, []byte{0x01})
+		if !ok {
+			return sdk.ErrUnknownRequest("test").Result()
+		}
+		return sdk.Result{}
+	}
+}
+
+func testSideTxHandler(vote abci.SideTxResultType) func(sdk.Context, sdk.Msg) abci.ResponseDeliverSideTx {
+	return func(_ sdk.Context, _ sdk.Msg) abci.ResponseDeliverSideTx {
+		return abci.ResponseDeliverSideTx{Result: vote}
+	}
+}
+
+// testSideTxProcessor records side-txs stored for the vote round
+type testSideTxProcessor struct {
+	txs []tmTypes.Tx
+}
+
+func (p *testSideTxProcessor) PostDeliverTxHandler(ctx sdk.Context, _ sdk.Tx, _ sdk.Result) {
+	p.txs = append(p.txs, ctx.TxBytes())
+}
+
+func newTestSideChannel() (*simulation.SideChannel, *testSideTxProcessor) {
+	processor := &testSideTxProcessor{}
+	encoder := func(tx sdk.Tx) ([]byte, error) {
+		return []byte(tx.(authTypes.StdTx).Memo), nil
+	}
+
+	return simulation.NewSideChannel(processor, encoder), processor
+}
+
+func TestRandomSideTxResult(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	results := make(map[abci.SideTxResultType]int)
+	for i := 0; i < 1000; i++ {
+		results[RandomSideTxResult(r, abci.ResponseDeliverSideTx{Result: abci.SideTxResultType_Yes})]++
+
+		// failed side-tx never reaches yes
+		require.NotEqual(t, abci.SideTxResultType_Yes, RandomSideTxResult(r, abci.ResponseDeliverSideTx{Result: abci.SideTxResultType_Skip}))
+	}
+
+	require.Greater(t, results[abci.SideTxResultType_Yes], results[abci.SideTxResultType_Skip])
+	require.Greater(t, results[abci.SideTxResultType_Skip], 0)
+	require.Greater(t, results[abci.SideTxResultType_No], 0)
+}
+
+func TestDeliverSideTx(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sideChannel, processor := newTestSideChannel()
+	votes := []abci.VoteInfo{{Validator: abci.Validator{Address: []byte("validator"), Power: 1}, SignedLastBlock: true}}
+
+	// failed msg handler writes nothing and side-tx is not voted on
+	ctx := newTestContext(t).WithBlockHeight(10)
+	opMsg := DeliverSideTx(r, sideChannel, ctx, testMsg{}, testHandler(false), testSideTxHandler(abci.SideTxResultType_Yes))
+	require.False(t, opMsg.OK)
+	require.False(t, ctx.KVStore(testStoreKey).Has(handledKey))
+	require.Empty(t, processor.txs)
+	require.Empty(t, sideChannel.RequestBeginSideBlock(abci.Header{Height: 12}, votes).SideTxResults)
+
+	// side-tx is stored by the processor and voted on in the side block two blocks later
+	opMsg = DeliverSideTx(r, sideChannel, ctx, testMsg{}, testHandler(true), testSideTxHandler(abci.SideTxResultType_Yes))
+	require.True(t, opMsg.OK)
+	require.True(t, ctx.KVStore(testStoreKey).Has(handledKey))
+	require.Len(t, processor.txs, 1)
+	require.Empty(t, sideChannel.RequestBeginSideBlock(abci.Header{Height: 11}, votes).SideTxResults)
+
+	req := sideChannel.RequestBeginSideBlock(abci.Header{Height: 12}, votes)
+	require.Len(t, req.SideTxResults, 1)
+	require.Equal(t, processor.txs[0].Hash(), req.SideTxResults[0].TxHash)
+	require.Equal(t, opMsg.Comment, req.SideTxResults[0].Sigs[0].Result.String())
+}
+
+func TestDeliverMsg(t *testing.T) {
+	ctx := newTestContext(t)
+	require.False(t, DeliverMsg(ctx, testMsg{}, testHandler(false)).OK)
+	require.False(t, ctx.KVStore(testStoreKey).Has(handledKey))
+
+	require.True(t, DeliverMsg(ctx, testMsg{}, testHandler(true)).OK)
+	require.True(t, ctx.KVStore(testStoreKey).Has(handledKey))
+}
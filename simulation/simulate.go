@@ -40,7 +40,7 @@ func initChain(
 // TODO: split this monster function up
 func SimulateFromSeed(
 	tb testing.TB, w io.Writer, app *baseapp.BaseApp,
-	appStateFn simulation.AppStateFn, ops WeightedOperations,
+	appStateFn simulation.AppStateFn, ops WeightedOperations, sideChannel *simulation.SideChannel,
 	blackListedAccs map[string]bool, config simulation.Config,
 ) (stopEarly bool, exportedParams Params, err error) {
 
@@ -145,6 +145,9 @@ func SimulateFromSeed(
 		logWriter.AddEntry(BeginBlockEntry(int64(height)))
 		app.BeginBlock(request)
 
+		// Run the BeginSideBlock handler with the votes on side-txs of past blocks
+		app.BeginSideBlock(sideChannel.RequestBeginSideBlock(header, request.LastCommitInfo.Votes))
+
 		ctx := app.NewContext(false, header)
 
 		// Run queued operations. Ignores blocksize if blocksize is too small
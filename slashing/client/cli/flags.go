@@ -10,4 +10,6 @@ const (
 	FlagSlashInfoBytes   = "slashinfo-bytes"
 	FlagTickID           = "tick-id"
 	FlagBlockNumber      = "block-number"
+	FlagHeaderA          = "header-a"
+	FlagHeaderB          = "header-b"
 )
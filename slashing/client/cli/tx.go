@@ -31,6 +31,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdUnjail(cdc),
 		GetCmdTick(cdc),
 		GetCmdTickAck(cdc),
+		GetCmdSubmitBorEvidence(cdc),
 	)...)
 
 	return slashingTxCmd
@@ -178,3 +179,44 @@ func GetCmdTickAck(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdSubmitBorEvidence submits bor producer double-sign evidence
+func GetCmdSubmitBorEvidence(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-bor-evidence",
+		Args:  cobra.NoArgs,
+		Short: "submit two conflicting bor headers sealed by same producer",
+		Long: `submit bor producer double-sign evidence with rlp encoded headers:
+
+$ <appcli> tx slashing submit-bor-evidence --header-a 0x... --header-b 0x... --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			msg := types.NewMsgSubmitBorEvidence(
+				proposer,
+				types.BorEquivocation{
+					HeaderA: hmTypes.HexToHexBytes(viper.GetString(FlagHeaderA)),
+					HeaderB: hmTypes.HexToHexBytes(viper.GetString(FlagHeaderB)),
+				},
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().String(FlagHeaderA, "", "--header-a=<rlp-encoded-bor-header>")
+	cmd.Flags().String(FlagHeaderB, "", "--header-b=<rlp-encoded-bor-header>")
+	cmd.MarkFlagRequired(FlagHeaderA)
+	cmd.MarkFlagRequired(FlagHeaderB)
+
+	return cmd
+}
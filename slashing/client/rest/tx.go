@@ -29,6 +29,11 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/slashing/tick-ack",
 		newTickAckHandler(cliCtx),
 	).Methods("POST")
+
+	r.HandleFunc(
+		"/slashing/bor-evidence",
+		newSubmitBorEvidenceHandler(cliCtx),
+	).Methods("POST")
 }
 
 // Unjail TX body
@@ -57,6 +62,12 @@ type TickAckReq struct {
 	BlockNumber uint64       `json:"block_number" yaml:"block_number"`
 }
 
+type BorEvidenceReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	HeaderA string       `json:"header_a"`
+	HeaderB string       `json:"header_b"`
+}
+
 func newUnjailRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// read req from Request
@@ -138,3 +149,32 @@ func newTickAckHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func newSubmitBorEvidenceHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req BorEvidenceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgSubmitBorEvidence(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			types.BorEquivocation{
+				HeaderA: hmTypes.HexToHexBytes(req.HeaderA),
+				HeaderB: hmTypes.HexToHexBytes(req.HeaderB),
+			},
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -29,6 +29,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		}
 	}
 
+	for valIDStr, array := range data.MissedSideTxs {
+		for _, missed := range array {
+			valID, _ := strconv.ParseUint(valIDStr, 10, 64)
+			keeper.SetValidatorMissedSideTxBitArray(ctx, hmTypes.ValidatorID(valID), missed.Index, missed.Missed)
+		}
+	}
+
 	for _, valSlashInfo := range data.BufferValSlashingInfo {
 		keeper.SetBufferValSlashingInfo(ctx, valSlashInfo.ID, *valSlashInfo)
 	}
@@ -51,6 +58,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data types.GenesisState) {
 	params := keeper.GetParams(ctx)
 	signingInfos := make(map[string]hmTypes.ValidatorSigningInfo)
 	missedBlocks := make(map[string][]types.MissedBlock)
+	missedSideTxs := make(map[string][]types.MissedBlock)
 	keeper.IterateValidatorSigningInfos(ctx, func(valID hmTypes.ValidatorID, info hmTypes.ValidatorSigningInfo) (stop bool) {
 		signingInfos[valID.String()] = info
 		localMissedBlocks := []types.MissedBlock{}
@@ -60,6 +68,13 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data types.GenesisState) {
 			return false
 		})
 		missedBlocks[valID.String()] = localMissedBlocks
+
+		localMissedSideTxs := []types.MissedBlock{}
+		keeper.IterateValidatorMissedSideTxBitArray(ctx, valID, func(index int64, missed bool) (stop bool) {
+			localMissedSideTxs = append(localMissedSideTxs, types.NewMissedBlock(index, missed))
+			return false
+		})
+		missedSideTxs[valID.String()] = localMissedSideTxs
 		return false
 	})
 
@@ -71,5 +86,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data types.GenesisState) {
 		missedBlocks,
 		bufSlashInfos,
 		tickSlashInfos,
-		keeper.GetTickCount(ctx))
+		keeper.GetTickCount(ctx),
+		missedSideTxs)
 }
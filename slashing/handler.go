@@ -27,6 +27,8 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 			return handleMsgTickAck(ctx, msg, k, contractCaller)
 		case types.MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k, contractCaller)
+		case types.MsgSubmitBorEvidence:
+			return handleMsgSubmitBorEvidence(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in slashing module").Result()
 		}
@@ -140,6 +142,30 @@ func handleMsgUnjail(ctx sdk.Context, msg types.MsgUnjail, k Keeper, contractCal
 	}
 }
 
+// handleMsgSubmitBorEvidence - handles bor producer double-sign evidence
+// 1. recover producer from both header seals (done in ValidateBasic)
+// 2. check producer was selected for span covering the bor block
+// 3. slash producer and emit event
+func handleMsgSubmitBorEvidence(ctx sdk.Context, msg types.MsgSubmitBorEvidence, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating bor evidence msg",
+		"from", msg.From,
+		"blockNumber", msg.Evidence.GetHeight(),
+	)
+
+	if !k.GetParams(ctx).EnableSlashing {
+		k.Logger(ctx).Error("Slashing is not enabled")
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Slashing is not enabled").Result()
+	}
+
+	if err := k.HandleBorDoubleSign(ctx, msg.Evidence); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 /*
 	handleMsgTickAck - handle msg tick ack event
 	1. validate the tx hash in the event
@@ -3,8 +3,10 @@ package slashing
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/slashing/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -95,6 +97,89 @@ func (k *Keeper) HandleValidatorSignature(ctx sdk.Context, addr []byte, power in
 	return nil
 }
 
+// HandleValidatorSideTxVotes handles the votes of a validator on the side-txs decided in a side block, in order,
+// must be called once per validator per side block. A vote is counted as missed if validator was absent or
+// voted skip while the majority decided yes or no.
+// Params are passed by the caller, which reads them once for all validators of a side block.
+func (k *Keeper) HandleValidatorSideTxVotes(ctx sdk.Context, params types.Params, addr []byte, missedVotes []bool) error {
+	signerAddress := hmTypes.BytesToHeimdallAddress(addr)
+	k.Logger(ctx).Debug("Processing side-tx downtime request for validator", "address", signerAddress, "votes", len(missedVotes))
+
+	if params.SideTxWindow <= 0 || len(missedVotes) == 0 {
+		return nil
+	}
+
+	// NOTE: RoundInt64 will never panic as minSideTxParticipation is less than 1.
+	minSideTxParticipation := params.MinSideTxParticipation.MulInt64(params.SideTxWindow).RoundInt64()
+	maxMissed := params.SideTxWindow - minSideTxParticipation
+
+	// fetch validator Info
+	validator, err := k.sk.GetValidatorInfo(ctx, signerAddress.Bytes())
+	if err != nil {
+		k.Logger(ctx).Error("validator info not found", "address", signerAddress)
+		return err
+	}
+
+	// fetch signing info
+	signInfo, found := k.GetValidatorSigningInfo(ctx, validator.ID)
+	if !found {
+		panic(fmt.Sprintf("Expected signing info for validator %s but not found", addr))
+	}
+
+	for _, missed := range missedVotes {
+		// this is a relative index, so it counts side-txs the validator *should* have voted on
+		index := signInfo.SideTxIndexOffset % params.SideTxWindow
+		signInfo.SideTxIndexOffset++
+
+		// Update missed side-tx bit array & counter
+		// This counter just tracks the sum of the bit array, no bit is set while it is zero
+		previous := signInfo.MissedSideTxsCounter > 0 && k.GetValidatorMissedSideTxBitArray(ctx, validator.ID, index)
+		switch {
+		case !previous && missed:
+			// Array value has changed from not missed to missed, increment counter
+			k.SetValidatorMissedSideTxBitArray(ctx, validator.ID, index, true)
+			signInfo.MissedSideTxsCounter++
+		case previous && !missed:
+			// Array value has changed from missed to not missed, decrement counter
+			k.SetValidatorMissedSideTxBitArray(ctx, validator.ID, index, false)
+			signInfo.MissedSideTxsCounter--
+		default:
+			// Array value at this index has not changed, no need to update counter
+		}
+
+		if missed {
+			k.Logger(ctx).Info(
+				fmt.Sprintf("Absent validator %s on side-tx at height %d, %d missed, threshold %d", validator.ID, ctx.BlockHeight(), signInfo.MissedSideTxsCounter, minSideTxParticipation))
+		}
+
+		// SLASH - if validator has been around for a full window and has missed too many side-txs, punish them
+		if signInfo.SideTxIndexOffset >= params.SideTxWindow && signInfo.MissedSideTxsCounter > maxMissed {
+
+			valSlashInfo, found := k.GetBufferValSlashingInfo(ctx, validator.ID)
+			// if val is already in jailed state(in buffer or fixed), don't slash him anymore.
+			if validator.Jailed || (found && valSlashInfo.IsJailed) {
+				k.Logger(ctx).Info(fmt.Sprintf("Validator %s would have been slashed for side-tx downtime, but was already jailed", validator.ID))
+			} else {
+				// Side-tx downtime confirmed: slash the validator
+				k.Logger(ctx).Info(fmt.Sprintf("Validator %s below side-tx participation threshold of %d",
+					validator.ID, minSideTxParticipation))
+
+				slashedAmount := k.SlashInterim(ctx, validator.ID, params.SlashFractionSideTxDowntime)
+				k.Logger(ctx).Debug("Interim side-tx downtime slashing successful", "valID", validator.ID, "slashedAmount", slashedAmount)
+
+				// We need to reset the counter & array so that the validator won't be immediately slashed again.
+				signInfo.MissedSideTxsCounter = 0
+				signInfo.SideTxIndexOffset = 0
+				k.clearValidatorMissedSideTxBitArray(ctx, validator.ID)
+			}
+		}
+	}
+
+	// Set the updated signing info
+	k.SetValidatorSigningInfo(ctx, validator.ID, signInfo)
+	return nil
+}
+
 // HandleDoubleSign implements an equivocation evidence handler. Assuming the
 // evidence is valid, the validator committing the misbehavior will be slashed,
 // jailed
@@ -149,3 +234,91 @@ func (k *Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation)
 
 	return nil
 }
+
+// HandleBorDoubleSign implements a bor equivocation evidence handler. Assuming the
+// evidence is valid, the producer who sealed both headers will be slashed.
+//
+// The evidence is considered invalid if:
+// - the producer does not exist
+// - the producer was not selected for the span covering the bor block
+// - the span covering the bor block is more than MaxBorEvidenceSpans spans before the last span
+// - the evidence is already handled
+func (k *Keeper) HandleBorDoubleSign(ctx sdk.Context, evidence types.BorEquivocation) sdk.Error {
+	signerAddress := hmTypes.BytesToHeimdallAddress(evidence.GetConsensusAddress())
+	blockNumber := evidence.GetHeight()
+	k.Logger(ctx).Debug("Processing bor doubleSign request for producer", "address", signerAddress, "blockNumber", blockNumber)
+
+	if k.HasBorEvidence(ctx, signerAddress, blockNumber) {
+		k.Logger(ctx).Error("Bor double sign already handled", "address", signerAddress, "blockNumber", blockNumber)
+		return hmCommon.ErrEvidenceAlreadyHandled(k.Codespace())
+	}
+
+	validator, err := k.sk.GetValidatorInfo(ctx, signerAddress.Bytes())
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching validator", "signerAddress", signerAddress)
+		return hmCommon.ErrNoValidator(k.Codespace())
+	}
+
+	// check if validator was producer for bor block
+	span, err := k.moduleCommunicator.GetBorSpanForBlock(ctx, uint64(blockNumber))
+	if err != nil {
+		k.Logger(ctx).Error("Span not found for bor block", "blockNumber", blockNumber, "error", err)
+		return hmCommon.ErrSpanNotFound(k.Codespace())
+	}
+
+	lastSpan, err := k.moduleCommunicator.GetBorLastSpan(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Last span not found", "error", err)
+		return hmCommon.ErrSpanNotFound(k.Codespace())
+	}
+
+	// check if evidence is within evidence window
+	params := k.GetParams(ctx)
+	if span.ID+params.MaxBorEvidenceSpans < lastSpan.ID {
+		k.Logger(ctx).Error("Bor double sign evidence is too old", "spanID", span.ID, "lastSpanID", lastSpan.ID, "maxBorEvidenceSpans", params.MaxBorEvidenceSpans)
+		return hmCommon.ErrInvalidEvidence(k.Codespace(), "Evidence is too old")
+	}
+
+	isProducer := false
+	for _, producer := range span.SelectedProducers {
+		if producer.ID == validator.ID {
+			isProducer = true
+			break
+		}
+	}
+
+	if !isProducer {
+		k.Logger(ctx).Error("Validator was not a producer for bor block", "valID", validator.ID, "spanID", span.ID, "blockNumber", blockNumber)
+		return hmCommon.ErrInvalidEvidence(k.Codespace(), "Validator was not a producer for bor block")
+	}
+
+	if ok := k.HasValidatorSigningInfo(ctx, validator.ID); !ok {
+		panic(fmt.Sprintf("expected signing info for validator %s but not found", validator.ID))
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("confirmed bor double sign from %s at bor block %d", validator.ID, blockNumber))
+
+	// mark evidence as handled
+	k.SetBorEvidence(ctx, signerAddress, blockNumber)
+
+	valSlashInfo, found := k.GetBufferValSlashingInfo(ctx, validator.ID)
+	// if val is already in jailed state(in buffer or fixed), don't slash him anymore.
+	if validator.Jailed || (found && valSlashInfo.IsJailed) {
+		k.Logger(ctx).Info(fmt.Sprintf("Validator %s would have been slashed for bor double sign, but was already jailed", validator.ID))
+	} else {
+		slashedAmount := k.SlashInterim(ctx, validator.ID, params.SlashFractionDoubleSign)
+		k.Logger(ctx).Debug("Interim bor double sign slashing successful", "valID", validator.ID, "slashedAmount", slashedAmount)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBorDoubleSign,
+			sdk.NewAttribute(types.AttributeKeyValID, validator.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, signerAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(blockNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package slashing_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+)
+
+//
+// Create test app
+//
+
+// returns context and app with params set on account keeper
+func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context) {
+	app := app.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+
+	return app, ctx
+}
@@ -0,0 +1,38 @@
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// RegisterInvariants registers all slashing invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "buffer-validators", BufferValidatorsInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the slashing module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return BufferValidatorsInvariant(keeper)(ctx)
+	}
+}
+
+// BufferValidatorsInvariant checks that the slashing buffer only holds slashing
+// info of validators present on store
+func BufferValidatorsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		keeper.IterateBufferValSlashingInfos(ctx, func(slashingInfo hmTypes.ValidatorSlashingInfo) (stop bool) {
+			if _, ok := keeper.sk.GetValidatorFromValID(ctx, slashingInfo.ID); !ok {
+				msg += fmt.Sprintf("\tbuffer references unknown validator %v\n", slashingInfo.ID)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "buffer validators", msg), msg != ""
+	}
+}
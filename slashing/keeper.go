@@ -16,6 +16,16 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+// ModuleCommunicator manages different module interaction
+type ModuleCommunicator interface {
+	// GetBorSpanForBlock returns span covering bor block
+	GetBorSpanForBlock(ctx sdk.Context, blockNumber uint64) (*hmTypes.Span, error)
+	// GetBorLastSpan returns last bor span
+	GetBorLastSpan(ctx sdk.Context) (*hmTypes.Span, error)
+	// IsUpgradeDone returns true if the software upgrade was applied on chain
+	IsUpgradeDone(ctx sdk.Context, name string) bool
+}
+
 // Keeper of the slashing store
 type Keeper struct {
 	cdc      *codec.Codec
@@ -27,17 +37,20 @@ type Keeper struct {
 
 	// chain manager keeper
 	chainKeeper chainmanager.Keeper
+	// module communicator
+	moduleCommunicator ModuleCommunicator
 }
 
 // NewKeeper creates a slashing keeper
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk staking.Keeper, paramSpace subspace.Subspace, codespace sdk.CodespaceType, chainKeeper chainmanager.Keeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk staking.Keeper, paramSpace subspace.Subspace, codespace sdk.CodespaceType, chainKeeper chainmanager.Keeper, moduleCommunicator ModuleCommunicator) Keeper {
 	return Keeper{
-		storeKey:    key,
-		cdc:         cdc,
-		sk:          sk,
-		paramSpace:  paramSpace.WithKeyTable(types.ParamKeyTable()),
-		codespace:   codespace,
-		chainKeeper: chainKeeper,
+		storeKey:           key,
+		cdc:                cdc,
+		sk:                 sk,
+		paramSpace:         paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:          codespace,
+		chainKeeper:        chainKeeper,
+		moduleCommunicator: moduleCommunicator,
 	}
 }
 
@@ -137,9 +150,7 @@ func (k *Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 // SetValidatorMissedBlockBitArray sets the bit that checks if the validator has
 // missed a block in the current window
 func (k *Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, valID hmTypes.ValidatorID, index int64, missed bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.BoolValue{Value: missed})
-	store.Set(types.GetValidatorMissedBlockBitArrayKey(valID.Bytes(), index), bz)
+	k.setMissedBit(ctx, types.GetValidatorMissedBlockBitArrayKey(valID.Bytes(), index), missed)
 }
 
 // clearValidatorMissedBlockBitArray deletes every instance of ValidatorMissedBlockBitArray in the store
@@ -152,6 +163,98 @@ func (k *Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, valID hmType
 	}
 }
 
+// GetValidatorMissedSideTxBitArray gets the bit for the missed side-txs array
+func (k *Keeper) GetValidatorMissedSideTxBitArray(ctx sdk.Context, valID hmTypes.ValidatorID, index int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorMissedSideTxBitArrayKey(valID.Bytes(), index))
+	var missed gogotypes.BoolValue
+	if bz == nil {
+		// lazy: treat empty key as not missed
+		return false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &missed)
+
+	return missed.Value
+}
+
+// IterateValidatorMissedSideTxBitArray iterates over the side-tx window
+// and performs a callback function
+func (k *Keeper) IterateValidatorMissedSideTxBitArray(ctx sdk.Context,
+	valID hmTypes.ValidatorID, handler func(index int64, missed bool) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	index := int64(0)
+	params := k.GetParams(ctx)
+	// Array may be sparse
+	for ; index < params.SideTxWindow; index++ {
+		var missed gogotypes.BoolValue
+		bz := store.Get(types.GetValidatorMissedSideTxBitArrayKey(valID.Bytes(), index))
+		if bz == nil {
+			continue
+		}
+
+		k.cdc.MustUnmarshalBinaryBare(bz, &missed)
+		if handler(index, missed.Value) {
+			break
+		}
+	}
+}
+
+// SetValidatorMissedSideTxBitArray sets the bit that checks if the validator has
+// missed a side-tx vote in the current window
+func (k *Keeper) SetValidatorMissedSideTxBitArray(ctx sdk.Context, valID hmTypes.ValidatorID, index int64, missed bool) {
+	k.setMissedBit(ctx, types.GetValidatorMissedSideTxBitArrayKey(valID.Bytes(), index), missed)
+}
+
+// setMissedBit stores a bit of a missed bit array. A false bool marshals to empty bytes,
+// so once the v0.3 upgrade is applied unset bits are deleted and the array is kept sparse.
+func (k *Keeper) setMissedBit(ctx sdk.Context, key []byte, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !missed && k.moduleCommunicator.IsUpgradeDone(ctx, hmTypes.UpgradeV03) {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.BoolValue{Value: missed})
+	store.Set(key, bz)
+}
+
+// clearValidatorMissedSideTxBitArray deletes every instance of ValidatorMissedSideTxBitArray in the store
+func (k *Keeper) clearValidatorMissedSideTxBitArray(ctx sdk.Context, valID hmTypes.ValidatorID) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetValidatorMissedSideTxBitArrayPrefixKey(valID.Bytes())
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// skip keys of other validators sharing the id prefix (eg. 1 and 12)
+		if len(iter.Key()) != len(prefix)+8 {
+			continue
+		}
+		store.Delete(iter.Key())
+	}
+}
+
+// SetBorEvidence marks bor double-sign of producer at bor block as handled
+func (k *Keeper) SetBorEvidence(ctx sdk.Context, signer hmTypes.HeimdallAddress, blockNumber int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBorEvidenceKey(signer.Bytes(), blockNumber), types.DefaultValue)
+}
+
+// HasBorEvidence checks if bor double-sign of producer at bor block is already handled
+func (k *Keeper) HasBorEvidence(ctx sdk.Context, signer hmTypes.HeimdallAddress, blockNumber int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetBorEvidenceKey(signer.Bytes(), blockNumber))
+}
+
+// MinSideTxParticipation - minimum side-txs voted per window
+func (k *Keeper) MinSideTxParticipation(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+
+	// NOTE: RoundInt64 will never panic as minSideTxParticipation is
+	//       less than 1.
+	return params.MinSideTxParticipation.MulInt64(params.SideTxWindow).RoundInt64()
+}
+
 // MinSignedPerWindow - minimum blocks signed per window
 func (k *Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
 	var minSignedPerWindow sdk.Dec
@@ -0,0 +1,240 @@
+package slashing_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	borConsensus "github.com/maticnetwork/bor/consensus/bor"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/app"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/slashing"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	stakingSim "github.com/maticnetwork/heimdall/staking/simulation"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Test suite
+//
+
+// KeeperTestSuite integrate test suite context object
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+//
+// Tests
+//
+
+func (suite *KeeperTestSuite) TestHandleValidatorSideTxVotes() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.SlashingKeeper
+
+	params := keeper.GetParams(ctx)
+	params.SideTxWindow = 10
+	params.MinSideTxParticipation = sdk.NewDecWithPrec(5, 1)
+	params.SlashFractionSideTxDowntime = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, params)
+
+	validator := stakingSim.GenRandomVal(1, 0, 100, 10, false, 1)[0]
+	require.NoError(t, app.StakingKeeper.AddValidator(ctx, validator))
+	keeper.SetValidatorSigningInfo(ctx, validator.ID, hmTypes.NewValidatorSigningInfo(validator.ID, 0, 0, 0))
+
+	t.Run("Tracking", func(t *testing.T) {
+		require.NoError(t, keeper.HandleValidatorSideTxVotes(ctx, keeper.GetParams(ctx), validator.Signer.Bytes(), []bool{true, false}))
+
+		signInfo, found := keeper.GetValidatorSigningInfo(ctx, validator.ID)
+		require.True(t, found)
+		require.Equal(t, int64(2), signInfo.SideTxIndexOffset)
+		require.Equal(t, int64(1), signInfo.MissedSideTxsCounter)
+		require.True(t, keeper.GetValidatorMissedSideTxBitArray(ctx, validator.ID, 0))
+		require.False(t, keeper.GetValidatorMissedSideTxBitArray(ctx, validator.ID, 1))
+
+		// block signing is tracked separately
+		require.Equal(t, int64(0), signInfo.IndexOffset)
+		require.Equal(t, int64(0), signInfo.MissedBlocksCounter)
+	})
+
+	t.Run("NoSlashWithinWindow", func(t *testing.T) {
+		// missed 6 of first 9 side-txs, window not completed yet
+		missedVotes := make([]bool, 7)
+		for i := range missedVotes {
+			missedVotes[i] = i%3 != 0
+		}
+		require.NoError(t, keeper.HandleValidatorSideTxVotes(ctx, keeper.GetParams(ctx), validator.Signer.Bytes(), missedVotes))
+
+		signInfo, _ := keeper.GetValidatorSigningInfo(ctx, validator.ID)
+		require.Equal(t, int64(9), signInfo.SideTxIndexOffset)
+		require.Equal(t, int64(5), signInfo.MissedSideTxsCounter)
+
+		_, found := keeper.GetBufferValSlashingInfo(ctx, validator.ID)
+		require.False(t, found)
+	})
+
+	t.Run("Slash", func(t *testing.T) {
+		// 6 missed in full window of 10, more than allowed 5
+		require.NoError(t, keeper.HandleValidatorSideTxVotes(ctx, keeper.GetParams(ctx), validator.Signer.Bytes(), []bool{true}))
+
+		slashInfo, found := keeper.GetBufferValSlashingInfo(ctx, validator.ID)
+		require.True(t, found)
+		require.Equal(t, uint64(10), slashInfo.SlashedAmount)
+
+		// counter and array are reset after slashing
+		signInfo, _ := keeper.GetValidatorSigningInfo(ctx, validator.ID)
+		require.Equal(t, int64(0), signInfo.SideTxIndexOffset)
+		require.Equal(t, int64(0), signInfo.MissedSideTxsCounter)
+		require.False(t, keeper.GetValidatorMissedSideTxBitArray(ctx, validator.ID, 0))
+	})
+
+	t.Run("UnknownValidator", func(t *testing.T) {
+		require.Error(t, keeper.HandleValidatorSideTxVotes(ctx, keeper.GetParams(ctx), hmTypes.SampleHeimdallAddress("unknown").Bytes(), []bool{true}))
+	})
+}
+
+func (suite *KeeperTestSuite) TestHandleMsgSubmitBorEvidence() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.SlashingKeeper
+	handler := slashing.NewHandler(keeper, &helper.ContractCaller{})
+
+	params := keeper.GetParams(ctx)
+	params.EnableSlashing = true
+	params.SlashFractionDoubleSign = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, params)
+
+	// producer with known key
+	privKey := secp256k1.GenPrivKey()
+	producerKey, err := crypto.ToECDSA(privKey[:])
+	require.NoError(t, err)
+	rawPubKey := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	pubkey := hmTypes.NewPubKey(rawPubKey[:])
+	producer := hmTypes.Validator{
+		ID:          hmTypes.NewValidatorID(1),
+		StartEpoch:  0,
+		EndEpoch:    100,
+		VotingPower: 100,
+		Signer:      hmTypes.HexToHeimdallAddress(pubkey.Address().String()),
+		PubKey:      pubkey,
+	}
+	require.NoError(t, app.StakingKeeper.AddValidator(ctx, producer))
+	keeper.SetValidatorSigningInfo(ctx, producer.ID, hmTypes.NewValidatorSigningInfo(producer.ID, 0, 0, 0))
+
+	// validator which is not selected as producer
+	other := stakingSim.GenRandomVal(1, 0, 100, 100, false, 2)[0]
+	require.NoError(t, app.StakingKeeper.AddValidator(ctx, other))
+
+	valSet := hmTypes.NewValidatorSet([]*hmTypes.Validator{&producer, &other})
+	require.NoError(t, app.BorKeeper.AddNewSpan(ctx, hmTypes.NewSpan(0, 0, 255, *valSet, []hmTypes.Validator{producer}, "15001")))
+	require.NoError(t, app.BorKeeper.AddNewSpan(ctx, hmTypes.NewSpan(1, 256, 6655, *valSet, []hmTypes.Validator{other}, "15001")))
+	app.BorKeeper.UpdateLastSpan(ctx, 1)
+
+	from := hmTypes.BytesToHeimdallAddress([]byte("submitter"))
+	newMsg := func(number int64) types.MsgSubmitBorEvidence {
+		evidence, err := types.NewBorEquivocation(
+			sealBorHeader(t, producerKey, number, common.HexToHash("0x01")),
+			sealBorHeader(t, producerKey, number, common.HexToHash("0x02")),
+		)
+		require.NoError(t, err)
+		return types.NewMsgSubmitBorEvidence(from, evidence)
+	}
+
+	t.Run("Slash", func(t *testing.T) {
+		msg := newMsg(100)
+		require.Nil(t, msg.ValidateBasic())
+
+		result := handler(ctx, msg)
+		require.True(t, result.IsOK(), result.Log)
+		require.Equal(t, types.EventTypeBorDoubleSign, result.Events[len(result.Events)-1].Type)
+
+		slashInfo, found := keeper.GetBufferValSlashingInfo(ctx, producer.ID)
+		require.True(t, found)
+		require.Equal(t, uint64(10), slashInfo.SlashedAmount)
+	})
+
+	t.Run("AlreadyHandled", func(t *testing.T) {
+		result := handler(ctx, newMsg(100))
+		require.Equal(t, hmCommon.CodeEvidenceAlreadyHandled, result.Code)
+	})
+
+	t.Run("NotProducer", func(t *testing.T) {
+		// producer is not selected in span 1
+		result := handler(ctx, newMsg(300))
+		require.Equal(t, hmCommon.CodeInvalidEvidence, result.Code)
+	})
+
+	t.Run("SpanNotFound", func(t *testing.T) {
+		result := handler(ctx, newMsg(10000))
+		require.Equal(t, hmCommon.CodeSpanNotFound, result.Code)
+	})
+
+	t.Run("TooOld", func(t *testing.T) {
+		params := keeper.GetParams(ctx)
+		params.MaxBorEvidenceSpans = 1
+		keeper.SetParams(ctx, params)
+
+		// span 0 is out of the evidence window once span 2 is the last span
+		require.NoError(t, app.BorKeeper.AddNewSpan(ctx, hmTypes.NewSpan(2, 6656, 12955, *valSet, []hmTypes.Validator{other}, "15001")))
+		result := handler(ctx, newMsg(150))
+		require.Equal(t, hmCommon.CodeInvalidEvidence, result.Code)
+		require.Contains(t, result.Log, "too old")
+		require.False(t, keeper.HasBorEvidence(ctx, producer.Signer, 150))
+	})
+}
+
+//
+// utils
+//
+
+// sealBorHeader creates bor header at block number sealed by given key
+func sealBorHeader(t *testing.T, key *ecdsa.PrivateKey, number int64, root common.Hash) *ethTypes.Header {
+	header := &ethTypes.Header{
+		Number:     big.NewInt(number),
+		Difficulty: big.NewInt(1),
+		Root:       root,
+		Extra:      make([]byte, 32+65),
+	}
+
+	sig, err := crypto.Sign(borConsensus.SealHash(header).Bytes(), key)
+	require.NoError(t, err)
+	copy(header.Extra[32:], sig)
+
+	return header
+}
+
+func (suite *KeeperTestSuite) TestBufferValidatorsInvariant() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.SlashingKeeper
+
+	validator := stakingSim.GenRandomVal(1, 0, 100, 10, false, 1)[0]
+	require.NoError(t, app.StakingKeeper.AddValidator(ctx, validator))
+
+	keeper.SetBufferValSlashingInfo(ctx, validator.ID, hmTypes.NewValidatorSlashingInfo(validator.ID, 10, false))
+	_, broken := slashing.BufferValidatorsInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// slashing info of validator missing on store
+	unknownID := hmTypes.NewValidatorID(100)
+	keeper.SetBufferValSlashingInfo(ctx, unknownID, hmTypes.NewValidatorSlashingInfo(unknownID, 10, false))
+	_, broken = slashing.BufferValidatorsInvariant(keeper)(ctx)
+	require.True(t, broken)
+}
@@ -0,0 +1,56 @@
+package v0_3
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/migrate"
+)
+
+// Values of the side-tx participation params added in v0.3
+var (
+	SideTxWindow                = int64(100)
+	MinSideTxParticipation      = sdk.NewDecWithPrec(5, 1)
+	SlashFractionSideTxDowntime = sdk.NewDecWithPrec(1, 2)
+)
+
+// MaxBorEvidenceSpans is the bor double sign evidence window added in v0.3
+var MaxBorEvidenceSpans = uint64(100)
+
+// Migrate adds the side-tx participation params, the bor evidence window
+// and the empty missed side-tx votes
+func Migrate(oldGenState json.RawMessage) (json.RawMessage, error) {
+	genState, err := migrate.DecodeObject(oldGenState)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := genState.Object("params")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := params.SetDefault("side_tx_window", SideTxWindow); err != nil {
+		return nil, err
+	}
+	if err := params.SetDefault("min_side_tx_participation", MinSideTxParticipation); err != nil {
+		return nil, err
+	}
+	if err := params.SetDefault("slash_fraction_side_tx_downtime", SlashFractionSideTxDowntime); err != nil {
+		return nil, err
+	}
+	if err := params.SetDefault("max_bor_evidence_spans", MaxBorEvidenceSpans); err != nil {
+		return nil, err
+	}
+
+	if err := genState.Set("params", params); err != nil {
+		return nil, err
+	}
+
+	if err := genState.SetDefault("missed_side_txs", migrate.EmptyObject); err != nil {
+		return nil, err
+	}
+
+	return genState.Marshal()
+}
@@ -26,6 +26,11 @@ const (
 	SlashFractionLimit      = "slash_fraction_limit"
 	JailFractionLimit       = "jail_fraction_limit"
 	MaxEvidenceAge          = "max_evidence_age"
+
+	SideTxWindow                = "side_tx_window"
+	MinSideTxParticipation      = "min_side_tx_participation"
+	SlashFractionSideTxDowntime = "slash_fraction_side_tx_downtime"
+	MaxBorEvidenceSpans         = "max_bor_evidence_spans"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -73,6 +78,26 @@ func GenEnableslashing(r *rand.Rand) bool {
 	return (r.Intn(200)%10 == 0)
 }
 
+// GenSideTxWindow randomized SideTxWindow
+func GenSideTxWindow(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 10, 1000))
+}
+
+// GenMinSideTxParticipation randomized MinSideTxParticipation
+func GenMinSideTxParticipation(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(10)), 1)
+}
+
+// GenSlashFractionSideTxDowntime randomized SlashFractionSideTxDowntime
+func GenSlashFractionSideTxDowntime(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
+}
+
+// GenMaxBorEvidenceSpans randomized MaxBorEvidenceSpans
+func GenMaxBorEvidenceSpans(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 200))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -129,12 +154,38 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { enableSlashing = GenEnableslashing(r) },
 	)
 
+	var sideTxWindow int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SideTxWindow, &sideTxWindow, simState.Rand,
+		func(r *rand.Rand) { sideTxWindow = GenSideTxWindow(r) },
+	)
+
+	var minSideTxParticipation sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinSideTxParticipation, &minSideTxParticipation, simState.Rand,
+		func(r *rand.Rand) { minSideTxParticipation = GenMinSideTxParticipation(r) },
+	)
+
+	var slashFractionSideTxDowntime sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionSideTxDowntime, &slashFractionSideTxDowntime, simState.Rand,
+		func(r *rand.Rand) { slashFractionSideTxDowntime = GenSlashFractionSideTxDowntime(r) },
+	)
+
+	var maxBorEvidenceSpans uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxBorEvidenceSpans, &maxBorEvidenceSpans, simState.Rand,
+		func(r *rand.Rand) { maxBorEvidenceSpans = GenMaxBorEvidenceSpans(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime, slashFractionLimit, jailFractionLimit, maxEvidenceAge, enableSlashing,
+		sideTxWindow, minSideTxParticipation, slashFractionSideTxDowntime,
+		maxBorEvidenceSpans,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, nil, nil, nil, nil, uint64(0))
+	slashingGenesis := types.NewGenesisState(params, nil, nil, nil, nil, uint64(0), nil)
 
 	fmt.Printf("Selected randomly generated slashing parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, slashingGenesis.Params))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(slashingGenesis)
@@ -9,6 +9,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUnjail{}, "slashing/MsgUnjail", nil)
 	cdc.RegisterConcrete(MsgTick{}, "slashing/MsgTick", nil)
 	cdc.RegisterConcrete(MsgTickAck{}, "slashing/MsgTickAck", nil)
+	cdc.RegisterConcrete(MsgSubmitBorEvidence{}, "slashing/MsgSubmitBorEvidence", nil)
 
 }
 
@@ -10,6 +10,8 @@ const (
 	EventTypeUnjail      = "unjail"
 	EventTypeLiveness    = "liveness"
 
+	EventTypeBorDoubleSign = "bor-double-sign"
+
 	AttributeKeyAddress        = "address"
 	AttributeKeyValID          = "valid"
 	AttributeKeyHeight         = "height"
@@ -1,15 +1,23 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	borConsensus "github.com/maticnetwork/bor/consensus/bor"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/rlp"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // Evidence defines the contract which concrete evidence types of misbehavior
@@ -57,6 +65,12 @@ type Equivocation struct {
 const (
 	RouteEquivocation = "equivocation"
 	TypeEquivocation  = "equivocation"
+
+	RouteBorEquivocation = "bor_equivocation"
+	TypeBorEquivocation  = "bor_equivocation"
+
+	// borExtraSeal fixed number of extra-data suffix bytes reserved for bor producer seal
+	borExtraSeal = 65
 )
 
 // var _ exported.Evidence = (*Equivocation)(nil)
@@ -130,3 +144,151 @@ func ConvertDuplicateVoteEvidence(dupVote abci.Evidence) Evidence {
 		Time:             dupVote.Time,
 	}
 }
+
+// BorEquivocation implements the Evidence interface and defines evidence of a bor
+// producer sealing two different headers at the same height.
+type BorEquivocation struct {
+	HeaderA hmTypes.HexBytes `json:"header_a"` // rlp encoded bor header
+	HeaderB hmTypes.HexBytes `json:"header_b"` // rlp encoded bor header
+}
+
+// NewBorEquivocation creates bor equivocation from conflicting headers
+func NewBorEquivocation(headerA, headerB *ethTypes.Header) (BorEquivocation, error) {
+	a, err := rlp.EncodeToBytes(headerA)
+	if err != nil {
+		return BorEquivocation{}, err
+	}
+
+	b, err := rlp.EncodeToBytes(headerB)
+	if err != nil {
+		return BorEquivocation{}, err
+	}
+
+	return BorEquivocation{
+		HeaderA: a,
+		HeaderB: b,
+	}, nil
+}
+
+// Route returns the Evidence Handler route for a BorEquivocation type.
+func (e BorEquivocation) Route() string { return RouteBorEquivocation }
+
+// Type returns the Evidence Handler type for a BorEquivocation type.
+func (e BorEquivocation) Type() string { return TypeBorEquivocation }
+
+func (e BorEquivocation) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a BorEquivocation object.
+func (e BorEquivocation) Hash() []byte {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(&e))
+}
+
+// ValidateBasic checks that both headers are sealed by the same producer at the same height
+// and are different.
+func (e BorEquivocation) ValidateBasic() error {
+	headerA, err := DecodeBorHeader(e.HeaderA)
+	if err != nil {
+		return fmt.Errorf("invalid bor header: %v", err)
+	}
+
+	headerB, err := DecodeBorHeader(e.HeaderB)
+	if err != nil {
+		return fmt.Errorf("invalid bor header: %v", err)
+	}
+
+	if headerA.Number == nil || headerB.Number == nil || headerA.Number.Cmp(headerB.Number) != 0 {
+		return fmt.Errorf("bor headers are not at the same height")
+	}
+
+	if headerA.Hash() == headerB.Hash() {
+		return fmt.Errorf("bor headers are identical")
+	}
+
+	signerA, err := RecoverBorHeaderSigner(headerA)
+	if err != nil {
+		return fmt.Errorf("invalid bor header seal: %v", err)
+	}
+
+	signerB, err := RecoverBorHeaderSigner(headerB)
+	if err != nil {
+		return fmt.Errorf("invalid bor header seal: %v", err)
+	}
+
+	if !bytes.Equal(signerA.Bytes(), signerB.Bytes()) {
+		return fmt.Errorf("bor headers are sealed by different producers")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the address of producer who sealed both headers.
+// Returns nil for invalid evidence.
+func (e BorEquivocation) GetConsensusAddress() sdk.ConsAddress {
+	header, err := DecodeBorHeader(e.HeaderA)
+	if err != nil {
+		return nil
+	}
+
+	signer, err := RecoverBorHeaderSigner(header)
+	if err != nil {
+		return nil
+	}
+
+	return signer.Bytes()
+}
+
+// GetHeight returns the bor block number at which headers conflict.
+func (e BorEquivocation) GetHeight() int64 {
+	header, err := DecodeBorHeader(e.HeaderA)
+	if err != nil || header.Number == nil {
+		return 0
+	}
+
+	return header.Number.Int64()
+}
+
+// GetTime returns the time of the first bor header.
+func (e BorEquivocation) GetTime() time.Time {
+	header, err := DecodeBorHeader(e.HeaderA)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(header.Time), 0).UTC()
+}
+
+// GetValidatorPower is a no-op for the BorEquivocation type.
+func (e BorEquivocation) GetValidatorPower() int64 { return 0 }
+
+// GetTotalPower is a no-op for the BorEquivocation type.
+func (e BorEquivocation) GetTotalPower() int64 { return 0 }
+
+// DecodeBorHeader decodes rlp encoded bor header
+func DecodeBorHeader(data []byte) (*ethTypes.Header, error) {
+	var header ethTypes.Header
+	if err := rlp.DecodeBytes(data, &header); err != nil {
+		return nil, err
+	}
+
+	return &header, nil
+}
+
+// RecoverBorHeaderSigner recovers producer address from bor header seal
+func RecoverBorHeaderSigner(header *ethTypes.Header) (common.Address, error) {
+	if len(header.Extra) < borExtraSeal {
+		return common.Address{}, fmt.Errorf("bor header seal is missing")
+	}
+	signature := header.Extra[len(header.Extra)-borExtraSeal:]
+
+	pubkey, err := crypto.Ecrecover(borConsensus.SealHash(header).Bytes(), signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var signer common.Address
+	copy(signer[:], crypto.Keccak256(pubkey[1:])[12:])
+	return signer, nil
+}
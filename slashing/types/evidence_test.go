@@ -0,0 +1,70 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	borConsensus "github.com/maticnetwork/bor/consensus/bor"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// sealBorHeader creates bor header at block number sealed by given key
+func sealBorHeader(t *testing.T, key *ecdsa.PrivateKey, number int64, root common.Hash) *ethTypes.Header {
+	header := &ethTypes.Header{
+		Number:     big.NewInt(number),
+		Difficulty: big.NewInt(1),
+		Root:       root,
+		Time:       1600000000,
+		Extra:      make([]byte, 32+borExtraSeal),
+	}
+
+	sig, err := crypto.Sign(borConsensus.SealHash(header).Bytes(), key)
+	require.NoError(t, err)
+	copy(header.Extra[32:], sig)
+
+	return header
+}
+
+func TestBorEquivocation(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	producer := crypto.PubkeyToAddress(key.PublicKey)
+
+	headerA := sealBorHeader(t, key, 100, common.HexToHash("0x01"))
+	headerB := sealBorHeader(t, key, 100, common.HexToHash("0x02"))
+
+	t.Run("Valid", func(t *testing.T) {
+		evidence, err := NewBorEquivocation(headerA, headerB)
+		require.NoError(t, err)
+		require.NoError(t, evidence.ValidateBasic())
+		require.Equal(t, producer.Bytes(), evidence.GetConsensusAddress().Bytes())
+		require.Equal(t, int64(100), evidence.GetHeight())
+		require.Equal(t, int64(1600000000), evidence.GetTime().Unix())
+	})
+
+	t.Run("SameHeader", func(t *testing.T) {
+		evidence, _ := NewBorEquivocation(headerA, headerA)
+		require.Error(t, evidence.ValidateBasic())
+	})
+
+	t.Run("DifferentHeight", func(t *testing.T) {
+		evidence, _ := NewBorEquivocation(headerA, sealBorHeader(t, key, 101, common.HexToHash("0x02")))
+		require.Error(t, evidence.ValidateBasic())
+	})
+
+	t.Run("DifferentProducer", func(t *testing.T) {
+		evidence, _ := NewBorEquivocation(headerA, sealBorHeader(t, otherKey, 100, common.HexToHash("0x02")))
+		require.Error(t, evidence.ValidateBasic())
+	})
+
+	t.Run("MissingSeal", func(t *testing.T) {
+		unsealed := &ethTypes.Header{Number: big.NewInt(100), Difficulty: big.NewInt(1), Extra: make([]byte, 32)}
+		evidence, _ := NewBorEquivocation(headerA, unsealed)
+		require.Error(t, evidence.ValidateBasic())
+		require.Nil(t, BorEquivocation{HeaderA: []byte("invalid")}.GetConsensusAddress())
+	})
+}
@@ -18,6 +18,7 @@ type GenesisState struct {
 	BufferValSlashingInfo []*hmTypes.ValidatorSlashingInfo        `json:"buffer_val_slash_info" yaml:"buffer_val_slash_info"`
 	TickValSlashingInfo   []*hmTypes.ValidatorSlashingInfo        `json:"tick_val_slash_info" yaml:"tick_val_slash_info"`
 	TickCount             uint64                                  `json:"tick_count" yaml:"tick_count"`
+	MissedSideTxs         map[string][]MissedBlock                `json:"missed_side_txs" yaml:"missed_side_txs"`
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -28,6 +29,7 @@ func NewGenesisState(
 	bufferValSlashingInfo []*hmTypes.ValidatorSlashingInfo,
 	tickValSlashingInfo []*hmTypes.ValidatorSlashingInfo,
 	tickCount uint64,
+	missedSideTxs map[string][]MissedBlock,
 ) GenesisState {
 
 	return GenesisState{
@@ -37,6 +39,7 @@ func NewGenesisState(
 		BufferValSlashingInfo: bufferValSlashingInfo,
 		TickValSlashingInfo:   tickValSlashingInfo,
 		TickCount:             tickCount,
+		MissedSideTxs:         missedSideTxs,
 	}
 }
 
@@ -60,6 +63,8 @@ func DefaultGenesisState() GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: make(map[string]hmTypes.ValidatorSigningInfo),
 		MissedBlocks: make(map[string][]MissedBlock),
+
+		MissedSideTxs: make(map[string][]MissedBlock),
 	}
 }
 
@@ -90,6 +95,26 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	sideTxWindow := data.Params.SideTxWindow
+	if sideTxWindow < 0 {
+		return fmt.Errorf("side-tx window cannot be negative, is %d", sideTxWindow)
+	}
+
+	minSideTx := data.Params.MinSideTxParticipation
+	if !minSideTx.IsNil() && (minSideTx.IsNegative() || minSideTx.GT(sdk.OneDec())) {
+		return fmt.Errorf("min side-tx participation should be less than or equal to one and greater than zero, is %s", minSideTx.String())
+	}
+
+	sideTxDowntime := data.Params.SlashFractionSideTxDowntime
+	if !sideTxDowntime.IsNil() && (sideTxDowntime.IsNegative() || sideTxDowntime.GT(sdk.OneDec())) {
+		return fmt.Errorf("slashing fraction side-tx downtime should be less than or equal to one and greater than zero, is %s", sideTxDowntime.String())
+	}
+
+	borEvidenceSpans := data.Params.MaxBorEvidenceSpans
+	if borEvidenceSpans < 1 {
+		return fmt.Errorf("max bor evidence spans must be at least 1, is %d", borEvidenceSpans)
+	}
+
 	return nil
 }
 
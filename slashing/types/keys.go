@@ -37,6 +37,9 @@ var (
 	TickValSlashingInfoKey          = []byte{0x06} // Prefix for Slashing Info stored after tick tx
 	SlashingSequenceKey             = []byte{0x07} // prefix for each key for slashing sequence map
 	TickCountKey                    = []byte{0x08} // key to store Tick counts
+
+	ValidatorMissedSideTxBitArrayKey = []byte{0x09} // Prefix for missed side-tx bit array
+	BorEvidenceKey                   = []byte{0x0A} // Prefix for handled bor double-sign evidence
 )
 
 // GetValidatorSigningInfoKey - stored by *valID*
@@ -56,6 +59,25 @@ func GetValidatorMissedBlockBitArrayKey(valID []byte, i int64) []byte {
 	return append(GetValidatorMissedBlockBitArrayPrefixKey(valID), b...)
 }
 
+// GetValidatorMissedSideTxBitArrayPrefixKey - stored by *valID*
+func GetValidatorMissedSideTxBitArrayPrefixKey(valID []byte) []byte {
+	return append(ValidatorMissedSideTxBitArrayKey, valID...)
+}
+
+// GetValidatorMissedSideTxBitArrayKey - stored by *valID*
+func GetValidatorMissedSideTxBitArrayKey(valID []byte, i int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(i))
+	return append(GetValidatorMissedSideTxBitArrayPrefixKey(valID), b...)
+}
+
+// GetBorEvidenceKey - stored by producer and bor block number
+func GetBorEvidenceKey(signer []byte, blockNumber int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(blockNumber))
+	return append(append(BorEvidenceKey, signer...), b...)
+}
+
 // GetBufferValSlashingInfoKey - gets buffer val slashing info key
 func GetBufferValSlashingInfoKey(id []byte) []byte {
 	return append(BufferValSlashingInfoKey, id...)
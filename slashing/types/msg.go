@@ -202,3 +202,65 @@ func (msg MsgTickAck) GetLogIndex() uint64 {
 func (msg MsgTickAck) GetSideSignBytes() []byte {
 	return nil
 }
+
+//
+// Msg Submit Bor Evidence
+//
+
+var _ MsgSubmitEvidence = &MsgSubmitBorEvidence{}
+
+// MsgSubmitBorEvidence - struct for submitting bor producer double-sign evidence
+type MsgSubmitBorEvidence struct {
+	From     types.HeimdallAddress `json:"from"`
+	Evidence BorEquivocation       `json:"evidence"`
+}
+
+func NewMsgSubmitBorEvidence(from types.HeimdallAddress, evidence BorEquivocation) MsgSubmitBorEvidence {
+	return MsgSubmitBorEvidence{
+		From:     from,
+		Evidence: evidence,
+	}
+}
+
+// Type returns message type
+func (msg MsgSubmitBorEvidence) Type() string {
+	return "submit-bor-evidence"
+}
+
+func (msg MsgSubmitBorEvidence) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgSubmitBorEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgSubmitBorEvidence) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSubmitBorEvidence) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if err := msg.Evidence.ValidateBasic(); err != nil {
+		return hmCommon.ErrInvalidEvidence(hmCommon.DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// GetEvidence returns submitted evidence
+func (msg MsgSubmitBorEvidence) GetEvidence() Evidence {
+	return msg.Evidence
+}
+
+// GetSubmitter returns address of evidence submitter
+func (msg MsgSubmitBorEvidence) GetSubmitter() sdk.AccAddress {
+	return types.HeimdallAddressToAccAddress(msg.From)
+}
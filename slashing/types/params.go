@@ -13,6 +13,8 @@ const (
 	DefaultParamspace           = ModuleName
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSideTxWindow         = int64(100)
+	DefaultMaxBorEvidenceSpans  = uint64(100)
 )
 
 var (
@@ -23,6 +25,9 @@ var (
 	DefaultJailFractionLimit       = sdk.NewDec(1).Quo(sdk.NewDec(3))
 	DefaultMaxEvidenceAge          = 60 * 2 * time.Second
 	DefaultEnableSlashing          = false
+
+	DefaultMinSideTxParticipation      = sdk.NewDecWithPrec(5, 1)
+	DefaultSlashFractionSideTxDowntime = sdk.NewDec(1).Quo(sdk.NewDec(100))
 )
 
 // Parameter store keys
@@ -36,6 +41,12 @@ var (
 	KeyJailFractionLimit       = []byte("JailFractionLimit")
 	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
 	KeyEnableSlashing          = []byte("EnableSlashing")
+
+	KeySideTxWindow                = []byte("SideTxWindow")
+	KeyMinSideTxParticipation      = []byte("MinSideTxParticipation")
+	KeySlashFractionSideTxDowntime = []byte("SlashFractionSideTxDowntime")
+
+	KeyMaxBorEvidenceSpans = []byte("MaxBorEvidenceSpans")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -51,12 +62,20 @@ type Params struct {
 	JailFractionLimit       sdk.Dec       `json:"jail_fraction_limit" yaml:"jail_fraction_limit"`               // if slashedAmount crossed JailFraction of validatorPower, Jail him
 	MaxEvidenceAge          time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`
 	EnableSlashing          bool          `json:"enable_slashing" yaml:"enable_slashing"`
+
+	SideTxWindow                int64   `json:"side_tx_window" yaml:"side_tx_window"`                                   // number of decided side-txs tracked per validator
+	MinSideTxParticipation      sdk.Dec `json:"min_side_tx_participation" yaml:"min_side_tx_participation"`             // fraction of side-txs in window validator must vote on
+	SlashFractionSideTxDowntime sdk.Dec `json:"slash_fraction_side_tx_downtime" yaml:"slash_fraction_side_tx_downtime"` // fraction amount to slash on side-tx downtime
+
+	MaxBorEvidenceSpans uint64 `json:"max_bor_evidence_spans" yaml:"max_bor_evidence_spans"` // number of spans before last span bor double sign evidence is accepted for
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, slashFractionLimit sdk.Dec, jailFractionLimit sdk.Dec, maxEvidenceAge time.Duration, enableSlashing bool,
+	sideTxWindow int64, minSideTxParticipation, slashFractionSideTxDowntime sdk.Dec,
+	maxBorEvidenceSpans uint64,
 ) Params {
 
 	return Params{
@@ -69,6 +88,12 @@ func NewParams(
 		SlashFractionLimit:      slashFractionLimit,
 		JailFractionLimit:       jailFractionLimit,
 		EnableSlashing:          enableSlashing,
+
+		SideTxWindow:                sideTxWindow,
+		MinSideTxParticipation:      minSideTxParticipation,
+		SlashFractionSideTxDowntime: slashFractionSideTxDowntime,
+
+		MaxBorEvidenceSpans: maxBorEvidenceSpans,
 	}
 }
 
@@ -88,10 +113,16 @@ func (p Params) String() string {
   SlashFractionDowntime:   %s
   SlashFractionLimit:   %s
   JailFractionDowntime:   %s
-  EnableSlashing:   %s`,
+  EnableSlashing:   %v
+  SideTxWindow:   %d
+  MinSideTxParticipation:   %s
+  SlashFractionSideTxDowntime:   %s
+  MaxBorEvidenceSpans:   %d`,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign, p.MaxEvidenceAge,
-		p.SlashFractionDowntime, p.SlashFractionLimit, p.JailFractionLimit, p.EnableSlashing)
+		p.SlashFractionDowntime, p.SlashFractionLimit, p.JailFractionLimit, p.EnableSlashing,
+		p.SideTxWindow, p.MinSideTxParticipation, p.SlashFractionSideTxDowntime,
+		p.MaxBorEvidenceSpans)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -106,6 +137,10 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyJailFractionLimit, &p.JailFractionLimit},
 		{KeyMaxEvidenceAge, &p.MaxEvidenceAge},
 		{KeyEnableSlashing, &p.EnableSlashing},
+		{KeySideTxWindow, &p.SideTxWindow},
+		{KeyMinSideTxParticipation, &p.MinSideTxParticipation},
+		{KeySlashFractionSideTxDowntime, &p.SlashFractionSideTxDowntime},
+		{KeyMaxBorEvidenceSpans, &p.MaxBorEvidenceSpans},
 	}
 }
 
@@ -114,6 +149,8 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultSlashFractionLimit, DefaultJailFractionLimit, DefaultMaxEvidenceAge, DefaultEnableSlashing,
+		DefaultSideTxWindow, DefaultMinSideTxParticipation, DefaultSlashFractionSideTxDowntime,
+		DefaultMaxBorEvidenceSpans,
 	)
 }
 
@@ -190,3 +227,48 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateSideTxWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("side-tx window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinSideTxParticipation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("min side-tx participation cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min side-tx participation too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionSideTxDowntime(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("side-tx downtime slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("side-tx downtime slash fraction too large: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package snapshot
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// iavl orphan key prefix, followed by the last and first version the node
+// was alive at and the node hash
+const orphanKeyPrefix = 'o'
+
+// AppHash returns the app hash committed at height, failing when any store
+// no longer holds its tree at that height.
+func AppHash(db dbm.DB, height int64) ([]byte, error) {
+	cInfo, err := loadCommitInfo(db, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return commitHash(db, cInfo)
+}
+
+// Rollback reverts the multistore held in db to height, removing every later
+// version of each store. It returns the height the multistore was at.
+func Rollback(db dbm.DB, height int64) (int64, error) {
+	latest := getLatestVersion(db)
+	if height <= 0 || height >= latest {
+		return latest, fmt.Errorf("height must be between 1 and %d", latest-1)
+	}
+
+	cInfo, err := loadCommitInfo(db, height)
+	if err != nil {
+		return latest, err
+	}
+
+	latestInfo, err := loadCommitInfo(db, latest)
+	if err != nil {
+		return latest, err
+	}
+
+	batch := db.NewBatch()
+
+	stores := make(map[string]bool, len(cInfo.StoreInfos))
+	for _, info := range cInfo.StoreInfos {
+		stores[info.Name] = true
+		if err := rollbackStore(db, batch, []byte(storeKeyPrefix+info.Name+"/"), height); err != nil {
+			return latest, fmt.Errorf("store %s: %v", info.Name, err)
+		}
+	}
+
+	// stores added after height
+	for _, info := range latestInfo.StoreInfos {
+		if !stores[info.Name] {
+			deletePrefix(db, batch, []byte(storeKeyPrefix+info.Name+"/"))
+		}
+	}
+
+	for v := height + 1; v <= latest; v++ {
+		batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, v)))
+	}
+
+	latestBytes, _ := cdc.MarshalBinaryLengthPrefixed(height)
+	batch.Set([]byte(latestVersionKey), latestBytes)
+	batch.WriteSync()
+
+	return latest, nil
+}
+
+// loadCommitInfo loads the commit info at height, checking every store still
+// holds its tree at that height
+func loadCommitInfo(db dbm.DB, height int64) (commitInfo, error) {
+	cInfoBytes := db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, height)))
+	if cInfoBytes == nil {
+		return commitInfo{}, fmt.Errorf("no commit found at height %d", height)
+	}
+
+	cInfo, err := decodeCommitInfo(cInfoBytes)
+	if err != nil {
+		return commitInfo{}, err
+	}
+
+	// stores are committed in map order
+	sort.Slice(cInfo.StoreInfos, func(i, j int) bool {
+		return cInfo.StoreInfos[i].Name < cInfo.StoreInfos[j].Name
+	})
+
+	for _, info := range cInfo.StoreInfos {
+		if !db.Has(append([]byte(storeKeyPrefix+info.Name+"/"), rootKey(height)...)) {
+			return commitInfo{}, fmt.Errorf("store %s has no tree at height %d, it has been pruned", info.Name, height)
+		}
+	}
+
+	return cInfo, nil
+}
+
+// rollbackStore removes the roots, nodes and orphan entries of the versions
+// after height from the iavl store under prefix
+func rollbackStore(db dbm.DB, batch dbm.Batch, prefix []byte, height int64) error {
+	deleted := make(map[string]bool)
+	deleteNode := func(hash []byte) {
+		if !deleted[string(hash)] {
+			deleted[string(hash)] = true
+			batch.Delete(append(append([]byte{}, prefix...), nodeKey(hash)...))
+		}
+	}
+
+	// nodes of later versions, a node is never newer than its parent so the
+	// walk stops at nodes created up to height
+	var roots [][]byte
+	iterPrefix(db, append(append([]byte{}, prefix...), rootKeyPrefix), func(key, value []byte) {
+		if int64(binary.BigEndian.Uint64(key[len(prefix)+1:])) > height {
+			roots = append(roots, value)
+			batch.Delete(key)
+		}
+	})
+
+	for _, root := range roots {
+		stack := [][]byte{root}
+		for len(stack) > 0 {
+			hash := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if len(hash) == 0 || deleted[string(hash)] {
+				continue
+			}
+
+			value := db.Get(append(append([]byte{}, prefix...), nodeKey(hash)...))
+			if value == nil {
+				return fmt.Errorf("node %X not found", hash)
+			}
+
+			n, err := decodeNode(value)
+			if err != nil {
+				return fmt.Errorf("node %X: %v", hash, err)
+			}
+
+			if n.version <= height {
+				continue
+			}
+
+			deleteNode(hash)
+			if n.height > 0 {
+				stack = append(stack, n.leftHash, n.rightHash)
+			}
+		}
+	}
+
+	// nodes alive at height are alive again in the latest version, nodes of
+	// later versions which are no longer reachable are only listed as orphans
+	iterPrefix(db, append(append([]byte{}, prefix...), orphanKeyPrefix), func(key, value []byte) {
+		toVersion := int64(binary.BigEndian.Uint64(key[len(prefix)+1:]))
+		fromVersion := int64(binary.BigEndian.Uint64(key[len(prefix)+9:]))
+
+		if toVersion >= height {
+			batch.Delete(key)
+		}
+		if fromVersion > height {
+			deleteNode(value)
+		}
+	})
+
+	return nil
+}
+
+func iterPrefix(db dbm.DB, prefix []byte, fn func(key, value []byte)) {
+	iter := dbm.IteratePrefix(db, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		fn(iter.Key(), iter.Value())
+	}
+}
+
+func deletePrefix(db dbm.DB, batch dbm.Batch, prefix []byte) {
+	iterPrefix(db, prefix, func(key, _ []byte) {
+		batch.Delete(key)
+	})
+}
@@ -0,0 +1,72 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestRollback(t *testing.T) {
+	db, commits := setupMultiStore(t)
+
+	latest, err := Rollback(db, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), latest)
+
+	// the db matches one which never went past height 2
+	refDB := dbm.NewMemDB()
+	refCommits := commitVersions(newMultiStore(t, refDB), 2)
+	require.Equal(t, commits[:2], refCommits)
+
+	refCount := 0
+	iterPrefix(refDB, []byte(storeKeyPrefix), func(key, value []byte) {
+		require.Equal(t, value, db.Get(key), "key %q", key)
+		refCount++
+	})
+
+	count := 0
+	iterPrefix(db, []byte(storeKeyPrefix), func(_, _ []byte) {
+		count++
+	})
+	require.Equal(t, refCount, count)
+	require.Nil(t, db.Get([]byte("s/3")))
+
+	// heights after the rollback are committed again
+	rs := newMultiStore(t, db)
+	require.Equal(t, commits[1], rs.LastCommitID())
+	require.Equal(t, commits[2:], commitVersions(rs, 3))
+}
+
+func TestRollbackRefused(t *testing.T) {
+	db, _ := setupMultiStore(t)
+
+	_, err := Rollback(db, 5)
+	require.Error(t, err)
+
+	_, err = Rollback(db, 0)
+	require.Error(t, err)
+
+	// pruned heights
+	prunedDB := dbm.NewMemDB()
+	rs := rootmulti.NewStore(prunedDB)
+	rs.SetPruning(storeTypes.NewPruningOptions(2, 0))
+	rs.MountStoreWithDB(storeKeyA, sdk.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(storeKeyB, sdk.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(storeKeyC, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	commitVersions(rs, 5)
+
+	_, err = Rollback(prunedDB, 1)
+	require.Error(t, err)
+
+	_, err = AppHash(prunedDB, 1)
+	require.Error(t, err)
+
+	latest, err := Rollback(prunedDB, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), latest)
+}
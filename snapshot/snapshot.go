@@ -0,0 +1,578 @@
+// Package snapshot exports the application multistore at a height into
+// verifiable chunks and restores a fresh application db from them. It also
+// rolls the multistore of an existing db back to an earlier height.
+//
+// A snapshot holds the raw IAVL nodes reachable from each store root at the
+// snapshot height, so the restored trees hash exactly like the original ones.
+// Every node is checked against its hash on restore and the resulting commit
+// is checked against the app hash from the manifest.
+package snapshot
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// Format is the current snapshot format version
+	Format uint32 = 1
+
+	// DefaultChunkSize is the default uncompressed size of a chunk
+	DefaultChunkSize = 16 * 1024 * 1024
+
+	// ManifestFile is the name of the manifest in a snapshot dir
+	ManifestFile = "manifest.json"
+
+	latestVersionKey = "s/latest"
+	commitInfoKeyFmt = "s/%d"
+	storeKeyPrefix   = "s/k:"
+
+	// iavl node and root key prefixes
+	nodeKeyPrefix = 'n'
+	rootKeyPrefix = 'r'
+
+	// entries written per batch on restore
+	restoreBatchSize = 10000
+)
+
+var cdc = codec.New()
+
+// Chunk holds the hash of a single snapshot chunk
+type Chunk struct {
+	Hash cmn.HexBytes `json:"hash"`
+}
+
+// Manifest describes a snapshot
+type Manifest struct {
+	Format  uint32       `json:"format"`
+	Height  int64        `json:"height"`
+	AppHash cmn.HexBytes `json:"app_hash"`
+	Stores  []string     `json:"stores"`
+	Chunks  []Chunk      `json:"chunks"`
+}
+
+// ChunkFile returns the file name of chunk i in a snapshot dir
+func ChunkFile(i int) string {
+	return fmt.Sprintf("chunk-%05d.gz", i)
+}
+
+// LoadManifest reads the manifest from a snapshot dir
+func LoadManifest(dir string) (*Manifest, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return nil, err
+	}
+
+	if manifest.Format != Format {
+		return nil, fmt.Errorf("unsupported snapshot format %d", manifest.Format)
+	}
+
+	return &manifest, nil
+}
+
+// Create writes a snapshot of the multistore held in db at height into dir.
+// Height 0 selects the latest committed height.
+func Create(db dbm.DB, height int64, dir string, chunkSize int) (*Manifest, error) {
+	if height == 0 {
+		height = getLatestVersion(db)
+	}
+
+	cInfo, err := loadCommitInfo(db, height)
+	if err != nil {
+		return nil, err
+	}
+
+	appHash, err := commitHash(db, cInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	w := &chunkWriter{dir: dir, chunkSize: chunkSize}
+
+	// commit info goes first, restore needs it to check the stores
+	cInfoKey := []byte(fmt.Sprintf(commitInfoKeyFmt, height))
+	if err := w.add(cInfoKey, db.Get(cInfoKey)); err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		Format:  Format,
+		Height:  height,
+		AppHash: appHash,
+	}
+
+	for _, info := range cInfo.StoreInfos {
+		prefix := []byte(storeKeyPrefix + info.Name + "/")
+		key := append(append([]byte{}, prefix...), rootKey(height)...)
+		root := db.Get(key)
+		if err := w.add(key, root); err != nil {
+			return nil, err
+		}
+
+		if err := walkNodes(db, prefix, root, func(key, value []byte) error {
+			return w.add(key, value)
+		}); err != nil {
+			return nil, fmt.Errorf("store %s: %v", info.Name, err)
+		}
+
+		manifest.Stores = append(manifest.Stores, info.Name)
+	}
+
+	if err := w.flush(); err != nil {
+		return nil, err
+	}
+	manifest.Chunks = w.chunks
+
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, ManifestFile), bz, 0644); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// Restore writes the snapshot held in dir into an empty application db and
+// checks the restored commit against the app hash in the manifest.
+func Restore(dir string, db dbm.DB) (*Manifest, error) {
+	if db.Has([]byte(latestVersionKey)) {
+		return nil, errors.New("application db is not empty")
+	}
+
+	manifest, err := LoadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &restorer{
+		db:     db,
+		height: manifest.Height,
+		batch:  db.NewBatch(),
+	}
+
+	for i, chunk := range manifest.Chunks {
+		bz, err := ioutil.ReadFile(filepath.Join(dir, ChunkFile(i)))
+		if err != nil {
+			return nil, err
+		}
+
+		if hash := sha256.Sum256(bz); !bytes.Equal(hash[:], chunk.Hash) {
+			return nil, fmt.Errorf("chunk %d hash mismatch", i)
+		}
+
+		if err := r.restoreChunk(bz); err != nil {
+			return nil, fmt.Errorf("chunk %d: %v", i, err)
+		}
+	}
+
+	if r.cInfo == nil {
+		return nil, errors.New("snapshot holds no commit info")
+	}
+
+	// check every store is complete before marking the height as committed
+	for _, info := range r.cInfo.StoreInfos {
+		root, ok := r.roots[info.Name]
+		if !ok {
+			return nil, fmt.Errorf("store %s has no root in snapshot", info.Name)
+		}
+
+		prefix := []byte(storeKeyPrefix + info.Name + "/")
+		if err := walkNodes(db, prefix, root, func(_, _ []byte) error { return nil }); err != nil {
+			return nil, fmt.Errorf("store %s: %v", info.Name, err)
+		}
+	}
+
+	latestBytes, _ := cdc.MarshalBinaryLengthPrefixed(manifest.Height)
+	db.SetSync([]byte(latestVersionKey), latestBytes)
+
+	appHash, err := commitHash(db, *r.cInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(appHash, manifest.AppHash) {
+		return nil, fmt.Errorf("restored app hash %X does not match snapshot app hash %X", appHash, manifest.AppHash)
+	}
+
+	return manifest, nil
+}
+
+//
+// Commit info
+//
+
+// commitInfo mirrors the commit info persisted by the root multistore
+type commitInfo struct {
+	Version    int64
+	StoreInfos []storeInfo
+}
+
+type storeInfo struct {
+	Name string
+	Core struct {
+		CommitID sdk.CommitID
+	}
+}
+
+func decodeCommitInfo(bz []byte) (cInfo commitInfo, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(bz, &cInfo)
+	return cInfo, err
+}
+
+func getLatestVersion(db dbm.DB) (latest int64) {
+	if bz := db.Get([]byte(latestVersionKey)); bz != nil {
+		cdc.MustUnmarshalBinaryLengthPrefixed(bz, &latest)
+	}
+	return latest
+}
+
+// commitHash loads the stores of cInfo at its version and returns the
+// resulting multistore commit hash
+func commitHash(db dbm.DB, cInfo commitInfo) ([]byte, error) {
+	rs := rootmulti.NewStore(db)
+	for _, info := range cInfo.StoreInfos {
+		rs.MountStoreWithDB(sdk.NewKVStoreKey(info.Name), sdk.StoreTypeIAVL, nil)
+	}
+
+	if err := rs.LoadVersion(cInfo.Version); err != nil {
+		return nil, err
+	}
+
+	return rs.LastCommitID().Hash, nil
+}
+
+//
+// IAVL nodes
+//
+
+func rootKey(version int64) []byte {
+	key := make([]byte, 9)
+	key[0] = rootKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(version))
+	return key
+}
+
+func nodeKey(hash []byte) []byte {
+	return append([]byte{nodeKeyPrefix}, hash...)
+}
+
+// node holds the fields of a persisted iavl node
+type node struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+func decodeNode(bz []byte) (*node, error) {
+	var n node
+	var err error
+	var c int
+
+	if n.height, c, err = amino.DecodeInt8(bz); err != nil {
+		return nil, err
+	}
+	bz = bz[c:]
+
+	if n.size, c, err = amino.DecodeVarint(bz); err != nil {
+		return nil, err
+	}
+	bz = bz[c:]
+
+	if n.version, c, err = amino.DecodeVarint(bz); err != nil {
+		return nil, err
+	}
+	bz = bz[c:]
+
+	if n.key, c, err = amino.DecodeByteSlice(bz); err != nil {
+		return nil, err
+	}
+	bz = bz[c:]
+
+	if n.height == 0 {
+		if n.value, c, err = amino.DecodeByteSlice(bz); err != nil {
+			return nil, err
+		}
+		bz = bz[c:]
+	} else {
+		if n.leftHash, c, err = amino.DecodeByteSlice(bz); err != nil {
+			return nil, err
+		}
+		bz = bz[c:]
+
+		if n.rightHash, c, err = amino.DecodeByteSlice(bz); err != nil {
+			return nil, err
+		}
+		bz = bz[c:]
+	}
+
+	if len(bz) != 0 {
+		return nil, errors.New("trailing bytes after node")
+	}
+
+	return &n, nil
+}
+
+// hash computes the node hash the same way iavl does
+func (n *node) hash() []byte {
+	buf := new(bytes.Buffer)
+	_ = amino.EncodeInt8(buf, n.height)
+	_ = amino.EncodeVarint(buf, n.size)
+	_ = amino.EncodeVarint(buf, n.version)
+	if n.height == 0 {
+		_ = amino.EncodeByteSlice(buf, n.key)
+		_ = amino.EncodeByteSlice(buf, tmhash.Sum(n.value))
+	} else {
+		_ = amino.EncodeByteSlice(buf, n.leftHash)
+		_ = amino.EncodeByteSlice(buf, n.rightHash)
+	}
+	return tmhash.Sum(buf.Bytes())
+}
+
+// walkNodes calls fn with the db key and value of every node reachable from
+// root in the store under prefix, parents before children.
+func walkNodes(db dbm.DB, prefix []byte, root []byte, fn func(key, value []byte) error) error {
+	// empty tree
+	if len(root) == 0 {
+		return nil
+	}
+
+	stack := [][]byte{root}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		key := append(append([]byte{}, prefix...), nodeKey(hash)...)
+		value := db.Get(key)
+		if value == nil {
+			return fmt.Errorf("node %X not found", hash)
+		}
+
+		n, err := decodeNode(value)
+		if err != nil {
+			return fmt.Errorf("node %X: %v", hash, err)
+		}
+
+		if err := fn(key, value); err != nil {
+			return err
+		}
+
+		if n.height > 0 {
+			stack = append(stack, n.rightHash, n.leftHash)
+		}
+	}
+
+	return nil
+}
+
+//
+// Chunks
+//
+
+// chunkWriter splits entries into gzipped chunk files
+type chunkWriter struct {
+	dir       string
+	chunkSize int
+	buf       bytes.Buffer
+	chunks    []Chunk
+}
+
+func (w *chunkWriter) add(key, value []byte) error {
+	if err := amino.EncodeByteSlice(&w.buf, key); err != nil {
+		return err
+	}
+	if err := amino.EncodeByteSlice(&w.buf, value); err != nil {
+		return err
+	}
+
+	if w.buf.Len() >= w.chunkSize {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *chunkWriter) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(w.buf.Bytes()); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(w.dir, ChunkFile(len(w.chunks))), compressed.Bytes(), 0644); err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(compressed.Bytes())
+	w.chunks = append(w.chunks, Chunk{Hash: hash[:]})
+	w.buf.Reset()
+
+	return nil
+}
+
+// restorer checks snapshot entries and writes them into the db
+type restorer struct {
+	db      dbm.DB
+	height  int64
+	batch   dbm.Batch
+	pending int
+
+	cInfo *commitInfo
+	// store name -> commit hash at height
+	hashes map[string][]byte
+	// store name -> restored root hash
+	roots map[string][]byte
+}
+
+func (r *restorer) restoreChunk(compressed []byte) error {
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return err
+	}
+
+	bz, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return err
+	}
+
+	for len(bz) > 0 {
+		key, c, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[c:]
+
+		value, c, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[c:]
+
+		if err := r.restoreEntry(key, value); err != nil {
+			return err
+		}
+	}
+
+	r.batch.WriteSync()
+	r.batch = r.db.NewBatch()
+	r.pending = 0
+
+	return nil
+}
+
+func (r *restorer) restoreEntry(key, value []byte) error {
+	if r.cInfo == nil {
+		if string(key) != fmt.Sprintf(commitInfoKeyFmt, r.height) {
+			return errors.New("snapshot must start with the commit info")
+		}
+
+		cInfo, err := decodeCommitInfo(value)
+		if err != nil {
+			return err
+		}
+
+		r.cInfo = &cInfo
+		r.hashes = make(map[string][]byte, len(cInfo.StoreInfos))
+		r.roots = make(map[string][]byte, len(cInfo.StoreInfos))
+		for _, info := range cInfo.StoreInfos {
+			r.hashes[info.Name] = info.Core.CommitID.Hash
+		}
+	} else {
+		name, subKey, err := splitStoreKey(key)
+		if err != nil {
+			return err
+		}
+
+		expectedHash, ok := r.hashes[name]
+		if !ok {
+			return fmt.Errorf("unknown store %s", name)
+		}
+
+		switch {
+		case bytes.Equal(subKey, rootKey(r.height)):
+			if !bytes.Equal(value, expectedHash) {
+				return fmt.Errorf("store %s root does not match commit info", name)
+			}
+			r.roots[name] = value
+		case len(subKey) == 1+tmhash.Size && subKey[0] == nodeKeyPrefix:
+			n, err := decodeNode(value)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(n.hash(), subKey[1:]) {
+				return fmt.Errorf("store %s node %X hash mismatch", name, subKey[1:])
+			}
+		default:
+			return fmt.Errorf("unexpected key %X in store %s", subKey, name)
+		}
+	}
+
+	// empty roots decode as nil
+	if value == nil {
+		value = []byte{}
+	}
+
+	r.batch.Set(key, value)
+	r.pending++
+	if r.pending >= restoreBatchSize {
+		r.batch.WriteSync()
+		r.batch = r.db.NewBatch()
+		r.pending = 0
+	}
+
+	return nil
+}
+
+// splitStoreKey splits a db key into the store name and the iavl key
+func splitStoreKey(key []byte) (string, []byte, error) {
+	if !bytes.HasPrefix(key, []byte(storeKeyPrefix)) {
+		return "", nil, fmt.Errorf("unexpected key %s", strconv.Quote(string(key)))
+	}
+
+	rest := string(key[len(storeKeyPrefix):])
+	i := strings.Index(rest, "/")
+	if i <= 0 {
+		return "", nil, fmt.Errorf("unexpected key %s", strconv.Quote(string(key)))
+	}
+
+	return rest[:i], []byte(rest[i+1:]), nil
+}
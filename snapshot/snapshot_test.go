@@ -0,0 +1,160 @@
+package snapshot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	storeKeyA = sdk.NewKVStoreKey("a")
+	storeKeyB = sdk.NewKVStoreKey("b")
+	storeKeyC = sdk.NewKVStoreKey("c")
+)
+
+func newMultiStore(t *testing.T, db dbm.DB) *rootmulti.Store {
+	rs := rootmulti.NewStore(db)
+	rs.SetPruning(store.PruneNothing)
+	rs.MountStoreWithDB(storeKeyA, sdk.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(storeKeyB, sdk.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(storeKeyC, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	return rs
+}
+
+// commits n versions, store c stays empty
+func commitVersions(rs *rootmulti.Store, n int) (commits []sdk.CommitID) {
+	for j := 0; j < n; j++ {
+		v := rs.LastCommitID().Version
+		for i := 0; i < 100; i++ {
+			rs.GetKVStore(storeKeyA).Set([]byte(fmt.Sprintf("key-%d-%d", v, i)), []byte(fmt.Sprintf("value-%d", i)))
+			rs.GetKVStore(storeKeyB).Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d-%d", v, i)))
+		}
+		rs.GetKVStore(storeKeyA).Delete([]byte(fmt.Sprintf("key-%d-%d", v, v)))
+		commits = append(commits, rs.Commit())
+	}
+
+	return commits
+}
+
+func setupMultiStore(t *testing.T) (dbm.DB, []sdk.CommitID) {
+	db := dbm.NewMemDB()
+	return db, commitVersions(newMultiStore(t, db), 5)
+}
+
+func TestCreateRestore(t *testing.T) {
+	db, commits := setupMultiStore(t)
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// small chunks to split the snapshot
+	manifest, err := Create(db, 3, dir, 1024)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), manifest.Height)
+	require.Equal(t, commits[2].Hash, []byte(manifest.AppHash))
+	require.Equal(t, []string{"a", "b", "c"}, manifest.Stores)
+	require.True(t, len(manifest.Chunks) > 1)
+
+	restoredDB := dbm.NewMemDB()
+	restored, err := Restore(dir, restoredDB)
+	require.NoError(t, err)
+	require.Equal(t, manifest, restored)
+
+	// restored stores hold the state at the snapshot height and keep committing
+	rs := newMultiStore(t, restoredDB)
+	require.Equal(t, commits[2], rs.LastCommitID())
+	require.Equal(t, []byte("value-2-7"), rs.GetKVStore(storeKeyB).Get([]byte("key-7")))
+	require.Nil(t, rs.GetKVStore(storeKeyA).Get([]byte("key-3-1")))
+	require.Nil(t, rs.GetKVStore(storeKeyA).Get([]byte("key-1-1")))
+
+	rs.GetKVStore(storeKeyC).Set([]byte("key"), []byte("value"))
+	require.Equal(t, int64(4), rs.Commit().Version)
+
+	// restore needs an empty db
+	_, err = Restore(dir, restoredDB)
+	require.Error(t, err)
+}
+
+func TestCreateLatest(t *testing.T) {
+	db, commits := setupMultiStore(t)
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	manifest, err := Create(db, 0, dir, DefaultChunkSize)
+	require.NoError(t, err)
+	require.Equal(t, commits[4].Version, manifest.Height)
+	require.Equal(t, commits[4].Hash, []byte(manifest.AppHash))
+	require.Len(t, manifest.Chunks, 1)
+
+	_, err = Create(db, 6, dir, DefaultChunkSize)
+	require.Error(t, err)
+}
+
+func TestRestoreTampered(t *testing.T) {
+	db, _ := setupMultiStore(t)
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = Create(db, 3, dir, 1024)
+	require.NoError(t, err)
+
+	// corrupted chunk
+	chunkPath := filepath.Join(dir, ChunkFile(1))
+	bz, err := ioutil.ReadFile(chunkPath)
+	require.NoError(t, err)
+	bz[len(bz)/2] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(chunkPath, bz, 0644))
+
+	_, err = Restore(dir, dbm.NewMemDB())
+	require.Error(t, err)
+
+	// missing chunk
+	require.NoError(t, os.Remove(chunkPath))
+	_, err = Restore(dir, dbm.NewMemDB())
+	require.Error(t, err)
+}
+
+func TestRestoreNodeHashMismatch(t *testing.T) {
+	db, commits := setupMultiStore(t)
+
+	r := &restorer{
+		db:     dbm.NewMemDB(),
+		height: commits[2].Version,
+		batch:  dbm.NewMemDB().NewBatch(),
+	}
+
+	cInfoKey := []byte(fmt.Sprintf(commitInfoKeyFmt, commits[2].Version))
+	require.NoError(t, r.restoreEntry(cInfoKey, db.Get(cInfoKey)))
+
+	// a node stored under the hash of another node is rejected
+	var nodes [][]byte
+	prefix := []byte(storeKeyPrefix + "a/")
+	require.NoError(t, walkNodes(db, prefix, r.hashes["a"], func(key, _ []byte) error {
+		nodes = append(nodes, key)
+		return nil
+	}))
+	require.True(t, len(nodes) > 1)
+	require.NoError(t, r.restoreEntry(nodes[0], db.Get(nodes[0])))
+	require.Error(t, r.restoreEntry(nodes[0], db.Get(nodes[1])))
+
+	// roots must match the commit info
+	require.Error(t, r.restoreEntry(append(append([]byte{}, prefix...), rootKey(commits[2].Version)...), r.hashes["b"]))
+
+	// unknown stores and keys are rejected
+	require.Error(t, r.restoreEntry([]byte("s/k:d/"+string(rootKey(commits[2].Version))), nil))
+	require.Error(t, r.restoreEntry([]byte("s/latest"), nil))
+}
@@ -0,0 +1,181 @@
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcClient "github.com/tendermint/tendermint/rpc/lib/client"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// tendermint state db key of the validator set info of a height
+const validatorsKeyFmt = "validatorsKey:%v"
+
+// TrustedState holds what a node needs, next to the application state, to
+// start at a snapshot height H. It is fetched from a trusted node.
+type TrustedState struct {
+	Block           *tmTypes.Block          // block H
+	Commit          *tmTypes.Commit         // commit of block H
+	NextHeader      tmTypes.Header          // header H+1 holding the app hash of H
+	LastValidators  *tmTypes.ValidatorSet   // validators of H
+	Validators      *tmTypes.ValidatorSet   // validators of H+1
+	NextValidators  *tmTypes.ValidatorSet   // validators of H+2
+	ConsensusParams tmTypes.ConsensusParams // consensus params of H+1
+}
+
+// LoadTrustedState fetches the trusted state at height from a Tendermint RPC
+// node, the node must have committed the block after height.
+func LoadTrustedState(node string, height int64) (*TrustedState, error) {
+	client := httpClient.NewHTTP(node, "/websocket")
+
+	block, err := client.Block(&height)
+	if err != nil {
+		return nil, fmt.Errorf("fetching block %d: %v", height, err)
+	}
+
+	commit, err := client.Commit(&height)
+	if err != nil {
+		return nil, fmt.Errorf("fetching commit %d: %v", height, err)
+	}
+
+	nextHeight := height + 1
+	nextCommit, err := client.Commit(&nextHeight)
+	if err != nil {
+		return nil, fmt.Errorf("fetching header %d: %v", nextHeight, err)
+	}
+
+	ts := &TrustedState{
+		Block:      block.Block,
+		Commit:     commit.Commit,
+		NextHeader: *nextCommit.Header,
+	}
+
+	valSets := []**tmTypes.ValidatorSet{&ts.LastValidators, &ts.Validators, &ts.NextValidators}
+	for i, valSet := range valSets {
+		h := height + int64(i)
+		vals, err := client.Validators(&h)
+		if err != nil {
+			return nil, fmt.Errorf("fetching validators %d: %v", h, err)
+		}
+		*valSet = &tmTypes.ValidatorSet{Validators: vals.Validators}
+	}
+
+	// consensus params have no client method
+	var params ctypes.ResultConsensusParams
+	if _, err := rpcClient.NewJSONRPCClient(node).Call("consensus_params", map[string]interface{}{"height": nextHeight}, &params); err != nil {
+		return nil, fmt.Errorf("fetching consensus params %d: %v", nextHeight, err)
+	}
+	ts.ConsensusParams = params.ConsensusParams
+
+	return ts, nil
+}
+
+// Height returns the snapshot height of the trusted state
+func (ts *TrustedState) Height() int64 {
+	return ts.Block.Height
+}
+
+// AppHash returns the app hash committed at the snapshot height
+func (ts *TrustedState) AppHash() []byte {
+	return ts.NextHeader.AppHash
+}
+
+// ValidateBasic checks the blocks, commit and validator sets of the trusted
+// state are consistent with each other
+func (ts *TrustedState) ValidateBasic() error {
+	if ts.Block == nil || ts.Commit == nil || ts.LastValidators == nil || ts.Validators == nil || ts.NextValidators == nil {
+		return fmt.Errorf("incomplete trusted state")
+	}
+
+	height := ts.Block.Height
+	if ts.NextHeader.Height != height+1 {
+		return fmt.Errorf("header %d does not follow block %d", ts.NextHeader.Height, height)
+	}
+
+	parts := ts.Block.MakePartSet(tmTypes.BlockPartSizeBytes)
+	blockID := tmTypes.BlockID{Hash: ts.Block.Hash(), PartsHeader: parts.Header()}
+	if !blockID.Equals(ts.NextHeader.LastBlockID) {
+		return fmt.Errorf("block %d id %v does not match header %d last block id %v",
+			height, blockID, height+1, ts.NextHeader.LastBlockID)
+	}
+
+	if !bytes.Equal(ts.LastValidators.Hash(), ts.Block.ValidatorsHash) {
+		return fmt.Errorf("validators of block %d do not match its header", height)
+	}
+	if !bytes.Equal(ts.Validators.Hash(), ts.NextHeader.ValidatorsHash) {
+		return fmt.Errorf("validators of block %d do not match its header", height+1)
+	}
+	if !bytes.Equal(ts.NextValidators.Hash(), ts.NextHeader.NextValidatorsHash) {
+		return fmt.Errorf("validators of block %d do not match header %d", height+2, height+1)
+	}
+	if !bytes.Equal(ts.ConsensusParams.Hash(), ts.NextHeader.ConsensusHash) {
+		return fmt.Errorf("consensus params do not match header %d", height+1)
+	}
+
+	return ts.LastValidators.VerifyCommit(ts.Block.ChainID, blockID, height, ts.Commit)
+}
+
+// RestoreTendermint writes the Tendermint state and block store of a node at
+// the height of the trusted state, so that it starts at that height on top of
+// the application state restored from a snapshot. Both dbs must be empty.
+func RestoreTendermint(stateDB, blockStoreDB dbm.DB, ts *TrustedState) (sm.State, error) {
+	if err := ts.ValidateBasic(); err != nil {
+		return sm.State{}, err
+	}
+
+	if store.NewBlockStore(blockStoreDB).Height() != 0 {
+		return sm.State{}, fmt.Errorf("block store is not empty")
+	}
+	if !sm.LoadState(stateDB).IsEmpty() {
+		return sm.State{}, fmt.Errorf("state is not empty")
+	}
+
+	height := ts.Block.Height
+
+	// the proposer of a set is only known from the header it proposed
+	validators := ts.Validators.Copy()
+	_, validators.Proposer = validators.GetByAddress(ts.NextHeader.ProposerAddress)
+	if validators.Proposer == nil {
+		return sm.State{}, fmt.Errorf("proposer of block %d is not a validator", height+1)
+	}
+
+	state := sm.State{
+		Version: sm.Version{
+			Consensus: ts.NextHeader.Version,
+			Software:  version.TMCoreSemVer,
+		},
+		ChainID:                          ts.Block.ChainID,
+		LastBlockHeight:                  height,
+		LastBlockTotalTx:                 ts.Block.TotalTxs,
+		LastBlockID:                      ts.NextHeader.LastBlockID,
+		LastBlockTime:                    ts.Block.Time,
+		LastValidators:                   ts.LastValidators.Copy(),
+		Validators:                       validators,
+		NextValidators:                   ts.NextValidators.Copy(),
+		LastHeightValidatorsChanged:      height + 2,
+		ConsensusParams:                  ts.ConsensusParams,
+		LastHeightConsensusParamsChanged: height + 1,
+		LastResultsHash:                  ts.NextHeader.LastResultsHash,
+		AppHash:                          ts.NextHeader.AppHash,
+	}
+
+	// saving the state only stores the validators of H+2, the ones of H and
+	// H+1 are loaded to execute and verify the next block
+	for h, valSet := range map[int64]*tmTypes.ValidatorSet{height: state.LastValidators, height + 1: state.Validators} {
+		valInfo := &sm.ValidatorsInfo{ValidatorSet: valSet, LastHeightChanged: h}
+		stateDB.Set([]byte(fmt.Sprintf(validatorsKeyFmt, h)), valInfo.Bytes())
+	}
+	sm.SaveState(stateDB, state)
+
+	// block store starts at H
+	store.BlockStoreStateJSON{Height: height - 1}.Save(blockStoreDB)
+	store.NewBlockStore(blockStoreDB).SaveBlock(ts.Block, ts.Block.MakePartSet(tmTypes.BlockPartSizeBytes), ts.Commit)
+
+	return state, nil
+}
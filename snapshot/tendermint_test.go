@@ -0,0 +1,148 @@
+package snapshot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmNode "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var mainStoreKey = sdk.NewKVStoreKey("main")
+
+// newNodeApp returns an app writing the height of each block into its store
+func newNodeApp(t *testing.T, db dbm.DB) *baseapp.BaseApp {
+	app := baseapp.NewBaseApp("snapshot", log.NewNopLogger(), db, nil, baseapp.SetPruning(store.PruneNothing))
+	app.MountStores(mainStoreKey)
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		ctx.KVStore(mainStoreKey).Set([]byte(fmt.Sprintf("height-%d", req.Header.Height)), req.Hash)
+		return abci.ResponseBeginBlock{}
+	})
+	require.NoError(t, app.LoadLatestVersion(mainStoreKey))
+	return app
+}
+
+// newNodeConfig returns the config of a single validator test chain
+func newNodeConfig(t *testing.T, name string) *cfg.Config {
+	config := cfg.ResetTestRoot(name)
+	config.DBBackend = string(dbm.GoLevelDBBackend)
+	config.P2P.ListenAddress = "tcp://" + freeAddr(t)
+	config.RPC.ListenAddress = "tcp://" + freeAddr(t)
+	return config
+}
+
+func startNode(t *testing.T, config *cfg.Config, app abci.Application) *tmNode.Node {
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+
+	n, err := tmNode.NewNode(
+		config,
+		privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(app),
+		tmNode.DefaultGenesisDocProviderFunc(config),
+		tmNode.DefaultDBProvider,
+		tmNode.DefaultMetricsProvider(config.Instrumentation),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	return n
+}
+
+func waitForHeight(t *testing.T, n *tmNode.Node, height int64) {
+	deadline := time.Now().Add(30 * time.Second)
+	for n.BlockStore().Height() < height {
+		require.True(t, time.Now().Before(deadline), "node stuck at height %d", n.BlockStore().Height())
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func freeAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func TestStartFromSnapshot(t *testing.T) {
+	const height = int64(3)
+
+	// node of a running chain
+	configA := newNodeConfig(t, "snapshot_source")
+	defer os.RemoveAll(configA.RootDir)
+
+	dbA := dbm.NewMemDB()
+	nodeA := startNode(t, configA, newNodeApp(t, dbA))
+	waitForHeight(t, nodeA, height+2)
+
+	ts, err := LoadTrustedState(configA.RPC.ListenAddress, height)
+	require.NoError(t, err)
+	require.Equal(t, height, ts.Height())
+
+	require.NoError(t, nodeA.Stop())
+	nodeA.Wait()
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	manifest, err := Create(dbA, height, dir, DefaultChunkSize)
+	require.NoError(t, err)
+	require.Equal(t, []byte(manifest.AppHash), ts.AppHash())
+
+	// new node bootstrapped from the snapshot, using the key of the stopped
+	// validator with a fresh sign state
+	configB := newNodeConfig(t, "snapshot_restored")
+	defer os.RemoveAll(configB.RootDir)
+
+	dbB := dbm.NewMemDB()
+	_, err = Restore(dir, dbB)
+	require.NoError(t, err)
+
+	stateDB := dbm.NewDB("state", dbm.GoLevelDBBackend, configB.DBDir())
+	blockStoreDB := dbm.NewDB("blockstore", dbm.GoLevelDBBackend, configB.DBDir())
+
+	// tampered validators are refused
+	tampered := *ts
+	tampered.Validators = ts.Validators.Copy()
+	tampered.Validators.Validators[0].VotingPower++
+	_, err = RestoreTendermint(stateDB, blockStoreDB, &tampered)
+	require.Error(t, err)
+
+	state, err := RestoreTendermint(stateDB, blockStoreDB, ts)
+	require.NoError(t, err)
+	require.Equal(t, height, state.LastBlockHeight)
+
+	_, err = RestoreTendermint(stateDB, blockStoreDB, ts)
+	require.Error(t, err, "stores are not empty")
+
+	stateDB.Close()
+	blockStoreDB.Close()
+
+	nodeB := startNode(t, configB, newNodeApp(t, dbB))
+	defer func() {
+		require.NoError(t, nodeB.Stop())
+		nodeB.Wait()
+	}()
+
+	waitForHeight(t, nodeB, height+3)
+
+	// blocks before the snapshot are not held
+	require.Nil(t, nodeB.BlockStore().LoadBlock(height-1))
+	require.NotNil(t, nodeB.BlockStore().LoadBlock(height))
+}
@@ -31,6 +31,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetValidatorInfo(cdc),
 			GetCurrentValSet(cdc),
+			GetStandbyValidators(cdc),
 		)...,
 	)
 
@@ -104,3 +105,25 @@ func GetCurrentValSet(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetStandbyValidators returns staked validators outside active validator set
+func GetStandbyValidators(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "standby-validators",
+		Short: "show staked validators waiting for a slot in active validator set",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get standby validators
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStandbyValidators), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -36,6 +36,14 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/validator-set",
 		validatorSetHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/standby-validators",
+		standbyValidatorsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/params",
+		paramsHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/proposer/{times}",
 		proposerHandlerFn(cliCtx),
@@ -245,6 +253,48 @@ func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// get staked validators outside active validator set
+func standbyValidatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStandbyValidators), nil)
+		if err != nil {
+			RestLogger.Error("Error while fetching standby validators ", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// get staking params
+func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			RestLogger.Error("Error while fetching staking params ", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // get proposer for current validator set
 func proposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -8,32 +8,64 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
-	// get current val set
-	var vals []*hmTypes.Validator
-	if len(data.CurrentValSet.Validators) == 0 {
-		vals = data.Validators
-	} else {
-		vals = data.CurrentValSet.Validators
-	}
+	data.DefaultMissingParams()
+	keeper.SetParams(ctx, data.Params)
 
-	if len(vals) != 0 {
-		resultValSet := hmTypes.NewValidatorSet(vals)
-
-		// add validators in store
-		for _, validator := range resultValSet.Validators {
-			// Add individual validator to state
+	if len(data.CurrentValSet.Validators) != 0 && keeper.moduleCommunicator.IsUpgradeDone(ctx, hmTypes.UpgradeV03) {
+		// restore exported validators and validator set as they are, once upgraded to v0.3
+		for _, validator := range data.Validators {
 			if err := keeper.AddValidator(ctx, *validator); err != nil {
 				keeper.Logger(ctx).Error("Error InitGenesis", "error", err)
 			}
+		}
+
+		if err := keeper.UpdateValidatorSetInStore(ctx, data.CurrentValSet); err != nil {
+			panic(err)
+		}
+	} else {
+		// get current val set
+		var vals []*hmTypes.Validator
+		if len(data.CurrentValSet.Validators) == 0 {
+			vals = data.Validators
+		} else {
+			vals = data.CurrentValSet.Validators
+		}
 
-			// update validator set in store
-			if err := keeper.UpdateValidatorSetInStore(ctx, *resultValSet); err != nil {
-				panic(err)
+		// on a new chain, validators beyond the top MaxValidators by power are stored
+		// out of the set as they are not sent to tendermint on init chain
+		var standbyVals []*hmTypes.Validator
+		if maxValidators := keeper.GetMaxValidators(ctx); len(data.CurrentValSet.Validators) == 0 && uint64(len(vals)) > maxValidators {
+			ranked := make([]*hmTypes.Validator, len(vals))
+			copy(ranked, vals)
+			hmTypes.SortValidatorByPower(ranked)
+			vals, standbyVals = ranked[:maxValidators], ranked[maxValidators:]
+		}
+
+		if len(vals) != 0 {
+			resultValSet := hmTypes.NewValidatorSet(vals)
+
+			// add validators in store
+			for _, validator := range resultValSet.Validators {
+				// Add individual validator to state
+				if err := keeper.AddValidator(ctx, *validator); err != nil {
+					keeper.Logger(ctx).Error("Error InitGenesis", "error", err)
+				}
+
+				// update validator set in store
+				if err := keeper.UpdateValidatorSetInStore(ctx, *resultValSet); err != nil {
+					panic(err)
+				}
+
+				// increament accum if init validator set
+				if len(data.CurrentValSet.Validators) == 0 {
+					keeper.IncrementAccum(ctx, 1)
+				}
 			}
+		}
 
-			// increament accum if init validator set
-			if len(data.CurrentValSet.Validators) == 0 {
-				keeper.IncrementAccum(ctx, 1)
+		for _, validator := range standbyVals {
+			if err := keeper.AddValidator(ctx, *validator); err != nil {
+				keeper.Logger(ctx).Error("Error InitGenesis", "error", err)
 			}
 		}
 	}
@@ -47,6 +79,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	// return new genesis state
 	return types.NewGenesisState(
+		keeper.GetParams(ctx),
 		keeper.GetAllValidators(ctx),
 		keeper.GetValidatorSet(ctx),
 		keeper.GetStakingSequences(ctx),
@@ -1,6 +1,7 @@
 package staking_test
 
 import (
+	"encoding/json"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -8,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	"github.com/maticnetwork/heimdall/staking"
 	"github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -65,10 +67,117 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	// validator set
 	validatorSet := hmTypes.NewValidatorSet(validators)
 
-	genesisState := types.NewGenesisState(validators, *validatorSet, stakingSequence)
+	genesisState := types.NewGenesisState(types.DefaultParams(), validators, *validatorSet, stakingSequence)
 	staking.InitGenesis(ctx, app.StakingKeeper, genesisState)
 
 	actualParams := staking.ExportGenesis(ctx, app.StakingKeeper)
 	require.NotNil(t, actualParams)
 	require.LessOrEqual(t, 5, len(actualParams.Validators))
 }
+
+// TestInitGenesisUpgrade test restoring an exported validator set around v0.3 upgrade
+func (suite *GenesisTestSuite) TestInitGenesisUpgrade() {
+	t := suite.T()
+	r1 := rand.New(rand.NewSource(time.Now().UnixNano()))
+	accounts := simulation.RandomAccounts(r1, 3)
+
+	validators := make([]*hmTypes.Validator, len(accounts))
+	for i := range validators {
+		validators[i] = hmTypes.NewValidator(
+			hmTypes.NewValidatorID(uint64(i+1)),
+			0,
+			0,
+			uint64(i),
+			int64(10*(i+1)), // power
+			hmTypes.NewPubKey(accounts[i].PubKey.Bytes()),
+			accounts[i].Address,
+		)
+	}
+
+	// last validator is not part of exported validator set, which has rotated proposers
+	validatorSet := hmTypes.NewValidatorSet([]*hmTypes.Validator{validators[0].Copy(), validators[1].Copy()})
+	validatorSet.IncrementProposerPriority(3)
+	genesisState := types.NewGenesisState(types.DefaultParams(), validators, *validatorSet, nil)
+
+	// before the upgrade only validators of the validator set are restored
+	app, ctx, _ := createTestApp(false)
+	ctx.KVStore(app.GetKey(govTypes.StoreKey)).Delete(govTypes.DoneUpgradeKey(hmTypes.UpgradeV03))
+	staking.InitGenesis(ctx, app.StakingKeeper, genesisState)
+	require.Len(t, app.StakingKeeper.GetAllValidators(ctx), 2)
+
+	// after the upgrade validators and validator set are restored as exported
+	app, ctx, _ = createTestApp(false)
+	staking.InitGenesis(ctx, app.StakingKeeper, genesisState)
+	require.Len(t, app.StakingKeeper.GetAllValidators(ctx), 3)
+
+	actualValidatorSet := app.StakingKeeper.GetValidatorSet(ctx)
+	require.Equal(t, validatorSet.GetProposer().ID, actualValidatorSet.GetProposer().ID)
+	for i, validator := range validatorSet.Validators {
+		require.Equal(t, validator.ProposerPriority, actualValidatorSet.Validators[i].ProposerPriority)
+	}
+}
+
+// TestValidateGenesisMissingParams test genesis exported before staking params is accepted with defaults
+func (suite *GenesisTestSuite) TestValidateGenesisMissingParams() {
+	t := suite.T()
+	r1 := rand.New(rand.NewSource(time.Now().UnixNano()))
+	accounts := simulation.RandomAccounts(r1, int(types.DefaultMaxValidators)+1)
+
+	validators := make([]*hmTypes.Validator, len(accounts))
+	for i := range validators {
+		validators[i] = hmTypes.NewValidator(
+			hmTypes.NewValidatorID(uint64(i+1)),
+			0,
+			0,
+			uint64(i),
+			int64(simulation.RandIntBetween(r1, 10, 100)), // power
+			hmTypes.NewPubKey(accounts[i].PubKey.Bytes()),
+			accounts[i].Address,
+		)
+	}
+
+	genesisState := types.NewGenesisState(types.Params{}, validators[:3], *hmTypes.NewValidatorSet(validators[:3]), nil)
+	require.NoError(t, types.ValidateGenesis(genesisState))
+
+	appState := map[string]json.RawMessage{types.ModuleName: types.ModuleCdc.MustMarshalJSON(genesisState)}
+	require.Equal(t, types.DefaultParams(), types.GetGenesisStateFromAppState(appState).Params)
+
+	// validator limit is raised to the exported validator set
+	genesisState = types.NewGenesisState(types.Params{}, validators, *hmTypes.NewValidatorSet(validators), nil)
+	genesisState.DefaultMissingParams()
+	require.Equal(t, uint64(len(validators)), genesisState.Params.MaxValidators)
+
+	// invalid params are not defaulted
+	params := types.DefaultParams()
+	params.MaxValidators = 0
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState(params, nil, hmTypes.ValidatorSet{}, nil)))
+}
+
+// TestInitGenesisMaxValidators test validators beyond MaxValidators are on standby on a new chain
+func (suite *GenesisTestSuite) TestInitGenesisMaxValidators() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	r1 := rand.New(rand.NewSource(time.Now().UnixNano()))
+	accounts := simulation.RandomAccounts(r1, 3)
+
+	validators := make([]*hmTypes.Validator, len(accounts))
+	for i := range validators {
+		validators[i] = hmTypes.NewValidator(
+			hmTypes.NewValidatorID(uint64(i+1)),
+			0,
+			0,
+			uint64(i),
+			int64(10*(i+1)), // power
+			hmTypes.NewPubKey(accounts[i].PubKey.Bytes()),
+			accounts[i].Address,
+		)
+	}
+
+	params := types.DefaultParams()
+	params.MaxValidators = 2
+	staking.InitGenesis(ctx, app.StakingKeeper, types.NewGenesisState(params, validators, hmTypes.ValidatorSet{}, nil))
+
+	validatorSet := app.StakingKeeper.GetValidatorSet(ctx)
+	require.Len(t, validatorSet.Validators, 2)
+	require.False(t, validatorSet.HasAddress(validators[0].Signer.Bytes()))
+	require.Len(t, app.StakingKeeper.GetAllValidators(ctx), 3)
+}
@@ -144,7 +144,7 @@ func (suite *HandlerTestSuite) TestHandleMsgValidatorUpdate() {
 	newValidators := keeper.GetCurrentValidators(ctx)
 	require.Equal(t, len(oldValSet.Validators), len(newValidators), "Number of current validators should be equal")
 
-	setUpdates := helper.GetUpdatedValidators(&oldValSet, keeper.GetAllValidators(ctx), 5)
+	setUpdates := helper.GetUpdatedValidators(&oldValSet, keeper.GetAllValidators(ctx), 5, types.DefaultMaxValidators)
 	oldValSet.UpdateWithChangeSet(setUpdates)
 	_ = keeper.UpdateValidatorSetInStore(ctx, oldValSet)
 
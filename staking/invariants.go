@@ -0,0 +1,52 @@
+package staking
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/staking/types"
+)
+
+// RegisterInvariants registers all staking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "validator-set-power", ValidatorSetPowerInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the staking module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ValidatorSetPowerInvariant(keeper)(ctx)
+	}
+}
+
+// ValidatorSetPowerInvariant checks that the current validator set power equals
+// the sum of the voting power of its validators held on store
+func ValidatorSetPowerInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		validatorSet := keeper.GetValidatorSet(ctx)
+
+		var msg string
+		var expectedPower int64
+		for _, setValidator := range validatorSet.Validators {
+			validator, ok := keeper.GetValidatorFromValID(ctx, setValidator.ID)
+			if !ok {
+				msg += fmt.Sprintf("\tvalidator %v of the set is not stored\n", setValidator.ID)
+				continue
+			}
+
+			if validator.VotingPower != setValidator.VotingPower {
+				msg += fmt.Sprintf("\tvalidator %v power in set: %d, on store: %d\n",
+					setValidator.ID, setValidator.VotingPower, validator.VotingPower)
+			}
+			expectedPower += validator.VotingPower
+		}
+
+		totalPower := validatorSet.TotalVotingPower()
+		broken := msg != "" || totalPower != expectedPower
+
+		return sdk.FormatInvariant(types.ModuleName, "validator set power",
+			fmt.Sprintf("%s\tvalidator set power:        %d\n\tsum of validators power: %d\n",
+				msg, totalPower, expectedPower)), broken
+	}
+}
@@ -3,6 +3,8 @@ package staking
 import (
 	"encoding/hex"
 	"errors"
+	"math"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,6 +34,7 @@ type ModuleCommunicator interface {
 	GetCoins(ctx sdk.Context, addr hmTypes.HeimdallAddress) sdk.Coins
 	SendCoins(ctx sdk.Context, from hmTypes.HeimdallAddress, to hmTypes.HeimdallAddress, amt sdk.Coins) sdk.Error
 	CreateValiatorSigningInfo(ctx sdk.Context, valID hmTypes.ValidatorID, valSigningInfo hmTypes.ValidatorSigningInfo)
+	IsUpgradeDone(ctx sdk.Context, name string) bool
 }
 
 // Keeper stores all related data
@@ -197,20 +200,92 @@ func (k *Keeper) GetTotalPower(ctx sdk.Context) (totalPower int64) {
 	return
 }
 
-// GetSpanEligibleValidators returns current validators who are not getting deactivated in between next span
+// GetSpanEligibleValidators returns current active validators who are not getting deactivated in between next span
 func (k *Keeper) GetSpanEligibleValidators(ctx sdk.Context) (validators []hmTypes.Validator) {
 	// get ack count
 	ackCount := k.moduleCommunicator.GetACKCount(ctx)
 
-	// Get validators and iterate through validator list
-	k.IterateValidatorsAndApplyFn(ctx, func(validator hmTypes.Validator) error {
-		// check if validator is valid for current epoch and endEpoch is not set.
-		if validator.EndEpoch == 0 && validator.IsCurrentValidator(ackCount) {
-			// append if validator is current valdiator
-			validators = append(validators, validator)
+	// validator set is not capped before v0.3 upgrade, all current validators are eligible in store order
+	if !k.moduleCommunicator.IsUpgradeDone(ctx, hmTypes.UpgradeV03) {
+		k.IterateValidatorsAndApplyFn(ctx, func(validator hmTypes.Validator) error {
+			// check if validator is valid for current epoch and endEpoch is not set.
+			if validator.EndEpoch == 0 && validator.IsCurrentValidator(ackCount) {
+				validators = append(validators, validator)
+			}
+			return nil
+		})
+
+		return
+	}
+
+	// get top validators by power, standby validators are not eligible
+	activeValidators := helper.GetActiveValidators(k.GetAllValidators(ctx), ackCount, k.GetMaxValidators(ctx))
+	for _, validator := range activeValidators {
+		// check if endEpoch is not set.
+		if validator.EndEpoch == 0 {
+			validators = append(validators, *validator)
 		}
-		return nil
-	})
+	}
+
+	return
+}
+
+// GetStandbyValidators returns current validators who are staked but not part of active validator set
+// because of MaxValidators limit
+func (k *Keeper) GetStandbyValidators(ctx sdk.Context) (validators []hmTypes.Validator) {
+	// get ack count
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+
+	allValidators := k.GetAllValidators(ctx)
+	active := make(map[hmTypes.ValidatorID]bool)
+	for _, validator := range helper.GetActiveValidators(allValidators, ackCount, k.GetMaxValidators(ctx)) {
+		active[validator.ID] = true
+	}
+
+	var standby []*hmTypes.Validator
+	for _, validator := range allValidators {
+		if validator.IsCurrentValidator(ackCount) && !active[validator.ID] {
+			standby = append(standby, validator)
+		}
+	}
+
+	// next validators in line come first
+	for _, validator := range hmTypes.SortValidatorByPower(standby) {
+		validators = append(validators, *validator)
+	}
+
+	return
+}
+
+// ValidatorStatusEvents returns events for validators entering the active validator set
+// and for current validators moving to standby because of MaxValidators limit
+func ValidatorStatusEvents(currentSet *hmTypes.ValidatorSet, updates []*hmTypes.Validator, validators []*hmTypes.Validator, ackCount uint64) (events sdk.Events) {
+	// validator id => validator
+	validatorByID := make(map[hmTypes.ValidatorID]*hmTypes.Validator)
+	for _, validator := range validators {
+		validatorByID[validator.ID] = validator
+	}
+
+	for _, update := range updates {
+		eventType := ""
+		if !currentSet.HasAddress(update.Signer.Bytes()) {
+			eventType = types.EventTypeValidatorActive
+		} else if validator, ok := validatorByID[update.ID]; ok && update.VotingPower == 0 && validator.IsCurrentValidator(ackCount) {
+			eventType = types.EventTypeValidatorStandby
+		}
+
+		if eventType == "" {
+			continue
+		}
+
+		events = append(events, sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, update.ID.String()),
+			sdk.NewAttribute(types.AttributeKeySigner, update.Signer.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorPower, strconv.FormatInt(validatorByID[update.ID].VotingPower, 10)),
+		))
+	}
 
 	return
 }
@@ -395,6 +470,31 @@ func (k *Keeper) IterateCurrentValidatorsAndApplyFn(ctx sdk.Context, f func(vali
 	}
 }
 
+//
+// Params
+//
+
+// SetParams sets the staking module's parameters.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the staking module's parameters.
+func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// GetMaxValidators returns the size limit of active validator set.
+// Validator set is not capped before v0.3 upgrade, all current validators are active.
+func (k *Keeper) GetMaxValidators(ctx sdk.Context) uint64 {
+	if !k.moduleCommunicator.IsUpgradeDone(ctx, hmTypes.UpgradeV03) {
+		return math.MaxUint64
+	}
+
+	return k.GetParams(ctx).MaxValidators
+}
+
 //
 // Staking sequence
 //
@@ -9,6 +9,7 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 
 	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/staking"
 
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	stakingSim "github.com/maticnetwork/heimdall/staking/simulation"
@@ -219,7 +220,7 @@ func (suite *KeeperTestSuite) TestRemoveValidatorSetChange() {
 	err := keeper.AddValidator(ctx, *prevValidatorSet.Validators[0])
 	require.Empty(t, err, "Unable to update validator set")
 
-	setUpdates := helper.GetUpdatedValidators(currentValSet, keeper.GetAllValidators(ctx), 5)
+	setUpdates := helper.GetUpdatedValidators(currentValSet, keeper.GetAllValidators(ctx), 5, keeper.GetParams(ctx).MaxValidators)
 	currentValSet.UpdateWithChangeSet(setUpdates)
 
 	updatedValSet := currentValSet
@@ -251,7 +252,7 @@ func (suite *KeeperTestSuite) TestAddValidatorSetChange() {
 
 	keeper.AddValidator(ctx, valToBeAdded)
 
-	setUpdates := helper.GetUpdatedValidators(currentValSet, keeper.GetAllValidators(ctx), 5)
+	setUpdates := helper.GetUpdatedValidators(currentValSet, keeper.GetAllValidators(ctx), 5, keeper.GetParams(ctx).MaxValidators)
 	currentValSet.UpdateWithChangeSet(setUpdates)
 
 	require.Equal(t, len(prevValSet.Validators)+1, len(currentValSet.Validators), "Number of validators should be increased by 1")
@@ -260,6 +261,48 @@ func (suite *KeeperTestSuite) TestAddValidatorSetChange() {
 
 }
 
+func (suite *KeeperTestSuite) TestMaxValidatorsSetChange() {
+	// create sub test to check validator admission with max validators
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// allow only 4 validators in active set
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 4
+	keeper.SetParams(ctx, params)
+
+	// load 4 validators to state
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	initValSet := keeper.GetValidatorSet(ctx)
+	currentValSet := initValSet.Copy()
+
+	// new validator with more power than existing validators
+	validators := stakingSim.GenRandomVal(1, 0, 20, 10, false, 5)
+	valToBeAdded := validators[0]
+	keeper.AddValidator(ctx, valToBeAdded)
+
+	setUpdates := helper.GetUpdatedValidators(currentValSet, keeper.GetAllValidators(ctx), 5, params.MaxValidators)
+	currentValSet.UpdateWithChangeSet(setUpdates)
+
+	require.Equal(t, 4, len(currentValSet.Validators), "Number of validators should not exceed max validators")
+	require.True(t, currentValSet.HasAddress(valToBeAdded.Signer.Bytes()), "Validator with higher power should be added")
+
+	// validator with lowest power and highest ID moves to standby
+	lastVal, _ := keeper.GetValidatorFromValID(ctx, hmTypes.NewValidatorID(4))
+	require.False(t, currentValSet.HasAddress(lastVal.Signer.Bytes()), "Validator with lowest power should be removed")
+
+	events := staking.ValidatorStatusEvents(&initValSet, setUpdates, keeper.GetAllValidators(ctx), 5)
+	require.Len(t, events, 2)
+
+	standby := keeper.GetStandbyValidators(ctx)
+	require.Len(t, standby, 1)
+	require.Equal(t, lastVal.ID, standby[0].ID)
+
+	for _, val := range keeper.GetSpanEligibleValidators(ctx) {
+		require.NotEqual(t, lastVal.ID, val.ID, "Standby validator should not be span eligible")
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpdateValidatorSetChange() {
 	// create sub test to check if validator remove
 	t, app, ctx := suite.T(), suite.app, suite.ctx
@@ -278,7 +321,7 @@ func (suite *KeeperTestSuite) TestUpdateValidatorSetChange() {
 
 	keeper.UpdateSigner(ctx, newSigner[0].Signer, newSigner[0].PubKey, valToUpdate.Signer)
 
-	setUpdates := helper.GetUpdatedValidators(&currentValSet, keeper.GetAllValidators(ctx), 5)
+	setUpdates := helper.GetUpdatedValidators(&currentValSet, keeper.GetAllValidators(ctx), 5, keeper.GetParams(ctx).MaxValidators)
 	currentValSet.UpdateWithChangeSet(setUpdates)
 
 	require.Equal(t, len(prevValSet.Validators), len(currentValSet.Validators), "Number of validators should remain same")
@@ -368,3 +411,20 @@ func (suite *KeeperTestSuite) TestGetSpanEligibleValidators() {
 	validators := keeper.GetSpanEligibleValidators(ctx)
 	require.LessOrEqual(t, len(validators), 4)
 }
+
+func (suite *KeeperTestSuite) TestValidatorSetPowerInvariant() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	_, broken := staking.ValidatorSetPowerInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// validator power changed on store only
+	validator := keeper.GetValidatorSet(ctx).Validators[0]
+	validator.VotingPower++
+	require.NoError(t, keeper.AddValidator(ctx, *validator))
+
+	_, broken = staking.ValidatorSetPowerInvariant(keeper)(ctx)
+	require.True(t, broken)
+}
@@ -0,0 +1,49 @@
+package v0_3
+
+import (
+	"encoding/json"
+
+	"github.com/maticnetwork/heimdall/migrate"
+)
+
+// Params of the staking module added in v0.3
+type Params struct {
+	ProposerBonusPercent int64  `json:"proposer_bonus_percent"`
+	MaxValidators        uint64 `json:"max_validators"`
+}
+
+// DefaultParams of the staking module in v0.3
+var DefaultParams = Params{
+	ProposerBonusPercent: 10,
+	MaxValidators:        100,
+}
+
+// Migrate adds the staking params. The validator limit is raised to the size
+// of the current validator set so no validator moves to standby on upgrade.
+func Migrate(oldGenState json.RawMessage) (json.RawMessage, error) {
+	genState, err := migrate.DecodeObject(oldGenState)
+	if err != nil {
+		return nil, err
+	}
+
+	valSet, err := genState.Object("current_val_set")
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := valSet.List("validators")
+	if err != nil {
+		return nil, err
+	}
+
+	params := DefaultParams
+	if uint64(len(validators)) > params.MaxValidators {
+		params.MaxValidators = uint64(len(validators))
+	}
+
+	if err := genState.SetDefault("params", params); err != nil {
+		return nil, err
+	}
+
+	return genState.Marshal()
+}
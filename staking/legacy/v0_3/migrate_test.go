@@ -0,0 +1,30 @@
+package v0_3_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/staking/legacy/v0_3"
+	"github.com/maticnetwork/heimdall/staking/types"
+)
+
+func TestMigrateLargeValidatorSet(t *testing.T) {
+	validators := make([]string, 120)
+	for i := range validators {
+		validators[i] = fmt.Sprintf(`{"ID":"%d","power":"1"}`, i+1)
+	}
+
+	oldGenState := fmt.Sprintf(`{"validators":[],"current_val_set":{"validators":[%s]}}`, strings.Join(validators, ","))
+	migrated, err := v0_3.Migrate(json.RawMessage(oldGenState))
+	require.NoError(t, err)
+
+	// the active set is kept on upgrade
+	var genState types.GenesisState
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(migrated, &genState))
+	require.Equal(t, uint64(120), genState.Params.MaxValidators)
+	require.Equal(t, v0_3.DefaultParams.ProposerBonusPercent, genState.Params.ProposerBonusPercent)
+}
@@ -0,0 +1,166 @@
+package staking
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/simulation"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgValidatorJoin = "op_weight_msg_validator_join"
+	OpWeightMsgStakeUpdate   = "op_weight_msg_stake_update"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simTypes.AppParams, cdc *codec.Codec, k Keeper, sideChannel *simTypes.SideChannel) simulation.WeightedOperations {
+	var weightMsgValidatorJoin int
+	appParams.GetOrGenerate(cdc, OpWeightMsgValidatorJoin, &weightMsgValidatorJoin, nil,
+		func(_ *rand.Rand) {
+			weightMsgValidatorJoin = 10
+		},
+	)
+
+	var weightMsgStakeUpdate int
+	appParams.GetOrGenerate(cdc, OpWeightMsgStakeUpdate, &weightMsgStakeUpdate, nil,
+		func(_ *rand.Rand) {
+			weightMsgStakeUpdate = 50
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgValidatorJoin, SimulateMsgValidatorJoin(k, sideChannel)),
+		simulation.NewWeightedOperation(weightMsgStakeUpdate, SimulateMsgStakeUpdate(k, sideChannel)),
+	}
+}
+
+// SimulateMsgValidatorJoin generates a MsgValidatorJoin for a new signer,
+// occasionally with an invalid amount or not matching the staked event on the
+// main chain.
+func SimulateMsgValidatorJoin(k Keeper, sideChannel *simTypes.SideChannel) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+
+		from, _ := simTypes.RandomAcc(r, accs)
+		signer := simTypes.RandomAccounts(r, 1)[0]
+		pubkey := hmTypes.NewPubKey(signer.PubKey.Bytes())
+
+		amount, _ := helper.GetAmountFromPower(int64(simTypes.RandIntBetween(r, 1, 100)))
+
+		msg := types.NewMsgValidatorJoin(
+			from.Address,
+			uint64(simTypes.RandIntBetween(r, 1, math.MaxInt32)),
+			k.moduleCommunicator.GetACKCount(ctx),
+			sdk.NewIntFromBigInt(amount),
+			pubkey,
+			hmTypes.BytesToHeimdallHash(simTypes.RandBytes(r, 32)),
+			uint64(r.Intn(100)),
+			uint64(simTypes.RandIntBetween(r, 1, 1000000)),
+			0,
+		)
+
+		// staked event as emitted on the main chain
+		receipt := &ethTypes.Receipt{BlockNumber: new(big.Int).SetUint64(msg.BlockNumber)}
+		event := &stakinginfo.StakinginfoStaked{
+			Signer:          pubkey.Address(),
+			ValidatorId:     new(big.Int).SetUint64(msg.ID.Uint64()),
+			Nonce:           new(big.Int).SetUint64(msg.Nonce),
+			ActivationEpoch: new(big.Int).SetUint64(msg.ActivationEpoch),
+			Amount:          msg.Amount.BigInt(),
+			SignerPubkey:    pubkey.Bytes()[1:],
+		}
+
+		switch r.Intn(10) {
+		case 0:
+			msg.Amount = sdk.NewInt(1)
+		case 1:
+			event.Amount = new(big.Int).Add(event.Amount, big.NewInt(1))
+		case 2:
+			event.ValidatorId = new(big.Int).SetUint64(msg.ID.Uint64() + 1)
+		case 3:
+			event.Nonce = new(big.Int).SetUint64(msg.Nonce + 1)
+		}
+
+		contractCaller := &mocks.IContractCaller{}
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything).Return(receipt, nil)
+		contractCaller.On("DecodeValidatorJoinEvent", mock.Anything, receipt, msg.LogIndex).Return(event, nil)
+
+		opMsg := simulation.DeliverSideTx(r, sideChannel, ctx, msg,
+			NewHandler(k, contractCaller),
+			NewSideTxHandler(k, contractCaller),
+		)
+
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgStakeUpdate generates a MsgStakeUpdate for an existing validator,
+// occasionally with a stale nonce or not matching the stake update event on
+// the main chain.
+func SimulateMsgStakeUpdate(k Keeper, sideChannel *simTypes.SideChannel) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+
+		validators := k.GetAllValidators(ctx)
+		if len(validators) == 0 {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		validator := validators[r.Intn(len(validators))]
+		from, _ := simTypes.RandomAcc(r, accs)
+
+		amount, _ := helper.GetAmountFromPower(int64(simTypes.RandIntBetween(r, 1, 100)))
+
+		msg := types.NewMsgStakeUpdate(
+			from.Address,
+			validator.ID.Uint64(),
+			sdk.NewIntFromBigInt(amount),
+			hmTypes.BytesToHeimdallHash(simTypes.RandBytes(r, 32)),
+			uint64(r.Intn(100)),
+			uint64(simTypes.RandIntBetween(r, 1, 1000000)),
+			validator.Nonce+1,
+		)
+
+		// stake update event as emitted on the main chain
+		receipt := &ethTypes.Receipt{BlockNumber: new(big.Int).SetUint64(msg.BlockNumber)}
+		event := &stakinginfo.StakinginfoStakeUpdate{
+			ValidatorId: new(big.Int).SetUint64(msg.ID.Uint64()),
+			NewAmount:   msg.NewAmount.BigInt(),
+			Nonce:       new(big.Int).SetUint64(msg.Nonce),
+		}
+
+		switch r.Intn(10) {
+		case 0:
+			msg.Nonce = validator.Nonce
+		case 1:
+			event.NewAmount = new(big.Int).Add(event.NewAmount, big.NewInt(1))
+		case 2:
+			receipt.BlockNumber = new(big.Int).SetUint64(msg.BlockNumber + 1)
+		}
+
+		contractCaller := &mocks.IContractCaller{}
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything).Return(receipt, nil)
+		contractCaller.On("DecodeValidatorStakeUpdateEvent", mock.Anything, receipt, msg.LogIndex).Return(event, nil)
+
+		opMsg := simulation.DeliverSideTx(r, sideChannel, ctx, msg,
+			NewHandler(k, contractCaller),
+			NewSideTxHandler(k, contractCaller),
+		)
+
+		return opMsg, nil, nil
+	}
+}
@@ -34,6 +34,12 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryStakingSequence(ctx, req, keeper, contractCaller)
 		case types.QueryTotalValidatorPower:
 			return handleQueryTotalValidatorPower(ctx, req, keeper)
+		case types.QueryStandbyValidators:
+			return handleQueryStandbyValidators(ctx, req, keeper)
+		case types.QueryProposerBonusPercent:
+			return handleQueryProposerBonusPercent(ctx, req, keeper)
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
 
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
@@ -51,6 +57,33 @@ func handleQueryTotalValidatorPower(ctx sdk.Context, req abci.RequestQuery, keep
 
 }
 
+func handleQueryStandbyValidators(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// get validators waiting for a slot in active validator set
+	validators := keeper.GetStandbyValidators(ctx)
+
+	bz, err := json.Marshal(validators)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryProposerBonusPercent(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx).ProposerBonusPercent)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCurrentValidatorSet(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	// get validator set
 	validatorSet := keeper.GetValidatorSet(ctx)
@@ -1,9 +1,7 @@
 package simulation
 
 import (
-	"math/rand"
 	"strconv"
-	"time"
 
 	"github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -12,8 +10,7 @@ import (
 )
 
 func RandomizedGenState(simState *module.SimulationState) {
-	s1 := rand.NewSource(time.Now().UnixNano())
-	r1 := rand.New(s1)
+	r1 := simState.Rand
 	n := 5
 	accounts := simulation.RandomAccounts(r1, n)
 	stakingSequence := make([]string, n)
@@ -40,6 +37,6 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// validator set
 	validatorSet := hmTypes.NewValidatorSet(validators)
 
-	genesisState := types.NewGenesisState(validators, *validatorSet, stakingSequence)
+	genesisState := types.NewGenesisState(types.DefaultParams(), validators, *validatorSet, stakingSequence)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
 }
@@ -8,12 +8,16 @@ var (
 	EventTypeStakeUpdate   = "stake-update"
 	EventTypeValidatorExit = "validator-exit"
 
+	EventTypeValidatorActive  = "validator-active"
+	EventTypeValidatorStandby = "validator-standby"
+
 	AttributeKeySigner            = "signer"
 	AttributeKeyDeactivationEpoch = "deactivation-epoch"
 	AttributeKeyActivationEpoch   = "activation-epoch"
 	AttributeKeyValidatorID       = "validator-id"
 	AttributeKeyValidatorNonce    = "validator-nonce"
 	AttributeKeyUpdatedAt         = "updated-at"
+	AttributeKeyValidatorPower    = "validator-power"
 
 	AttributeValueCategory = ModuleName
 )
@@ -34,6 +34,7 @@ func (v *GenesisValidator) HeimdallValidator() hmTypes.Validator {
 
 // GenesisState is the checkpoint state that must be provided at genesis.
 type GenesisState struct {
+	Params           Params               `json:"params" yaml:"params"`
 	Validators       []*hmTypes.Validator `json:"validators" yaml:"validators"`
 	CurrentValSet    hmTypes.ValidatorSet `json:"current_val_set" yaml:"current_val_set"`
 	StakingSequences []string             `json:"staking_sequences" yaml:"staking_sequences"`
@@ -41,11 +42,13 @@ type GenesisState struct {
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
+	params Params,
 	validators []*hmTypes.Validator,
 	currentValSet hmTypes.ValidatorSet,
 	stakingSequences []string,
 ) GenesisState {
 	return GenesisState{
+		Params:           params,
 		Validators:       validators,
 		CurrentValSet:    currentValSet,
 		StakingSequences: stakingSequences,
@@ -54,12 +57,31 @@ func NewGenesisState(
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(nil, hmTypes.ValidatorSet{}, nil)
+	return NewGenesisState(DefaultParams(), nil, hmTypes.ValidatorSet{}, nil)
+}
+
+// DefaultMissingParams sets default params on genesis state exported before staking
+// params were added. As in the v0.3 migration, the validator limit is raised to the
+// size of the current validator set so no validator moves to standby.
+func (data *GenesisState) DefaultMissingParams() {
+	if data.Params != (Params{}) {
+		return
+	}
+
+	data.Params = DefaultParams()
+	if uint64(len(data.CurrentValSet.Validators)) > data.Params.MaxValidators {
+		data.Params.MaxValidators = uint64(len(data.CurrentValSet.Validators))
+	}
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	data.DefaultMissingParams()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, validator := range data.Validators {
 		if !validator.ValidateBasic() {
 			return errors.New("Invalid validator")
@@ -80,6 +102,7 @@ func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisSta
 	if appState[ModuleName] != nil {
 		types.ModuleCdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
 	}
+	genesisState.DefaultMissingParams()
 	return genesisState
 }
 
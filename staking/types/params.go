@@ -1,21 +1,125 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
+
 	"github.com/maticnetwork/heimdall/params/subspace"
 )
 
+// Default parameter values
 const (
-
 	// DefaultProposerBonusPercent - Proposer Signer Reward Ratio
 	DefaultProposerBonusPercent = int64(10)
+
+	// DefaultMaxValidators - maximum number of validators in active validator set
+	DefaultMaxValidators uint64 = 100
+)
+
+// Parameter keys
+var (
+	// ParamStoreKeyProposerBonusPercent - Store's Key for Reward amount
+	ParamStoreKeyProposerBonusPercent = []byte("proposerbonuspercent")
+
+	// KeyMaxValidators - Store's Key for max validators in active set
+	KeyMaxValidators = []byte("MaxValidators")
 )
 
-// ParamStoreKeyProposerBonusPercent - Store's Key for Reward amount
-var ParamStoreKeyProposerBonusPercent = []byte("proposerbonuspercent")
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the staking module.
+type Params struct {
+	ProposerBonusPercent int64  `json:"proposer_bonus_percent" yaml:"proposer_bonus_percent"` // proposer signer reward ratio
+	MaxValidators        uint64 `json:"max_validators" yaml:"max_validators"`                 // max validators in active validator set, ranked by power
+}
+
+// NewParams creates a new Params object
+func NewParams(proposerBonusPercent int64, maxValidators uint64) Params {
+	return Params{
+		ProposerBonusPercent: proposerBonusPercent,
+		MaxValidators:        maxValidators,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of staking module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{ParamStoreKeyProposerBonusPercent, &p.ProposerBonusPercent},
+		{KeyMaxValidators, &p.MaxValidators},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("ProposerBonusPercent: %d\n", p.ProposerBonusPercent))
+	sb.WriteString(fmt.Sprintf("MaxValidators: %d\n", p.MaxValidators))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateProposerBonusPercent(p.ProposerBonusPercent); err != nil {
+		return err
+	}
+
+	if err := validateMaxValidators(p.MaxValidators); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//
+// Extra functions
+//
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable(
-		ParamStoreKeyProposerBonusPercent, DefaultProposerBonusPercent,
-	)
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		ProposerBonusPercent: DefaultProposerBonusPercent,
+		MaxValidators:        DefaultMaxValidators,
+	}
+}
+
+func validateProposerBonusPercent(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 || v > 100 {
+		return fmt.Errorf("invalid proposer bonus percent: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid max validators: %d", v)
+	}
+
+	return nil
 }
@@ -15,6 +15,8 @@ const (
 	QueryCurrentProposer      = "current-proposer"
 	QueryProposerBonusPercent = "proposer-bonus-percent"
 	QueryStakingSequence      = "staking-sequence"
+	QueryStandbyValidators    = "standby-validators"
+	QueryParams               = "params"
 )
 
 // QuerySignerParams defines the params for querying by address
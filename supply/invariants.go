@@ -0,0 +1,83 @@
+package supply
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+)
+
+// RegisterInvariants registers all supply invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(supplyTypes.ModuleName, "module-accounts", ModuleAccountsInvariant(k))
+	ir.RegisterRoute(supplyTypes.ModuleName, "total-supply", TotalSupplyInvariant(k))
+}
+
+// AllInvariants runs all invariants of the supply module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return TotalSupplyInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountsInvariant checks that every registered module account is stored
+// as a module account under its name and holds valid coins
+func ModuleAccountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		names := make([]string, 0, len(k.permAddrs))
+		for name := range k.permAddrs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var msg string
+		broken := false
+		for _, name := range names {
+			acc := k.ak.GetAccount(ctx, k.permAddrs[name].GetAddress())
+			if acc == nil {
+				continue
+			}
+
+			macc, ok := acc.(supplyTypes.ModuleAccountInterface)
+			if !ok || macc.GetName() != name {
+				broken = true
+				msg += fmt.Sprintf("\t%s is not stored as module account\n", name)
+				continue
+			}
+
+			if !macc.GetCoins().IsValid() {
+				broken = true
+				msg += fmt.Sprintf("\t%s module account has invalid coins: %s\n", name, macc.GetCoins())
+			}
+		}
+
+		return sdk.FormatInvariant(supplyTypes.ModuleName, "module accounts", msg), broken
+	}
+}
+
+// TotalSupplyInvariant checks that the total supply reflects all the coins held
+// by accounts, module accounts included
+func TotalSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedTotal sdk.Coins
+		k.ak.IterateAccounts(ctx, func(acc authTypes.Account) (stop bool) {
+			expectedTotal = expectedTotal.Add(acc.GetCoins())
+			return false
+		})
+
+		total := k.GetSupply(ctx).Total
+		broken := !expectedTotal.IsAllGTE(total) || !total.IsAllGTE(expectedTotal)
+
+		return sdk.FormatInvariant(supplyTypes.ModuleName, "total supply",
+			fmt.Sprintf("\tsum of accounts coins: %v\n\tsupply.Total:          %v\n",
+				expectedTotal, total)), broken
+	}
+}
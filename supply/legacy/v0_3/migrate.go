@@ -0,0 +1,31 @@
+package v0_3
+
+import (
+	"encoding/json"
+
+	"github.com/maticnetwork/heimdall/migrate"
+)
+
+// Migrate drops the total supply. Topups before v0.3 were not added to total
+// supply, it is recomputed from the genesis accounts on init genesis.
+func Migrate(oldGenState json.RawMessage) (json.RawMessage, error) {
+	genState, err := migrate.DecodeObject(oldGenState)
+	if err != nil {
+		return nil, err
+	}
+
+	supply, err := genState.Object("supply")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := supply.Set("total", migrate.EmptyList); err != nil {
+		return nil, err
+	}
+
+	if err := genState.Set("supply", supply); err != nil {
+		return nil, err
+	}
+
+	return genState.Marshal()
+}
@@ -6,6 +6,8 @@ import (
 )
 
 // InitGenesis sets distribution information for genesis.
+//
+// CONTRACT: total supply must have been already initialized
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	for _, sequence := range data.TopupSequences {
 		keeper.SetTopupSequence(ctx, sequence)
@@ -18,6 +20,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		}
 	}
 
+	// genesis without total topups is backed by its dividend accounts and supply
+	totalTopups := data.TotalTopups
+	if totalTopups == (sdk.Int{}) || totalTopups.IsZero() {
+		var err error
+		if totalTopups, err = keeper.EstimateTotalTopups(ctx); err != nil {
+			panic(err)
+		}
+	}
+	keeper.SetTotalTopups(ctx, totalTopups)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -25,5 +36,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	return types.NewGenesisState(
 		keeper.GetTopupSequences(ctx),
 		keeper.GetAllDividendAccounts(ctx),
+		keeper.GetTotalTopups(ctx),
 	)
 }
@@ -25,7 +25,8 @@ type GenesisTestSuite struct {
 
 // SetupTest setup necessary things for genesis test
 func (suite *GenesisTestSuite) SetupTest() {
-	suite.app, suite.ctx, _ = createTestApp(true)
+	// genesis of supply is initialized before topup
+	suite.app, suite.ctx, _ = createTestApp(false)
 }
 
 // TestGenesisTestSuite
@@ -91,6 +91,14 @@ func HandleMsgWithdrawFee(ctx sdk.Context, k Keeper, msg types.MsgWithdrawFee) s
 		return err.Result()
 	}
 
+	// withdrawn coins leave supply
+	if k.TracksSupply(ctx) {
+		if err := k.DeflateSupply(ctx, maticCoins); err != nil {
+			k.Logger(ctx).Error("handleMsgWithdrawFee | DeflateSupply", "fromAddress", msg.UserAddress, "err", err)
+			return err.Result()
+		}
+	}
+
 	// Add Fee to Dividend Account
 	feeAmount := amount.BigInt()
 	if err := k.AddFeeToDividendAccount(ctx, msg.UserAddress, feeAmount); err != nil {
@@ -17,6 +17,7 @@ import (
 	chainTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper/mocks"
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
 	"github.com/maticnetwork/heimdall/topup"
 	"github.com/maticnetwork/heimdall/topup/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -109,6 +110,13 @@ func (suite *HandlerTestSuite) TestHandleMsgTopup() {
 	})
 }
 
+// inflateSupply adds coins set directly on accounts to total supply
+func (suite *HandlerTestSuite) inflateSupply(coins sdk.Coins) {
+	supply := suite.app.SupplyKeeper.GetSupply(suite.ctx)
+	supply.Inflate(coins)
+	suite.app.SupplyKeeper.SetSupply(suite.ctx, supply)
+}
+
 func (suite *HandlerTestSuite) TestHandleMsgWithdrawFee() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 
@@ -130,6 +138,7 @@ func (suite *HandlerTestSuite) TestHandleMsgWithdrawFee() {
 		acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, hmTypes.AccAddressToHeimdallAddress(addr))
 		acc1.SetCoins(coins)
 		app.AccountKeeper.SetAccount(ctx, acc1)
+		suite.inflateSupply(coins)
 
 		// check if coins > 0
 		require.True(t, acc1.GetCoins().AmountOf(authTypes.FeeToken).GT(sdk.NewInt(0)))
@@ -152,6 +161,7 @@ func (suite *HandlerTestSuite) TestHandleMsgWithdrawFee() {
 		acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, hmTypes.AccAddressToHeimdallAddress(addr))
 		acc1.SetCoins(coins)
 		app.AccountKeeper.SetAccount(ctx, acc1)
+		suite.inflateSupply(coins)
 
 		// check if coins > 0
 		require.True(t, acc1.GetCoins().AmountOf(authTypes.FeeToken).GT(sdk.NewInt(0)))
@@ -181,6 +191,7 @@ func (suite *HandlerTestSuite) TestHandleMsgWithdrawFee() {
 		acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, hmTypes.AccAddressToHeimdallAddress(addr))
 		acc1.SetCoins(coins)
 		app.AccountKeeper.SetAccount(ctx, acc1)
+		suite.inflateSupply(coins)
 
 		m, _ := sdk.NewIntFromString("1")
 		coins = coins.Add(sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: m}})
@@ -192,4 +203,28 @@ func (suite *HandlerTestSuite) TestHandleMsgWithdrawFee() {
 		result := suite.handler(ctx, msg)
 		require.False(t, result.IsOK(), "Expected withdraw to be failed while withdrawing more than account's coins")
 	})
+
+	t.Run("Supply", func(t *testing.T) {
+		_, _, addr := sdkAuth.KeyTestPubAddr()
+		user := hmTypes.AccAddressToHeimdallAddress(addr)
+
+		// coins missing from total supply can't be withdrawn
+		coins := simulation.RandomFeeCoins()
+		acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, user)
+		acc1.SetCoins(coins)
+		app.AccountKeeper.SetAccount(ctx, acc1)
+		app.SupplyKeeper.SetSupply(ctx, supplyTypes.NewSupply(sdk.NewCoins()))
+
+		// failed msg changes are discarded, as when delivered in a tx
+		msg := types.NewMsgWithdrawFee(user, sdk.NewInt(0))
+		cacheCtx, _ := ctx.CacheContext()
+		result := suite.handler(cacheCtx, msg)
+		require.False(t, result.IsOK(), "Expected withdraw to be failed while total supply is lower than withdrawn amount")
+
+		// withdrawn coins leave total supply
+		suite.inflateSupply(coins)
+		result = suite.handler(ctx, msg)
+		require.True(t, result.IsOK(), "Expected withdraw to succeed, but failed")
+		require.True(t, app.SupplyKeeper.GetSupply(ctx).Total.IsZero())
+	})
 }
@@ -0,0 +1,45 @@
+package topup
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/topup/types"
+)
+
+// RegisterInvariants registers all topup invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "dividend-accounts", DividendAccountsInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the topup module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return DividendAccountsInvariant(keeper)(ctx)
+	}
+}
+
+// DividendAccountsInvariant checks that no dividend account holds more fee
+// than has been topped up in total
+func DividendAccountsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalTopups := keeper.GetTotalTopups(ctx)
+
+		var msg string
+		for _, dividendAccount := range keeper.GetAllDividendAccounts(ctx) {
+			fee, ok := sdk.NewIntFromString(dividendAccount.FeeAmount)
+			if !ok {
+				msg += fmt.Sprintf("\t%s has invalid fee amount: %s\n", dividendAccount.User, dividendAccount.FeeAmount)
+				continue
+			}
+
+			if fee.GT(totalTopups) {
+				msg += fmt.Sprintf("\t%s fee amount %s exceeds total topups\n", dividendAccount.User, fee)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "dividend accounts",
+			fmt.Sprintf("%s\ttotal topups: %s\n", msg, totalTopups)), msg != ""
+	}
+}
@@ -3,15 +3,18 @@ package topup
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/bank"
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/staking"
+	"github.com/maticnetwork/heimdall/supply"
 	"github.com/maticnetwork/heimdall/topup/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -24,6 +27,8 @@ var (
 	TopupSequencePrefixKey = []byte{0x81}
 
 	DividendAccountMapKey = []byte{0x82} // prefix for each key for Dividend Account Map
+
+	TotalTopupsKey = []byte{0x83} // key for total fee topped up from main chain
 )
 
 // Keeper stores all related data
@@ -42,6 +47,10 @@ type Keeper struct {
 	bk bank.Keeper
 	// staking keeper
 	sk staking.Keeper
+	// supply keeper
+	supplyKeeper supply.Keeper
+	// upgrade keeper
+	upgradeKeeper hmTypes.UpgradeKeeper
 }
 
 // NewKeeper create new keeper
@@ -53,6 +62,8 @@ func NewKeeper(
 	chainKeeper chainmanager.Keeper,
 	bankKeeper bank.Keeper,
 	stakingKeeper staking.Keeper,
+	supplyKeeper supply.Keeper,
+	upgradeKeeper hmTypes.UpgradeKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:         cdc,
@@ -62,6 +73,9 @@ func NewKeeper(
 		chainKeeper: chainKeeper,
 		bk:          bankKeeper,
 		sk:          stakingKeeper,
+
+		supplyKeeper:  supplyKeeper,
+		upgradeKeeper: upgradeKeeper,
 	}
 }
 
@@ -124,6 +138,69 @@ func (keeper *Keeper) HasTopupSequence(ctx sdk.Context, sequence string) bool {
 	return store.Has(GetTopupSequenceKey(sequence))
 }
 
+// GetTotalTopups returns total fee topped up
+func (keeper *Keeper) GetTotalTopups(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(keeper.key)
+
+	total := sdk.ZeroInt()
+	if bz := store.Get(TotalTopupsKey); bz != nil {
+		keeper.cdc.MustUnmarshalBinaryBare(bz, &total)
+	}
+
+	return total
+}
+
+// SetTotalTopups sets total fee topped up
+func (keeper *Keeper) SetTotalTopups(ctx sdk.Context, total sdk.Int) {
+	store := ctx.KVStore(keeper.key)
+	store.Set(TotalTopupsKey, keeper.cdc.MustMarshalBinaryBare(total))
+}
+
+// EstimateTotalTopups returns total topups for a state in which topups were not recorded, the fee
+// held by dividend accounts and the fee tokens in total supply. It bounds the fee dividend accounts
+// can reach by withdrawals.
+func (keeper *Keeper) EstimateTotalTopups(ctx sdk.Context) (sdk.Int, error) {
+	totalTopups := keeper.supplyKeeper.GetSupply(ctx).Total.AmountOf(authTypes.FeeToken)
+	for _, dividendAccount := range keeper.GetAllDividendAccounts(ctx) {
+		fee, ok := sdk.NewIntFromString(dividendAccount.FeeAmount)
+		if !ok {
+			return sdk.Int{}, fmt.Errorf("dividend account %s has invalid fee amount: %s", dividendAccount.User, dividendAccount.FeeAmount)
+		}
+		totalTopups = totalTopups.Add(fee)
+	}
+
+	return totalTopups, nil
+}
+
+// TracksSupply returns true if topups and withdrawals change total supply and total topups,
+// which they do since the v0.3 upgrade
+func (keeper *Keeper) TracksSupply(ctx sdk.Context) bool {
+	return keeper.upgradeKeeper.IsUpgradeDone(ctx, hmTypes.UpgradeV03)
+}
+
+// InflateSupply adds topped up coins to total supply
+func (keeper *Keeper) InflateSupply(ctx sdk.Context, amount sdk.Coins) {
+	supply := keeper.supplyKeeper.GetSupply(ctx)
+	supply.Inflate(amount)
+	keeper.supplyKeeper.SetSupply(ctx, supply)
+}
+
+// DeflateSupply removes withdrawn coins from total supply
+func (keeper *Keeper) DeflateSupply(ctx sdk.Context, amount sdk.Coins) sdk.Error {
+	supply := keeper.supplyKeeper.GetSupply(ctx)
+	if _, hasNeg := supply.Total.SafeSub(amount); hasNeg {
+		return sdk.ErrInsufficientCoins(fmt.Sprintf("total supply %s is lower than withdrawn amount %s", supply.Total, amount))
+	}
+
+	supply.Deflate(amount)
+	keeper.supplyKeeper.SetSupply(ctx, supply)
+	return nil
+}
+
+//
+// Dividend account methods
+//
+
 // GetDividendAccountMapKey returns dividend account map
 func GetDividendAccountMapKey(address []byte) []byte {
 	return append(DividendAccountMapKey, address...)
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/topup"
 	"github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/simulation"
@@ -94,3 +95,20 @@ func (suite *KeeperTestSuite) TestDividendAccountTree() {
 	require.NotNil(t, leafHash)
 	require.NoError(t, err)
 }
+
+func (suite *KeeperTestSuite) TestDividendAccountsInvariant() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.TopupKeeper
+	user := hmTypes.BytesToHeimdallAddress([]byte("some-address"))
+
+	keeper.SetTotalTopups(ctx, sdk.NewInt(100))
+	require.Nil(t, keeper.AddFeeToDividendAccount(ctx, user, big.NewInt(100)))
+
+	_, broken := topup.DividendAccountsInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// withdrawn fee exceeds topped up fee
+	require.Nil(t, keeper.AddFeeToDividendAccount(ctx, user, big.NewInt(1)))
+	_, broken = topup.DividendAccountsInvariant(keeper)(ctx)
+	require.True(t, broken)
+}
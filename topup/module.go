@@ -103,8 +103,10 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the topup invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the auth module.
 func (AppModule) Route() string {
@@ -170,9 +172,9 @@ func (AppModule) RandomizedParams(r *rand.Rand) []simTypes.ParamChange {
 func (AppModule) RegisterStoreDecoder(sdr hmModule.StoreDecoderRegistry) {
 }
 
-// WeightedOperations doesn't return any chainmanager module operation.
-func (AppModule) WeightedOperations(_ hmModule.SimulationState) []simTypes.WeightedOperation {
-	return nil
+// WeightedOperations returns all the topup module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState hmModule.SimulationState) []simTypes.WeightedOperation {
+	return WeightedOperations(simState.AppParams, simState.Cdc, am.keeper, simState.SideChannel)
 }
 
 func (am AppModule) NewSideTxHandler() hmTypes.SideTxHandler {
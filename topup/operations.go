@@ -0,0 +1,121 @@
+package topup
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/maticnetwork/heimdall/auth"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	"github.com/maticnetwork/heimdall/simulation"
+	"github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgTopup       = "op_weight_msg_topup"
+	OpWeightMsgWithdrawFee = "op_weight_msg_withdraw_fee"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simTypes.AppParams, cdc *codec.Codec, k Keeper, sideChannel *simTypes.SideChannel) simulation.WeightedOperations {
+	var weightMsgTopup int
+	appParams.GetOrGenerate(cdc, OpWeightMsgTopup, &weightMsgTopup, nil,
+		func(_ *rand.Rand) {
+			weightMsgTopup = 100
+		},
+	)
+
+	var weightMsgWithdrawFee int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawFee, &weightMsgWithdrawFee, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawFee = 50
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgTopup, SimulateMsgTopup(k, sideChannel)),
+		simulation.NewWeightedOperation(weightMsgWithdrawFee, SimulateMsgWithdrawFee(k)),
+	}
+}
+
+// SimulateMsgTopup generates a MsgTopup for a random account, occasionally
+// with a fee too low to pay the proposer or not matching the topup event on the
+// main chain.
+func SimulateMsgTopup(k Keeper, sideChannel *simTypes.SideChannel) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+
+		from, _ := simTypes.RandomAcc(r, accs)
+		user, _ := simTypes.RandomAcc(r, accs)
+
+		fee := new(big.Int).Mul(auth.DefaultFeeInMatic, big.NewInt(int64(simTypes.RandIntBetween(r, 1, 100))))
+
+		msg := types.NewMsgTopup(
+			from.Address,
+			user.Address,
+			sdk.NewIntFromBigInt(fee),
+			hmTypes.BytesToHeimdallHash(simTypes.RandBytes(r, 32)),
+			uint64(r.Intn(100)),
+			uint64(simTypes.RandIntBetween(r, 1, 1000000)),
+		)
+
+		// topup event as emitted on the main chain
+		receipt := &ethTypes.Receipt{BlockNumber: new(big.Int).SetUint64(msg.BlockNumber)}
+		event := &stakinginfo.StakinginfoTopUpFee{
+			User: msg.User.EthAddress(),
+			Fee:  msg.Fee.BigInt(),
+		}
+
+		switch r.Intn(10) {
+		case 0:
+			msg.Fee = sdk.OneInt()
+			event.Fee = msg.Fee.BigInt()
+		case 1:
+			event.Fee = new(big.Int).Add(event.Fee, big.NewInt(1))
+		case 2:
+			event.User = common.BytesToAddress(simTypes.RandBytes(r, 20))
+		}
+
+		contractCaller := &mocks.IContractCaller{}
+		contractCaller.On("GetConfirmedTxReceipt", mock.Anything, mock.Anything).Return(receipt, nil)
+		contractCaller.On("DecodeValidatorTopupFeesEvent", mock.Anything, receipt, msg.LogIndex).Return(event, nil)
+
+		opMsg := simulation.DeliverSideTx(r, sideChannel, ctx, msg,
+			NewHandler(k, contractCaller),
+			NewSideTxHandler(k, contractCaller),
+		)
+
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgWithdrawFee generates a MsgWithdrawFee for a random account,
+// withdrawing either part or all of its fee balance.
+func SimulateMsgWithdrawFee(k Keeper) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+
+		user, _ := simTypes.RandomAcc(r, accs)
+
+		// zero amount withdraws the full balance
+		amount := sdk.ZeroInt()
+		if balance := k.bk.GetCoins(ctx, user.Address).AmountOf(authTypes.FeeToken); balance.IsPositive() && r.Intn(2) == 0 {
+			amount = simTypes.RandomAmount(r, balance)
+		}
+
+		msg := types.NewMsgWithdrawFee(user.Address, amount)
+
+		return simulation.DeliverMsg(ctx, msg, NewHandler(k, &mocks.IContractCaller{})), nil, nil
+	}
+}
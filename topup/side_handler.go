@@ -127,6 +127,12 @@ func PostHandleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, sideTxRes
 		return err.Result()
 	}
 
+	// topped up coins are new to supply
+	if k.TracksSupply(ctx) {
+		k.InflateSupply(ctx, topupAmount)
+		k.SetTotalTopups(ctx, k.GetTotalTopups(ctx).Add(msg.Fee))
+	}
+
 	// transfer fees to sender (proposer)
 	if err := k.bk.SendCoins(ctx, user, msg.FromAddress, auth.DefaultFeeWantedPerTx); err != nil {
 		return err.Result()
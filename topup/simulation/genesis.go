@@ -5,9 +5,9 @@ import (
 	"math/big"
 	"math/rand"
 	"strconv"
-	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/common/math"
 	"github.com/maticnetwork/heimdall/topup/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -25,8 +25,7 @@ func GenSequenceNumber(r *rand.Rand) string {
 
 // RandomizeGenState returns topup genesis
 func RandomizeGenState(simState *module.SimulationState) {
-	s1 := rand.NewSource(time.Now().UnixNano())
-	r1 := rand.New(s1)
+	r1 := simState.Rand
 	n := 5
 	accounts := simulation.RandomAccounts(r1, n)
 
@@ -49,7 +48,7 @@ func RandomizeGenState(simState *module.SimulationState) {
 		)
 	}
 
-	topupGenesis := types.NewGenesisState(sequences, dividendAccounts)
+	topupGenesis := types.NewGenesisState(sequences, dividendAccounts, sdk.ZeroInt())
 	fmt.Printf("Selected randomly generated topup sequences:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, topupGenesis))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(topupGenesis)
 }
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -12,19 +14,21 @@ import (
 type GenesisState struct {
 	TopupSequences   []string                  `json:"tx_sequences" yaml:"tx_sequences"`
 	DividentAccounts []hmTypes.DividendAccount `json:"dividend_accounts" yaml:"dividend_accounts"`
+	TotalTopups      sdk.Int                   `json:"total_topups" yaml:"total_topups"` // total fee topped up, backs all dividend accounts
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(topupSequence []string, dividentAccounts []hmTypes.DividendAccount) GenesisState {
+func NewGenesisState(topupSequence []string, dividentAccounts []hmTypes.DividendAccount, totalTopups sdk.Int) GenesisState {
 	return GenesisState{
 		TopupSequences:   topupSequence,
 		DividentAccounts: dividentAccounts,
+		TotalTopups:      totalTopups,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(nil, nil)
+	return NewGenesisState(nil, nil, sdk.ZeroInt())
 }
 
 // ValidateGenesis performs basic validation of topup genesis data returning an
@@ -35,6 +39,10 @@ func ValidateGenesis(data GenesisState) error {
 			return errors.New("Invalid Sequence")
 		}
 	}
+
+	if data.TotalTopups != (sdk.Int{}) && data.TotalTopups.IsNegative() {
+		return errors.New("Invalid total topups")
+	}
 	return nil
 }
 
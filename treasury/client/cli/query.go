@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/treasury/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the treasury module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetQueryParams(cdc),
+			GetQueryPool(cdc),
+			GetQuerySpends(cdc),
+		)...,
+	)
+	return queryCmd
+}
+
+// GetQueryParams implements the params query command.
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current treasury parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as treasury parameters.
+
+Example:
+$ %s query treasury params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err = json.Unmarshal(bz, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetQueryPool implements the treasury pool balance query command.
+func GetQueryPool(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pool",
+		Args:  cobra.NoArgs,
+		Short: "show the treasury pool balance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query coins held by the treasury pool.
+
+Example:
+$ %s query treasury pool
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPool)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var pool sdk.Coins
+			if err = json.Unmarshal(bz, &pool); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(pool)
+		},
+	}
+}
+
+// GetQuerySpends implements the treasury spend history query command.
+func GetQuerySpends(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "spends",
+		Args:  cobra.NoArgs,
+		Short: "show treasury spend history",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query treasury spends made by governance.
+
+Example:
+$ %s query treasury spends
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpends)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+}
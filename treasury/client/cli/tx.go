@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	treasuryUtils "github.com/maticnetwork/heimdall/treasury/client/utils"
+	"github.com/maticnetwork/heimdall/treasury/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+var logger = helper.Logger.With("module", "treasury/client/cli")
+
+// GetCmdSubmitProposal implements a command handler for submitting a treasury
+// spend proposal transaction.
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a treasury spend proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a treasury spend proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Funds are transferred from
+the treasury pool to the recipient once the proposal passes.
+
+Example:
+$ %s tx gov submit-proposal treasury-spend <path/to/proposal.json> --validator-id=1 --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community grant",
+  "description": "Fund tooling development",
+  "recipient": "0x...",
+  "amount": [
+    {
+      "denom": "matic",
+      "amount": "1000000000000000000"
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "matic",
+      "amount": "1000000000000000000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := treasuryUtils.ParseTreasurySpendProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			from := helper.GetFromAddress(cliCtx)
+			content := types.NewTreasurySpendProposal(proposal.Title, proposal.Description, proposal.Recipient, proposal.Amount)
+
+			// create submit proposal
+			msg := govTypes.NewMsgSubmitProposal(content, proposal.Deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("GetCmdSubmitProposal | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	govclient "github.com/maticnetwork/heimdall/gov/client"
+	"github.com/maticnetwork/heimdall/treasury/client/cli"
+	"github.com/maticnetwork/heimdall/treasury/client/rest"
+)
+
+// treasury spend proposal handler
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
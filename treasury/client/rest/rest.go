@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+
+	treasuryTypes "github.com/maticnetwork/heimdall/treasury/types"
+)
+
+// RegisterRoutes registers the treasury module REST routes.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/treasury/params", queryHandlerFn(cliCtx, treasuryTypes.QueryParams)).Methods("GET")
+	r.HandleFunc("/treasury/pool", queryHandlerFn(cliCtx, treasuryTypes.QueryPool)).Methods("GET")
+	r.HandleFunc("/treasury/spends", queryHandlerFn(cliCtx, treasuryTypes.QuerySpends)).Methods("GET")
+}
@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	govRest "github.com/maticnetwork/heimdall/gov/client/rest"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	treasuryUtils "github.com/maticnetwork/heimdall/treasury/client/utils"
+	treasuryTypes "github.com/maticnetwork/heimdall/treasury/types"
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the treasury
+// spend REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "treasury_spend",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req treasuryUtils.TreasurySpendProposalReq
+		if !hmRest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := treasuryTypes.NewTreasurySpendProposal(req.Title, req.Description, req.Recipient, req.Amount)
+
+		msg := govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
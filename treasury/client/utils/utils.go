@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+type (
+	// TreasurySpendProposalJSON defines a TreasurySpendProposal with a deposit used
+	// to parse treasury spend proposals from a JSON file.
+	TreasurySpendProposalJSON struct {
+		Title       string                  `json:"title" yaml:"title"`
+		Description string                  `json:"description" yaml:"description"`
+		Recipient   hmTypes.HeimdallAddress `json:"recipient" yaml:"recipient"`
+		Amount      sdk.Coins               `json:"amount" yaml:"amount"`
+		Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+	}
+
+	// TreasurySpendProposalReq defines a treasury spend proposal request body.
+	TreasurySpendProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string                  `json:"title" yaml:"title"`
+		Description string                  `json:"description" yaml:"description"`
+		Recipient   hmTypes.HeimdallAddress `json:"recipient" yaml:"recipient"`
+		Amount      sdk.Coins               `json:"amount" yaml:"amount"`
+		Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+		Validator   hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+	}
+)
+
+// ParseTreasurySpendProposalJSON reads and parses a TreasurySpendProposalJSON from
+// file.
+func ParseTreasurySpendProposalJSON(cdc *codec.Codec, proposalFile string) (TreasurySpendProposalJSON, error) {
+	proposal := TreasurySpendProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,27 @@
+package treasury
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/treasury/types"
+)
+
+// InitGenesis sets treasury information for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	// create module account, pool balance is held by its account in auth genesis
+	keeper.GetTreasuryAccount(ctx)
+
+	for _, spend := range data.Spends {
+		keeper.SetSpend(ctx, spend)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	return types.NewGenesisState(
+		keeper.GetParams(ctx),
+		keeper.GetSpends(ctx),
+	)
+}
@@ -0,0 +1,21 @@
+package treasury_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	treasuryTypes "github.com/maticnetwork/heimdall/treasury/types"
+)
+
+//
+// Create test app
+//
+
+// returns context and app with params set on treasury keeper
+func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context) {
+	app := app.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+	app.TreasuryKeeper.SetParams(ctx, treasuryTypes.DefaultParams())
+	return app, ctx
+}
@@ -0,0 +1,190 @@
+package treasury
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/params/subspace"
+	"github.com/maticnetwork/heimdall/supply"
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+	"github.com/maticnetwork/heimdall/treasury/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// param space
+	paramSpace subspace.Subspace
+	// supply keeper
+	supplyKeeper supply.Keeper
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace subspace.Subspace,
+	codespace sdk.CodespaceType,
+	supplyKeeper supply.Keeper,
+) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:    codespace,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// -----------------------------------------------------------------------------
+// Params
+
+// SetParams sets the treasury module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the treasury module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// -----------------------------------------------------------------------------
+// Pool
+
+// GetTreasuryAccount returns the treasury ModuleAccount
+func (k Keeper) GetTreasuryAccount(ctx sdk.Context) supplyTypes.ModuleAccountInterface {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.TreasuryPoolName)
+}
+
+// GetPool returns treasury pool balance held by the treasury module account
+func (k Keeper) GetPool(ctx sdk.Context) sdk.Coins {
+	macc := k.GetTreasuryAccount(ctx)
+	if macc == nil {
+		return sdk.Coins{}
+	}
+	return macc.GetCoins()
+}
+
+// FundFromFeeCollector moves treasury fraction of collected fees into treasury
+func (k Keeper) FundFromFeeCollector(ctx sdk.Context) {
+	fraction := k.GetParams(ctx).FeeFraction
+	if fraction.IsNil() || !fraction.IsPositive() {
+		return
+	}
+
+	feeCollector := k.supplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
+	collected := feeCollector.GetCoins().AmountOf(authTypes.FeeToken)
+	amount := fraction.MulInt(collected).TruncateInt()
+	if !amount.IsPositive() {
+		return
+	}
+
+	coins := sdk.Coins{sdk.NewCoin(authTypes.FeeToken, amount)}
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName, types.TreasuryPoolName, coins); err != nil {
+		k.Logger(ctx).Error("Unable to fund treasury from fee collector", "error", err)
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTreasuryFund,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+}
+
+// Spend transfers treasury funds to recipient and records the spend
+func (k Keeper) Spend(ctx sdk.Context, title string, recipient hmTypes.HeimdallAddress, amount sdk.Coins) (types.TreasurySpend, sdk.Error) {
+	if _, hasNeg := k.GetPool(ctx).SafeSub(amount); hasNeg {
+		return types.TreasurySpend{}, common.ErrInsufficientTreasuryFunds(k.Codespace())
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.TreasuryPoolName, recipient, amount); err != nil {
+		return types.TreasurySpend{}, err
+	}
+
+	spend := types.NewTreasurySpend(k.GetSpendCount(ctx)+1, title, recipient, amount, ctx.BlockHeight())
+	k.SetSpend(ctx, spend)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTreasurySpend,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpendID, strconv.FormatUint(spend.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return spend, nil
+}
+
+// -----------------------------------------------------------------------------
+// Spend history
+
+// GetSpendKey returns key for treasury spend
+func GetSpendKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(types.SpendPrefixKey, bz...)
+}
+
+// SetSpend stores treasury spend and bumps spend count
+func (k Keeper) SetSpend(ctx sdk.Context, spend types.TreasurySpend) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetSpendKey(spend.ID), k.cdc.MustMarshalBinaryBare(spend))
+
+	if spend.ID > k.GetSpendCount(ctx) {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, spend.ID)
+		store.Set(types.SpendCountKey, bz)
+	}
+}
+
+// GetSpendCount returns number of recorded treasury spends
+func (k Keeper) GetSpendCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SpendCountKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetSpends returns all recorded treasury spends
+func (k Keeper) GetSpends(ctx sdk.Context) (spends []types.TreasurySpend) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SpendPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var spend types.TreasurySpend
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &spend)
+		spends = append(spends, spend)
+	}
+	return
+}
@@ -0,0 +1,118 @@
+package treasury_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/supply"
+	"github.com/maticnetwork/heimdall/treasury"
+	"github.com/maticnetwork/heimdall/treasury/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// fundFeeCollector adds collected fees to fee collector along with total supply
+func fundFeeCollector(t *testing.T, app *app.HeimdallApp, ctx sdk.Context, fees sdk.Coins) {
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
+	require.Nil(t, app.BankKeeper.SetCoins(ctx, feeCollector.GetAddress(), fees))
+
+	totalSupply := app.SupplyKeeper.GetSupply(ctx)
+	totalSupply.Inflate(fees)
+	app.SupplyKeeper.SetSupply(ctx, totalSupply)
+}
+
+// Tests
+
+func (suite *KeeperTestSuite) TestParamsGetterSetter() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	params := types.NewParams(sdk.NewDecWithPrec(2, 2))
+
+	app.TreasuryKeeper.SetParams(ctx, params)
+
+	require.Equal(t, params, app.TreasuryKeeper.GetParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestFundFromFeeCollector() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
+	fees := sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(1000))}
+	fundFeeCollector(t, app, ctx, fees)
+
+	// nothing moves with zero fraction
+	app.TreasuryKeeper.FundFromFeeCollector(ctx)
+	require.True(t, app.TreasuryKeeper.GetPool(ctx).IsZero())
+
+	app.TreasuryKeeper.SetParams(ctx, types.NewParams(sdk.NewDecWithPrec(25, 2)))
+	app.TreasuryKeeper.FundFromFeeCollector(ctx)
+
+	expected := sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(250))}
+	require.Equal(t, expected, app.TreasuryKeeper.GetPool(ctx))
+	require.Equal(t, expected, app.TreasuryKeeper.GetTreasuryAccount(ctx).GetCoins())
+	require.Equal(t, sdk.NewInt(750), app.BankKeeper.GetCoins(ctx, feeCollector.GetAddress()).AmountOf(authTypes.FeeToken))
+
+	_, broken := supply.AllInvariants(app.SupplyKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func (suite *KeeperTestSuite) TestTreasurySpendProposal() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	handler := treasury.NewTreasuryProposalHandler(app.TreasuryKeeper)
+	recipient := hmTypes.HexToHeimdallAddress("0x000000000000000000000000000000000000abcd")
+	amount := sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(100))}
+	proposal := types.NewTreasurySpendProposal("title", "description", recipient, amount)
+
+	// empty treasury can't spend
+	require.NotNil(t, handler(ctx, proposal))
+	require.Empty(t, app.TreasuryKeeper.GetSpends(ctx))
+
+	// fund treasury
+	fundFeeCollector(t, app, ctx, sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(1000))})
+	app.TreasuryKeeper.SetParams(ctx, types.NewParams(sdk.OneDec()))
+	app.TreasuryKeeper.FundFromFeeCollector(ctx)
+
+	require.Nil(t, handler(ctx, proposal))
+	require.Equal(t, amount, app.BankKeeper.GetCoins(ctx, recipient))
+	require.Equal(t, sdk.NewInt(900), app.TreasuryKeeper.GetPool(ctx).AmountOf(authTypes.FeeToken))
+
+	spends := app.TreasuryKeeper.GetSpends(ctx)
+	require.Len(t, spends, 1)
+	require.Equal(t, uint64(1), spends[0].ID)
+	require.Equal(t, recipient, spends[0].Recipient)
+	require.Equal(t, amount, spends[0].Amount)
+
+	_, broken := supply.AllInvariants(app.SupplyKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func (suite *KeeperTestSuite) TestPoolFromModuleAccount() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	require.True(t, app.TreasuryKeeper.GetPool(ctx).IsZero())
+
+	// coins sent to treasury account outside of the module are part of the pool
+	coins := sdk.Coins{sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(1))}
+	macc := app.TreasuryKeeper.GetTreasuryAccount(ctx)
+	require.Nil(t, app.BankKeeper.SetCoins(ctx, macc.GetAddress(), coins))
+	require.Equal(t, coins, app.TreasuryKeeper.GetPool(ctx))
+}
@@ -0,0 +1,34 @@
+package treasury
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/treasury/types"
+)
+
+// NewTreasuryProposalHandler creates handler for treasury spend proposals
+func NewTreasuryProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.TreasurySpendProposal:
+			return handleTreasurySpendProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized treasury proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleTreasurySpendProposal(ctx sdk.Context, k Keeper, p types.TreasurySpendProposal) sdk.Error {
+	spend, err := k.Spend(ctx, p.Title, p.Recipient, p.Amount)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("Transferred treasury funds", "spendID", spend.ID, "recipient", spend.Recipient, "amount", spend.Amount)
+	return nil
+}
@@ -0,0 +1,55 @@
+package treasury
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/treasury/types"
+)
+
+// NewQuerier creates a querier for treasury REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return queryParams(ctx, req, keeper)
+		case types.QueryPool:
+			return queryPool(ctx, req, keeper)
+		case types.QuerySpends:
+			return querySpends(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown treasury query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryPool(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetPool(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySpends(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	spends := keeper.GetSpends(ctx)
+	if spends == nil {
+		spends = []types.TreasurySpend{}
+	}
+
+	bz, err := json.Marshal(spends)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
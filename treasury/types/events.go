@@ -0,0 +1,13 @@
+package types
+
+// treasury module event types
+const (
+	EventTypeTreasuryFund  = "treasury-fund"
+	EventTypeTreasurySpend = "treasury-spend"
+
+	AttributeKeyAmount    = "amount"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeySpendID   = "spend-id"
+
+	AttributeValueCategory = ModuleName
+)
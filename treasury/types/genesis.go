@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// GenesisState is the treasury state that must be provided at genesis.
+type GenesisState struct {
+	Params Params          `json:"params" yaml:"params"`
+	Spends []TreasurySpend `json:"spends" yaml:"spends"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, spends []TreasurySpend) GenesisState {
+	return GenesisState{
+		Params: params,
+		Spends: spends,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// ValidateGenesis performs basic validation of treasury genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, spend := range data.Spends {
+		if spend.ID == 0 || spend.Recipient.Empty() || !spend.Amount.IsValid() {
+			return errors.New("Invalid treasury spend")
+		}
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns treasury GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		ModuleCdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+	return genesisState
+}
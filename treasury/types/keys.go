@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "treasury"
+
+	// StoreKey is the store key string for treasury
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for treasury
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for treasury
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+
+	// DefaultCodespace default code space
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	// TreasuryPoolName is the name of the module account holding community funds
+	TreasuryPoolName = ModuleName
+)
+
+var (
+	SpendPrefixKey = []byte{0x12} // prefix for each key to a treasury spend
+	SpendCountKey  = []byte{0x13} // key for treasury spend count
+)
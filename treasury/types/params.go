@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+)
+
+// Default parameter values
+var (
+	DefaultFeeFraction = sdk.ZeroDec()
+)
+
+// Parameter keys
+var (
+	KeyFeeFraction = []byte("FeeFraction")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the treasury module.
+type Params struct {
+	FeeFraction sdk.Dec `json:"fee_fraction" yaml:"fee_fraction"` // fraction of collected fees moved to treasury every block
+}
+
+// NewParams creates a new Params object
+func NewParams(feeFraction sdk.Dec) Params {
+	return Params{
+		FeeFraction: feeFraction,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of treasury module's parameters.
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyFeeFraction, &p.FeeFraction},
+	}
+}
+
+// String implements the stringer interface for Params
+func (p Params) String() string {
+	return fmt.Sprintf(`Treasury Params:
+  FeeFraction: %s`, p.FeeFraction)
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.FeeFraction.IsNil() || p.FeeFraction.IsNegative() || p.FeeFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee fraction should be between 0 and 1, is %s", p.FeeFraction)
+	}
+
+	return nil
+}
+
+//
+// Extra functions
+//
+
+// ParamKeyTable for treasury module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default parameters for treasury module
+func DefaultParams() Params {
+	return NewParams(DefaultFeeFraction)
+}
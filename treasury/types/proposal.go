@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// ProposalTypeTreasurySpend defines the type for a TreasurySpendProposal
+	ProposalTypeTreasurySpend = "TreasurySpend"
+)
+
+// Assert TreasurySpendProposal implements govTypes.Content at compile-time
+var _ govTypes.Content = TreasurySpendProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeTreasurySpend)
+	govTypes.RegisterProposalTypeCodec(TreasurySpendProposal{}, "heimdall/TreasurySpendProposal")
+}
+
+// TreasurySpendProposal spends treasury funds to recipient once passed
+type TreasurySpendProposal struct {
+	Title       string                  `json:"title" yaml:"title"`
+	Description string                  `json:"description" yaml:"description"`
+	Recipient   hmTypes.HeimdallAddress `json:"recipient" yaml:"recipient"`
+	Amount      sdk.Coins               `json:"amount" yaml:"amount"`
+}
+
+// NewTreasurySpendProposal creates a new treasury spend proposal
+func NewTreasurySpendProposal(title, description string, recipient hmTypes.HeimdallAddress, amount sdk.Coins) TreasurySpendProposal {
+	return TreasurySpendProposal{title, description, recipient, amount}
+}
+
+// GetTitle returns the title of a treasury spend proposal.
+func (tsp TreasurySpendProposal) GetTitle() string { return tsp.Title }
+
+// GetDescription returns the description of a treasury spend proposal.
+func (tsp TreasurySpendProposal) GetDescription() string { return tsp.Description }
+
+// ProposalRoute returns the routing key of a treasury spend proposal.
+func (tsp TreasurySpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a treasury spend proposal.
+func (tsp TreasurySpendProposal) ProposalType() string { return ProposalTypeTreasurySpend }
+
+// ValidateBasic validates the treasury spend proposal
+func (tsp TreasurySpendProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(govTypes.DefaultCodespace, tsp); err != nil {
+		return err
+	}
+
+	if tsp.Recipient.Empty() {
+		return common.ErrInvalidTreasurySpend(DefaultCodespace)
+	}
+
+	if !tsp.Amount.IsValid() || tsp.Amount.IsZero() {
+		return common.ErrInvalidTreasurySpend(DefaultCodespace)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (tsp TreasurySpendProposal) String() string {
+	return fmt.Sprintf(`Treasury Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+`, tsp.Title, tsp.Description, tsp.Recipient, tsp.Amount)
+}
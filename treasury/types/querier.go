@@ -0,0 +1,8 @@
+package types
+
+// query endpoints supported by the treasury Querier
+const (
+	QueryParams = "params"
+	QueryPool   = "pool"
+	QuerySpends = "spends"
+)
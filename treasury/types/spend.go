@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// TreasurySpend records funds transferred out of treasury by governance
+type TreasurySpend struct {
+	ID        uint64                  `json:"id" yaml:"id"`
+	Title     string                  `json:"title" yaml:"title"`
+	Recipient hmTypes.HeimdallAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins               `json:"amount" yaml:"amount"`
+	Height    int64                   `json:"height" yaml:"height"`
+}
+
+// NewTreasurySpend creates new treasury spend record
+func NewTreasurySpend(id uint64, title string, recipient hmTypes.HeimdallAddress, amount sdk.Coins, height int64) TreasurySpend {
+	return TreasurySpend{
+		ID:        id,
+		Title:     title,
+		Recipient: recipient,
+		Amount:    amount,
+		Height:    height,
+	}
+}
+
+// String returns the string representation of treasury spend
+func (s TreasurySpend) String() string {
+	return fmt.Sprintf(`TreasurySpend %d:
+  Title:     %s
+  Recipient: %s
+  Amount:    %s
+  Height:    %d`, s.ID, s.Title, s.Recipient, s.Amount, s.Height)
+}
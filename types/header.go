@@ -36,7 +36,7 @@ func CreateBlock(
 
 // SortHeaders sorts array of headers on the basis for timestamps
 func SortHeaders(headers []Checkpoint) []Checkpoint {
-	sort.Slice(headers, func(i, j int) bool {
+	sort.SliceStable(headers, func(i, j int) bool {
 		return headers[i].TimeStamp < headers[j].TimeStamp
 	})
 	return headers
@@ -110,4 +110,5 @@ type SimulationState struct {
 	GenTimestamp time.Time                            // genesis timestamp
 	ParamChanges []simulation.ParamChange             // simulated parameter changes from modules
 	Contents     []simulation.WeightedProposalContent // proposal content generator functions with their default weight and app sim key
+	SideChannel  *simulation.SideChannel              // side channel to the side-tx processor of the app
 }
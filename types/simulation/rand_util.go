@@ -152,6 +152,14 @@ func RandHex(length int) []byte {
 	return bytes
 }
 
+// RandBytes generates n random bytes from the given random source, so the
+// result is reproducible for a simulation seed.
+func RandBytes(r *rand.Rand, n int) []byte {
+	bytes := make([]byte, n)
+	r.Read(bytes)
+	return bytes
+}
+
 type multiSource []rand.Source
 
 func (ms multiSource) Int63() (r int64) {
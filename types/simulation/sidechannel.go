@@ -0,0 +1,133 @@
+package simulation
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+)
+
+// SideTxProcessor is the side-tx processor of the app
+type SideTxProcessor interface {
+	// PostDeliverTxHandler stores side-tx of a delivered tx for its vote round
+	PostDeliverTxHandler(ctx sdk.Context, tx sdk.Tx, result sdk.Result)
+}
+
+// sideTxVote is the result simulated validators vote for a side-tx
+type sideTxVote struct {
+	txHash []byte
+	result abci.SideTxResultType
+}
+
+// SideChannel hands side-txs delivered by simulation operations to the side-tx
+// processor of the app, and keeps the result simulated validators vote for each
+// side-tx until the side block which processes it.
+type SideChannel struct {
+	processor SideTxProcessor
+	encoder   sdk.TxEncoder
+
+	// number of delivered txs, used as memo to keep tx hashes unique
+	txCount uint64
+	// votes by height of side block
+	votes map[int64][]sideTxVote
+}
+
+// NewSideChannel creates a side channel for the side-tx processor of the app
+func NewSideChannel(processor SideTxProcessor, encoder sdk.TxEncoder) *SideChannel {
+	return &SideChannel{
+		processor: processor,
+		encoder:   encoder,
+		votes:     make(map[int64][]sideTxVote),
+	}
+}
+
+// DeliverTx runs msg through handler in a tx as the app delivers it, writing to
+// ctx only if the handler succeeds. Side-tx of a successful tx is stored by the
+// side-tx processor for the vote round of the next block.
+func (sc *SideChannel) DeliverTx(ctx sdk.Context, msg sdk.Msg, handler sdk.Handler) (tmTypes.Tx, sdk.Result) {
+	sc.txCount++
+	tx := authTypes.NewStdTx(msg, authTypes.StdSignature{}, strconv.FormatUint(sc.txCount, 10))
+	txBytes, err := sc.encoder(tx)
+	if err != nil {
+		return nil, sdk.ErrTxDecode(err.Error()).Result()
+	}
+
+	cacheCtx, write := ctx.WithTxBytes(txBytes).CacheContext()
+	res := handler(cacheCtx, msg)
+	if res.IsOK() {
+		write()
+		sc.processor.PostDeliverTxHandler(ctx.WithTxBytes(txBytes), tx, res)
+	}
+
+	return txBytes, res
+}
+
+// Vote records the result simulated validators vote for tx delivered at height.
+// Validators vote in the next block, the side block after it processes the votes.
+func (sc *SideChannel) Vote(height int64, tx tmTypes.Tx, result abci.SideTxResultType) {
+	sideBlockHeight := height + 2
+	sc.votes[sideBlockHeight] = append(sc.votes[sideBlockHeight], sideTxVote{
+		txHash: tx.Hash(),
+		result: result,
+	})
+}
+
+// RequestBeginSideBlock returns the side block request with the votes on side-txs
+// processed at height. Validators which signed the last block vote the simulated
+// result, others miss the vote. A commit carries votes of more than 2/3 of the
+// power, so validators missing from the last commit are added back to the voters
+// until they hold it.
+func (sc *SideChannel) RequestBeginSideBlock(header abci.Header, lastCommitVotes []abci.VoteInfo) abci.RequestBeginSideBlock {
+	votes := sc.votes[header.Height]
+	delete(sc.votes, header.Height)
+
+	var totalPower, signedPower int64
+	for _, v := range lastCommitVotes {
+		totalPower += v.Validator.Power
+		if v.SignedLastBlock {
+			signedPower += v.Validator.Power
+		}
+	}
+
+	voters := make([]abci.Validator, 0, len(lastCommitVotes))
+	for _, v := range lastCommitVotes {
+		if v.SignedLastBlock {
+			voters = append(voters, v.Validator)
+		}
+	}
+
+	for _, v := range lastCommitVotes {
+		if signedPower*3 > totalPower*2 {
+			break
+		}
+
+		if !v.SignedLastBlock {
+			voters = append(voters, v.Validator)
+			signedPower += v.Validator.Power
+		}
+	}
+
+	sideTxResults := make([]abci.SideTxResult, len(votes))
+	for i, vote := range votes {
+		sigs := make([]abci.SideTxSig, len(voters))
+		for j, voter := range voters {
+			sigs[j] = abci.SideTxSig{
+				Result:  vote.result,
+				Address: voter.Address,
+			}
+		}
+
+		sideTxResults[i] = abci.SideTxResult{
+			TxHash: vote.txHash,
+			Sigs:   sigs,
+		}
+	}
+
+	return abci.RequestBeginSideBlock{
+		Header:        header,
+		SideTxResults: sideTxResults,
+	}
+}
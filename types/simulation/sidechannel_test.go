@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestRequestBeginSideBlock(t *testing.T) {
+	sideChannel := NewSideChannel(nil, nil)
+	tx1, tx2 := tmTypes.Tx("tx1"), tmTypes.Tx("tx2")
+	sideChannel.Vote(10, tx1, abci.SideTxResultType_Yes)
+	sideChannel.Vote(10, tx2, abci.SideTxResultType_No)
+
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: []byte("online"), Power: 30}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: []byte("offline"), Power: 10}, SignedLastBlock: false},
+	}
+
+	// votes are processed in side block two blocks later, in order of delivery
+	header := abci.Header{Height: 12}
+	req := sideChannel.RequestBeginSideBlock(header, votes)
+	require.Equal(t, header, req.Header)
+	require.Len(t, req.SideTxResults, 2)
+	require.Equal(t, tx1.Hash(), req.SideTxResults[0].TxHash)
+	require.Equal(t, tx2.Hash(), req.SideTxResults[1].TxHash)
+
+	// validators offline in the last block miss the vote
+	require.Equal(t, []abci.SideTxSig{{Result: abci.SideTxResultType_Yes, Address: []byte("online")}}, req.SideTxResults[0].Sigs)
+	require.Equal(t, []abci.SideTxSig{{Result: abci.SideTxResultType_No, Address: []byte("online")}}, req.SideTxResults[1].Sigs)
+
+	// votes are handed over once
+	require.Empty(t, sideChannel.RequestBeginSideBlock(header, votes).SideTxResults)
+
+	// offline validators vote while the last commit holds less than 2/3 of the power
+	sideChannel.Vote(11, tx1, abci.SideTxResultType_Yes)
+	votes[0].Validator.Power = 20
+	req = sideChannel.RequestBeginSideBlock(abci.Header{Height: 13}, votes)
+	require.Len(t, req.SideTxResults[0].Sigs, 2)
+	require.Equal(t, []byte("offline"), req.SideTxResults[0].Sigs[1].Address)
+}
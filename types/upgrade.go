@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeV03 is the software upgrade which switches on the v0.3 state and tx
+// format changes. Chains started from a v0.3 genesis have it done from genesis.
+const UpgradeV03 = "v0.3"
+
+// UpgradeKeeper tells if a software upgrade was applied on chain
+type UpgradeKeeper interface {
+	IsUpgradeDone(ctx sdk.Context, name string) bool
+}
+
+// UpgradeMsg is a msg added by a software upgrade, txs with it are rejected until the upgrade is applied
+type UpgradeMsg interface {
+	// RequiredUpgrade returns name of the software upgrade adding the msg
+	RequiredUpgrade() string
+}
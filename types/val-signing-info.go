@@ -20,6 +20,10 @@ type ValidatorSigningInfo struct {
 	// Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
 	// missed blocks counter (to avoid scanning the array every time)
 	MissedBlocksCounter int64 `json:"missed_blocks_counter,omitempty"`
+	// index offset into missed side-tx bit array
+	SideTxIndexOffset int64 `json:"side_tx_index_offset,omitempty"`
+	// missed side-txs counter (to avoid scanning the array every time)
+	MissedSideTxsCounter int64 `json:"missed_side_txs_counter,omitempty"`
 }
 
 // NewValidatorSigningInfo creates a new ValidatorSigningInfo instance
@@ -44,9 +48,11 @@ func (i ValidatorSigningInfo) String() string {
   valID:               %d
   Start Height:          %d
   Index Offset:          %d  
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Side-tx Index Offset:  %d
+  Missed Side-txs Counter: %d`,
 		i.ValID, i.StartHeight, i.IndexOffset,
-		i.MissedBlocksCounter)
+		i.MissedBlocksCounter, i.SideTxIndexOffset, i.MissedSideTxsCounter)
 }
 
 // amino marshall validator
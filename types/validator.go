@@ -57,6 +57,18 @@ func SortValidatorByAddress(a []Validator) []Validator {
 	return a
 }
 
+// SortValidatorByPower sorts a slice of validators by voting power in descending order,
+// validators with equal power are sorted by ID
+func SortValidatorByPower(a []*Validator) []*Validator {
+	sort.SliceStable(a, func(i, j int) bool {
+		if a[i].VotingPower != a[j].VotingPower {
+			return a[i].VotingPower > a[j].VotingPower
+		}
+		return a[i].ID < a[j].ID
+	})
+	return a
+}
+
 // IsCurrentValidator checks if validator is in current validator set
 func (v *Validator) IsCurrentValidator(ackCount uint64) bool {
 	// current epoch will be ack count + 1